@@ -0,0 +1,117 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// DefaultACLMethod is the method of the rule applied to the methods without their own rule
+const DefaultACLMethod = "*"
+
+// ErrAccessDenied is returned when the caller is not allowed to call the contract method
+var ErrAccessDenied = errors.New("access denied")
+
+// GetContractACL returns the access control list of the contract, nil if the contract has none
+func GetContractACL(store db.KVStore, name string) (*pb.ContractACL, error) {
+	buf, err := store.Get(util.ContractACLKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 {
+		return nil, nil
+	}
+	acl := new(pb.ContractACL)
+	if err = proto.Unmarshal(buf, acl); err != nil {
+		return nil, err
+	}
+	return acl, nil
+}
+
+// PutContractACL saves the access control list of the contract, an empty list removes it
+func PutContractACL(store db.KVStore, acl *pb.ContractACL) error {
+	if err := validateACL(acl); err != nil {
+		return err
+	}
+	if len(acl.GetMethods()) == 0 && len(acl.GetRoles()) == 0 {
+		return store.Delete(util.ContractACLKey(acl.GetContract()))
+	}
+	buf, err := proto.Marshal(acl)
+	if err != nil {
+		return err
+	}
+	return store.Put(util.ContractACLKey(acl.GetContract()), buf)
+}
+
+func validateACL(acl *pb.ContractACL) error {
+	roles := make(map[string]bool)
+	for _, role := range acl.GetRoles() {
+		if role.GetName() == "" {
+			return errors.New("empty acl role name")
+		}
+		if roles[role.GetName()] {
+			return fmt.Errorf("duplicated acl role %s", role.GetName())
+		}
+		roles[role.GetName()] = true
+	}
+	methods := make(map[string]bool)
+	for _, rule := range acl.GetMethods() {
+		if rule.GetMethod() == "" {
+			return errors.New("empty acl method name")
+		}
+		if methods[rule.GetMethod()] {
+			return fmt.Errorf("duplicated acl rule of method %s", rule.GetMethod())
+		}
+		methods[rule.GetMethod()] = true
+		for _, role := range rule.GetRoles() {
+			if !roles[role] {
+				return fmt.Errorf("acl rule of method %s refers to undefined role %s", rule.GetMethod(), role)
+			}
+		}
+	}
+	return nil
+}
+
+// checkACL 校验caller是否可以调用合约的method，没有对应规则的方法不受限制
+func checkACL(acl *pb.ContractACL, method, caller string) error {
+	if acl == nil {
+		return nil
+	}
+	var rule, defaultRule *pb.MethodACL
+	for _, r := range acl.GetMethods() {
+		switch r.GetMethod() {
+		case method:
+			rule = r
+		case DefaultACLMethod:
+			defaultRule = r
+		}
+	}
+	if rule == nil {
+		rule = defaultRule
+	}
+	if rule == nil {
+		return nil
+	}
+	for _, allowed := range rule.GetCallers() {
+		if allowed == caller {
+			return nil
+		}
+	}
+	for _, name := range rule.GetRoles() {
+		for _, role := range acl.GetRoles() {
+			if role.GetName() != name {
+				continue
+			}
+			for _, member := range role.GetMembers() {
+				if member == caller {
+					return nil
+				}
+			}
+		}
+	}
+	return fmt.Errorf("%w: %s can not call method %s of contract %s", ErrAccessDenied, caller, method, acl.GetContract())
+}
@@ -0,0 +1,137 @@
+package bridge
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+)
+
+func TestCheckACL(t *testing.T) {
+	acl := &pb.ContractACL{
+		Contract: "token",
+		Roles: []*pb.ACLRole{
+			{Name: "admin", Members: []string{"alice"}},
+			{Name: "minter", Members: []string{"bob", "market"}},
+		},
+		Methods: []*pb.MethodACL{
+			{Method: "mint", Roles: []string{"admin", "minter"}},
+			{Method: "pause", Callers: []string{"carol"}, Roles: []string{"admin"}},
+			{Method: DefaultACLMethod, Roles: []string{"admin"}},
+			{Method: "transfer", Callers: []string{"alice", "bob", "carol"}},
+		},
+	}
+	cases := []struct {
+		acl     *pb.ContractACL
+		method  string
+		caller  string
+		allowed bool
+	}{
+		{nil, "mint", "anyone", true},
+		{acl, "mint", "market", true},
+		{acl, "mint", "carol", false},
+		{acl, "pause", "carol", true},
+		{acl, "pause", "alice", true},
+		{acl, "pause", "bob", false},
+		// 没有规则的方法使用*的规则
+		{acl, "burn", "alice", true},
+		{acl, "burn", "bob", false},
+		{acl, "transfer", "carol", true},
+		{acl, "transfer", "dave", false},
+		{&pb.ContractACL{Methods: []*pb.MethodACL{{Method: "mint"}}}, "burn", "dave", true},
+	}
+	for _, c := range cases {
+		err := checkACL(c.acl, c.method, c.caller)
+		if c.allowed && err != nil {
+			t.Errorf("%s %s: %v", c.caller, c.method, err)
+		}
+		if !c.allowed && !errors.Is(err, ErrAccessDenied) {
+			t.Errorf("%s %s: expect access denied, got %v", c.caller, c.method, err)
+		}
+	}
+}
+
+func TestPutContractACL(t *testing.T) {
+	b, _, _ := newTestBridge()
+	for _, acl := range []*pb.ContractACL{
+		{Roles: []*pb.ACLRole{{Name: ""}}},
+		{Roles: []*pb.ACLRole{{Name: "admin"}, {Name: "admin"}}},
+		{Methods: []*pb.MethodACL{{Method: ""}}},
+		{Methods: []*pb.MethodACL{{Method: "mint"}, {Method: "mint"}}},
+		{Methods: []*pb.MethodACL{{Method: "mint", Roles: []string{"admin"}}}},
+	} {
+		acl.Contract = "token"
+		if err := PutContractACL(NewWriteSet(b.db), acl); err == nil {
+			t.Errorf("expect acl %v to be rejected", acl)
+		}
+	}
+
+	ws := NewWriteSet(b.db)
+	if err := PutContractACL(ws, &pb.ContractACL{
+		Contract: "token",
+		Methods:  []*pb.MethodACL{{Method: "mint", Callers: []string{"alice"}}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if acl, err := GetContractACL(ws, "token"); err != nil || len(acl.GetMethods()) != 1 {
+		t.Fatalf("acl %v error %v", acl, err)
+	}
+	// 空的列表删除所有规则
+	if err := PutContractACL(ws, &pb.ContractACL{Contract: "token"}); err != nil {
+		t.Fatal(err)
+	}
+	if acl, err := GetContractACL(ws, "token"); err != nil || acl != nil {
+		t.Fatalf("expect the acl to be removed, got %v %v", acl, err)
+	}
+}
+
+func TestACLEnforcedOnCall(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	executor.deploy(t, b, &pb.ContractDesc{Name: "token", Deployer: "alice"}, func(s *SyscallService, ctx *ContractState) error {
+		return okResponse(s, ctx, "")
+	})
+	var marketErrs []error
+	executor.deploy(t, b, &pb.ContractDesc{Name: "market"}, func(s *SyscallService, ctx *ContractState) error {
+		for _, method := range []string{"mint", "burn"} {
+			_, err := callContract(s, ctx, "token", method)
+			marketErrs = append(marketErrs, err)
+		}
+		return okResponse(s, ctx, "")
+	})
+	ws := NewWriteSet(b.db)
+	if err := PutContractACL(ws, &pb.ContractACL{
+		Contract: "token",
+		Methods: []*pb.MethodACL{
+			{Method: "mint", Callers: []string{"alice", "market"}},
+			{Method: DefaultACLMethod, Callers: []string{"alice"}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		method, caller string
+		allowed        bool
+	}{
+		{"mint", "alice", true},
+		{"mint", "bob", false},
+		{"balance", "alice", true},
+		{"balance", "bob", false},
+	} {
+		_, _, err := invoke(vm, &ContractState{ContractName: "token", Method: c.method, Caller: c.caller})
+		if c.allowed != (err == nil) || (err != nil && !errors.Is(err, ErrAccessDenied)) {
+			t.Errorf("%s %s: unexpected error %v", c.caller, c.method, err)
+		}
+	}
+
+	// 跨合约调用以发起调用的合约作为caller校验
+	if _, _, err := invoke(vm, &ContractState{ContractName: "market", Caller: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if marketErrs[0] != nil || !errors.Is(marketErrs[1], ErrAccessDenied) {
+		t.Errorf("unexpected errors of calls from market %v", marketErrs)
+	}
+}
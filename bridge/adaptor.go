@@ -3,10 +3,16 @@ package bridge
 import (
 	"fmt"
 
+	"github.com/BeDreamCoder/uwavm/common/db"
 	"github.com/BeDreamCoder/uwavm/contract/go/pb"
 	"github.com/BeDreamCoder/uwavm/vm/gas"
 )
 
+const (
+	// StatusErrorThreshold 合约返回的status大于等于该值时，所有写操作都会回滚
+	StatusErrorThreshold = 400
+)
+
 // ContractError indicates the error of the contract running result
 type ContractError struct {
 	Status  int
@@ -22,27 +28,60 @@ func (c *ContractError) Error() string {
 // 它组合了合约内核态数据(cts)以及用户态的虚拟机数据(instance)
 type contractHandle struct {
 	cts      *ContractState
+	acl      *pb.ContractACL
 	instance Instance
 	release  func()
 }
 
 func (c *contractHandle) Invoke(method string, args map[string][]byte) (*pb.Response, error) {
+	// 创建实例时已经校验过state中指定的方法
+	if method != c.cts.Method {
+		if err := checkACL(c.acl, method, c.cts.Caller); err != nil {
+			return nil, err
+		}
+	}
 	c.cts.Method = method
 	c.cts.Args = args
 	err := c.instance.Exec("")
 	if err != nil {
+		c.cts.WriteSet.Discard()
+		if _, ok := err.(*gas.ErrOutOfGas); !ok && c.outOfGas() {
+			return nil, c.outOfGasError()
+		}
 		return nil, err
 	}
+	// 系统调用和内存的消耗在合约执行结束后才能完整统计
+	if c.outOfGas() {
+		c.cts.WriteSet.Discard()
+		return nil, c.outOfGasError()
+	}
 	if c.cts.Output == nil {
+		c.cts.WriteSet.Discard()
 		return nil, &ContractError{
 			Status:  500,
 			Message: "internal error",
 		}
 	}
 
+	if c.cts.Output.Status >= StatusErrorThreshold {
+		c.cts.WriteSet.Discard()
+	} else {
+		c.cts.WriteSet.Commit()
+	}
 	return c.cts.Output, nil
 }
 
+func (c *contractHandle) outOfGas() bool {
+	return c.instance.ResourceUsed().Exceed(c.cts.Limits)
+}
+
+func (c *contractHandle) outOfGasError() error {
+	return &gas.ErrOutOfGas{
+		Limits: c.cts.Limits,
+		Used:   c.instance.ResourceUsed(),
+	}
+}
+
 func (c *contractHandle) ResourceUsed() gas.Limits {
 	return c.instance.ResourceUsed()
 }
@@ -57,20 +96,53 @@ func (c *contractHandle) ReleaseCache() error {
 // vmImpl 为vm.VirtualMachine的实现
 // 它是vmContextImpl的工厂类，根据不同的虚拟机类型(Executor)生成对应的vmContextImpl
 type vmImpl struct {
-	name  string
-	state *StateManager
-	exec  Executor
+	db     db.Database
+	name   string
+	state  *StateManager
+	exec   Executor
+	bridge *Bridge
 }
 
 func (v *vmImpl) GetName() string {
 	return v.name
 }
 
+// NewVM 创建合约实例，合约设置了ACL时在创建实例前校验caller能否调用state中的方法
 func (v *vmImpl) NewVM(state *ContractState) (Contract, error) {
+	// ACL通过合约调用的WriteSet读取，与合约的读集合一同校验
+	store := state.WriteSet
+	if store == nil {
+		store = NewWriteSet(v.db)
+	}
+	acl, err := GetContractACL(store, state.ContractName)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkACL(acl, state.Method, state.Caller); err != nil {
+		return nil, err
+	}
+
 	ctx := v.state.CreateContractState()
 	ctx.ContractName = state.ContractName
+	ctx.Method = state.Method
 	ctx.Language = state.Language
 	ctx.Caller = state.Caller
+	ctx.Env = state.Env
+	if ctx.Env == nil {
+		ctx.Env = &pb.Environment{
+			Initiator: state.Caller,
+		}
+	}
+	ctx.WriteSet = state.WriteSet
+	if ctx.WriteSet == nil {
+		ctx.WriteSet = NewWriteSet(v.db)
+	}
+	ctx.Limits = state.Limits
+	ctx.ReadOnly = state.ReadOnly
+	ctx.CallStack = state.CallStack
+	if ctx.Limits == (gas.Limits{}) {
+		ctx.Limits = gas.MaxLimits
+	}
 
 	release := func() {
 		v.state.DestroyContractState(ctx)
@@ -83,15 +155,37 @@ func (v *vmImpl) NewVM(state *ContractState) (Contract, error) {
 	}
 	return &contractHandle{
 		cts:      ctx,
+		acl:      acl,
 		instance: instance,
 		release:  release,
 	}, nil
 }
 
-func (v *vmImpl) DeployContract(args map[string][]byte) (*pb.Response, gas.Limits, error) {
-	return v.exec.DeployContract(args)
+// 部署、调用、升级和查询请求在交给Executor之前校验caller的签名
+func (v *vmImpl) DeployContract(args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *RWSet, error) {
+	if err := authenticate(NewWriteSet(v.db), OpDeploy, "", args, v.bridge.RequireSignature()); err != nil {
+		return nil, gas.Limits{}, nil, err
+	}
+	return v.exec.DeployContract(args, limits)
 }
 
-func (v *vmImpl) InvokeContract(method string, args map[string][]byte) (*pb.Response, gas.Limits, error) {
-	return v.exec.InvokeContract(method, args)
+func (v *vmImpl) InvokeContract(method string, args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *RWSet, error) {
+	if err := authenticate(NewWriteSet(v.db), OpInvoke, method, args, v.bridge.RequireSignature()); err != nil {
+		return nil, gas.Limits{}, nil, err
+	}
+	return v.exec.InvokeContract(method, args, limits)
+}
+
+func (v *vmImpl) UpgradeContract(args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *RWSet, error) {
+	if err := authenticate(NewWriteSet(v.db), OpUpgrade, "", args, v.bridge.RequireSignature()); err != nil {
+		return nil, gas.Limits{}, nil, err
+	}
+	return v.exec.UpgradeContract(args, limits)
+}
+
+func (v *vmImpl) QueryContract(method string, args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, error) {
+	if err := authenticate(NewWriteSet(v.db), OpQuery, method, args, v.bridge.RequireSignature()); err != nil {
+		return nil, gas.Limits{}, err
+	}
+	return v.exec.QueryContract(method, args, limits)
 }
@@ -0,0 +1,119 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+)
+
+func callContract(s *SyscallService, ctx *ContractState, contract, method string) (*pb.Response, error) {
+	resp, err := s.ContractCall(context.Background(), &pb.ContractCallRequest{
+		Header:   header(ctx),
+		Module:   "wasm",
+		Contract: contract,
+		Method:   method,
+	})
+	return resp.GetResponse(), err
+}
+
+// writeThenReturn 写入key后按方法名返回不同的结果
+func writeThenReturn(s *SyscallService, ctx *ContractState) error {
+	if err := putObject(s, ctx, ctx.Method, "1"); err != nil {
+		return err
+	}
+	switch ctx.Method {
+	case "reject":
+		_, err := s.SetOutput(context.Background(), &pb.SetOutputRequest{
+			Header:   header(ctx),
+			Response: &pb.Response{Status: StatusErrorThreshold, Message: "rejected"},
+		})
+		return err
+	case "fail":
+		return errors.New("trap")
+	case "none":
+		return nil
+	}
+	return okResponse(s, ctx, "")
+}
+
+func TestInvokeRollbackOnError(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	executor.deploy(t, b, &pb.ContractDesc{Name: "buffer"}, writeThenReturn)
+
+	cases := []struct {
+		method string
+		status int32
+		err    bool
+	}{
+		{"ok", 200, false},
+		{"reject", StatusErrorThreshold, false},
+		{"fail", 0, true},
+		{"none", 0, true},
+	}
+	for _, c := range cases {
+		resp, root, err := invoke(vm, &ContractState{ContractName: "buffer", Method: c.method})
+		if (err != nil) != c.err || resp.GetStatus() != c.status {
+			t.Errorf("%s: response %v error %v", c.method, resp, err)
+		}
+		writes := root.RWSet().Writes
+		committed := len(writes) == 1 && string(writes[0].Key) == string(util.ContractStateKey("buffer", []byte(c.method)))
+		if committed != (c.method == "ok") || len(writes) > 1 {
+			t.Errorf("%s: unexpected writes %v", c.method, writes)
+		}
+	}
+}
+
+func TestContractCallRollbackOnError(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	executor.deploy(t, b, &pb.ContractDesc{Name: "callee"}, writeThenReturn)
+	var callErrs []error
+	var rejected *pb.Response
+	executor.deploy(t, b, &pb.ContractDesc{Name: "caller"}, func(s *SyscallService, ctx *ContractState) error {
+		if err := putObject(s, ctx, "before", "1"); err != nil {
+			return err
+		}
+		var err error
+		if rejected, err = callContract(s, ctx, "callee", "reject"); err != nil {
+			return err
+		}
+		for _, method := range []string{"fail", "none", "ok"} {
+			_, err := callContract(s, ctx, "callee", method)
+			callErrs = append(callErrs, err)
+		}
+		return okResponse(s, ctx, "")
+	})
+
+	_, root, err := invoke(vm, &ContractState{ContractName: "caller"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rejected.GetStatus() != StatusErrorThreshold {
+		t.Errorf("expect the rejected response, got %v", rejected)
+	}
+	if callErrs[0] == nil || callErrs[1] == nil || callErrs[2] != nil {
+		t.Errorf("unexpected call errors %v", callErrs)
+	}
+	// 失败的子调用只回滚自己的写操作
+	if err = b.CommitRWSet(root.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+	ws := NewWriteSet(b.db)
+	for key, expect := range map[string]bool{
+		string(util.ContractStateKey("caller", []byte("before"))): true,
+		string(util.ContractStateKey("callee", []byte("ok"))):     true,
+		string(util.ContractStateKey("callee", []byte("reject"))): false,
+		string(util.ContractStateKey("callee", []byte("fail"))):   false,
+		string(util.ContractStateKey("callee", []byte("none"))):   false,
+	} {
+		value, err := ws.Get([]byte(key))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (value != nil) != expect {
+			t.Errorf("key %q: value %q", key, value)
+		}
+	}
+}
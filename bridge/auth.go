@@ -0,0 +1,147 @@
+package bridge
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/common/util"
+)
+
+// 请求签名覆盖的操作类型，避免同一个签名在不同类型的请求之间重放
+const (
+	OpDeploy  = "deploy"
+	OpUpgrade = "upgrade"
+	OpInvoke  = "invoke"
+	OpQuery   = "query"
+	// 合约之外的转账和ACL修改同样需要签名和nonce
+	OpTransfer = "transfer"
+	OpSetACL   = "setacl"
+	// 增发和注册账户公钥只能由创世时设置的admin发起
+	OpIssue       = "issue"
+	OpRegisterKey = "registerkey"
+)
+
+// SignatureArg is the request arg carrying the ed25519 signature of the request digest
+const SignatureArg = "signature"
+
+var (
+	// ErrSignatureRequired is returned when the caller has a registered key but the request is not signed
+	ErrSignatureRequired = errors.New("signature required")
+	// ErrInvalidSignature is returned when the request signature does not match the key of the caller
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// RequestDigest returns the digest to be signed by the caller, it covers the operation,
+// the method and all the args except the signature in key order
+func RequestDigest(op, method string, args map[string][]byte) []byte {
+	keys := make([]string, 0, len(args))
+	for key := range args {
+		if key != SignatureArg {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	write := func(b []byte) {
+		var lenbuf [binary.MaxVarintLen64]byte
+		n := binary.PutUvarint(lenbuf[:], uint64(len(b)))
+		h.Write(lenbuf[:n])
+		h.Write(b)
+	}
+	write([]byte(op))
+	write([]byte(method))
+	for _, key := range keys {
+		write([]byte(key))
+		write(args[key])
+	}
+	return h.Sum(nil)
+}
+
+// SignRequest signs the request with the private key of the caller and stores the signature in args
+func SignRequest(privkey ed25519.PrivateKey, op, method string, args map[string][]byte) {
+	args[SignatureArg] = ed25519.Sign(privkey, RequestDigest(op, method, args))
+}
+
+// GetAccountKey returns the registered public key of the account, nil if the account has none
+func GetAccountKey(store db.KVStore, account string) (ed25519.PublicKey, error) {
+	buf, err := store.Get(util.AccountKeyKey(account))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 {
+		return nil, nil
+	}
+	return ed25519.PublicKey(buf), nil
+}
+
+// RegisterAccountKey binds the public key to the account, the key of an account can not be replaced
+func RegisterAccountKey(store db.KVStore, account string, pubkey ed25519.PublicKey) error {
+	if account == "" {
+		return errors.New("empty account name")
+	}
+	if len(pubkey) != ed25519.PublicKeySize {
+		return fmt.Errorf("bad ed25519 public key size %d", len(pubkey))
+	}
+	old, err := GetAccountKey(store, account)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		return fmt.Errorf("account %s already has a registered key", account)
+	}
+	return store.Put(util.AccountKeyKey(account), pubkey)
+}
+
+// GetAdmin returns the admin account set at genesis, empty if the database has no admin
+func GetAdmin(store db.KVStore) (string, error) {
+	buf, err := store.Get(util.AdminKey())
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// requireAdmin 检查caller是否为创世时设置的admin
+func requireAdmin(store db.KVStore, caller, action string) error {
+	admin, err := GetAdmin(store)
+	if err != nil {
+		return err
+	}
+	if admin == "" || admin != caller {
+		return fmt.Errorf("%s is not the admin, only the admin can %s", caller, action)
+	}
+	return nil
+}
+
+// authenticate 校验请求的签名，注册了公钥的caller必须签名，
+// requireSig为true时未注册公钥的caller不能发起请求
+func authenticate(store db.KVStore, op, method string, args map[string][]byte, requireSig bool) error {
+	caller := string(args["caller"])
+	if caller == "" {
+		return errors.New("missing contract caller")
+	}
+	pubkey, err := GetAccountKey(store, caller)
+	if err != nil {
+		return err
+	}
+	sign := args[SignatureArg]
+	if pubkey == nil {
+		if requireSig {
+			return fmt.Errorf("%w: caller %s has no registered key", ErrSignatureRequired, caller)
+		}
+		return nil
+	}
+	if sign == nil {
+		return fmt.Errorf("%w: caller %s has a registered key", ErrSignatureRequired, caller)
+	}
+	if !ed25519.Verify(pubkey, RequestDigest(op, method, args), sign) {
+		return fmt.Errorf("%w: caller %s", ErrInvalidSignature, caller)
+	}
+	return nil
+}
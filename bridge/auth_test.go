@@ -0,0 +1,265 @@
+package bridge
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// registerTestKey 直接写入账户公钥，不经过admin的注册
+func registerTestKey(t *testing.T, b *Bridge, account string) ed25519.PrivateKey {
+	pubkey, privkey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws := NewWriteSet(b.db)
+	if err = RegisterAccountKey(ws, account, pubkey); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+	return privkey
+}
+
+func withTestNonce(args map[string][]byte, nonce uint64) map[string][]byte {
+	args[NonceArg] = []byte(strconv.FormatUint(nonce, 10))
+	return args
+}
+
+func TestRegisterAccountKeyOnce(t *testing.T) {
+	b, _, _ := newTestBridge()
+	registerTestKey(t, b, "alice")
+	pubkey, _, _ := ed25519.GenerateKey(nil)
+	if err := RegisterAccountKey(NewWriteSet(b.db), "alice", pubkey); err == nil {
+		t.Fatal("expect the registered key not to be replaced")
+	}
+
+	// 并发注册同一个账户时只有先提交的成功
+	first, second := NewWriteSet(b.db), NewWriteSet(b.db)
+	if err := RegisterAccountKey(first, "bob", pubkey); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterAccountKey(second, "bob", pubkey); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.CommitRWSet(first.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+	var conflict *ErrVersionConflict
+	if err := b.CommitRWSet(second.RWSet()); !errors.As(err, &conflict) {
+		t.Fatalf("expect version conflict, got %v", err)
+	}
+}
+
+func TestTransferRequiresSignatureAndNonce(t *testing.T) {
+	b, _, _ := newTestBridge()
+	privkey := registerTestKey(t, b, "alice")
+	issueTestTokens(t, b, "alice", 100)
+	if err := b.Transfer(map[string][]byte{"to": []byte("alice"), "amount": []byte("100")}); err == nil {
+		t.Fatal("expect a transfer without source account to fail")
+	}
+
+	transfer := func(nonce uint64, amount string) map[string][]byte {
+		return withTestNonce(map[string][]byte{
+			"caller": []byte("alice"),
+			"to":     []byte("bob"),
+			"amount": []byte(amount),
+		}, nonce)
+	}
+	if err := b.Transfer(transfer(1, "10")); !errors.Is(err, ErrSignatureRequired) {
+		t.Fatalf("expect signature required, got %v", err)
+	}
+	forged := transfer(1, "10")
+	SignRequest(privkey, OpTransfer, "", forged)
+	forged["amount"] = []byte("90")
+	if err := b.Transfer(forged); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expect invalid signature, got %v", err)
+	}
+	// 其他类型请求的签名不能用于转账
+	other := transfer(1, "10")
+	SignRequest(privkey, OpInvoke, "", other)
+	if err := b.Transfer(other); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expect invalid signature, got %v", err)
+	}
+
+	signed := transfer(1, "10")
+	SignRequest(privkey, OpTransfer, "", signed)
+	if err := b.Transfer(signed); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Transfer(signed); !errors.Is(err, ErrBadNonce) {
+		t.Fatalf("expect replay to fail with bad nonce, got %v", err)
+	}
+
+	// 余额不足的转账失败，但nonce被消耗
+	failed := transfer(2, "1000")
+	SignRequest(privkey, OpTransfer, "", failed)
+	if err := b.Transfer(failed); !errors.Is(err, ErrBalanceNotEnough) {
+		t.Fatalf("expect balance not enough, got %v", err)
+	}
+	if nonce, _ := b.GetNonce("alice"); nonce != 2 {
+		t.Fatalf("failed transfer should consume the nonce, got %d", nonce)
+	}
+
+	for account, expect := range map[string]string{"alice": "90", "bob": "10"} {
+		balance, err := b.GetBalance(account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.String() != expect {
+			t.Errorf("balance of %s: %s, expect %s", account, balance, expect)
+		}
+	}
+}
+
+func TestIssueRequiresAdmin(t *testing.T) {
+	b, _, _ := newTestBridge()
+	pubkey, privkey, _ := ed25519.GenerateKey(nil)
+	if err := b.InitAdmin("root", pubkey); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.InitAdmin("mallory", pubkey); err == nil {
+		t.Fatal("expect the admin not to be replaced")
+	}
+	if admin, _ := b.GetAdmin(); admin != "root" {
+		t.Fatalf("admin is %q", admin)
+	}
+
+	issue := func(caller string, nonce uint64) map[string][]byte {
+		return withTestNonce(map[string][]byte{
+			"caller": []byte(caller),
+			"to":     []byte("alice"),
+			"amount": []byte("100"),
+		}, nonce)
+	}
+	if err := b.Issue(issue("root", 1)); !errors.Is(err, ErrSignatureRequired) {
+		t.Fatalf("expect signature required, got %v", err)
+	}
+	if err := b.Issue(issue("", 1)); err == nil {
+		t.Fatal("expect an issue without caller to fail")
+	}
+	// 其他账户不能增发
+	if err := b.Issue(issue("bob", 1)); err == nil {
+		t.Fatal("expect only the admin to issue")
+	}
+	signed := issue("root", 1)
+	SignRequest(privkey, OpIssue, "", signed)
+	if err := b.Issue(signed); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Issue(signed); !errors.Is(err, ErrBadNonce) {
+		t.Fatalf("expect replay to fail with bad nonce, got %v", err)
+	}
+	expectBalance(t, b, "alice", 100)
+	expectBalance(t, b, "bob", 0)
+
+	// 创世之后不能再设置admin
+	b, _, _ = newTestBridge()
+	issueTestTokens(t, b, "alice", 1)
+	if err := b.InitAdmin("root", pubkey); err == nil {
+		t.Fatal("expect the admin to be set only at genesis")
+	}
+}
+
+func TestRegisterAccountKeyRequiresAdmin(t *testing.T) {
+	b, _, _ := newTestBridge()
+	adminPub, adminPriv, _ := ed25519.GenerateKey(nil)
+	if err := b.InitAdmin("root", adminPub); err != nil {
+		t.Fatal(err)
+	}
+	pubkey, _, _ := ed25519.GenerateKey(nil)
+	register := func(caller, account string, nonce uint64) map[string][]byte {
+		return withTestNonce(map[string][]byte{
+			"caller":     []byte(caller),
+			"account":    []byte(account),
+			"public_key": pubkey,
+		}, nonce)
+	}
+
+	// 没有公钥的已有账户不能被其他人抢先注册
+	issueTestTokens(t, b, "alice", 100)
+	if err := b.RegisterAccountKey(register("mallory", "alice", 1)); err == nil {
+		t.Fatal("expect only the admin to register keys")
+	}
+	if err := b.RegisterAccountKey(register("alice", "alice", 1)); err == nil {
+		t.Fatal("expect an account not to register its own key")
+	}
+	if key, _ := b.GetAccountKey("alice"); key != nil {
+		t.Fatal("expect alice to have no key")
+	}
+	if err := b.RegisterAccountKey(register("root", "alice", 1)); !errors.Is(err, ErrSignatureRequired) {
+		t.Fatalf("expect signature required, got %v", err)
+	}
+
+	signed := register("root", "alice", 1)
+	SignRequest(adminPriv, OpRegisterKey, "", signed)
+	if err := b.RegisterAccountKey(signed); err != nil {
+		t.Fatal(err)
+	}
+	if key, _ := b.GetAccountKey("alice"); string(key) != string(pubkey) {
+		t.Fatal("expect the key of alice to be registered")
+	}
+	// 已注册的公钥不能被替换
+	signed = register("root", "alice", 2)
+	SignRequest(adminPriv, OpRegisterKey, "", signed)
+	if err := b.RegisterAccountKey(signed); err == nil {
+		t.Fatal("expect the registered key not to be replaced")
+	}
+}
+
+func TestSetContractACLRequiresSignatureAndNonce(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	privkey := registerTestKey(t, b, "alice")
+	executor.deploy(t, b, &pb.ContractDesc{Name: "token", Deployer: "alice"}, func(s *SyscallService, ctx *ContractState) error {
+		return okResponse(s, ctx, "")
+	})
+	aclBuf, _ := proto.Marshal(&pb.ContractACL{
+		Methods: []*pb.MethodACL{{Method: "mint", Callers: []string{"alice"}}},
+	})
+	setACL := func(caller string, nonce uint64) map[string][]byte {
+		return withTestNonce(map[string][]byte{
+			"contract_name": []byte("token"),
+			"caller":        []byte(caller),
+			"acl":           aclBuf,
+		}, nonce)
+	}
+
+	if err := b.SetContractACL(setACL("alice", 1)); !errors.Is(err, ErrSignatureRequired) {
+		t.Fatalf("expect signature required, got %v", err)
+	}
+	// 非部署者的请求被拒绝，nonce同样被消耗
+	if err := b.SetContractACL(setACL("bob", 1)); err == nil {
+		t.Fatal("expect only the deployer to set the acl")
+	}
+	if nonce, _ := b.GetNonce("bob"); nonce != 1 {
+		t.Fatalf("denied request should consume the nonce, got %d", nonce)
+	}
+
+	signed := setACL("alice", 1)
+	SignRequest(privkey, OpSetACL, "", signed)
+	if err := b.SetContractACL(signed); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.SetContractACL(signed); !errors.Is(err, ErrBadNonce) {
+		t.Fatalf("expect replay to fail with bad nonce, got %v", err)
+	}
+
+	acl, err := b.GetContractACL("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(acl.GetMethods()) != 1 || acl.GetContract() != "token" {
+		t.Fatalf("bad acl %v", acl)
+	}
+	if _, _, err = invoke(vm, &ContractState{ContractName: "token", Method: "mint", Caller: "bob"}); !errors.Is(err, ErrAccessDenied) {
+		t.Fatalf("expect access denied, got %v", err)
+	}
+	if _, _, err = invoke(vm, &ContractState{ContractName: "token", Method: "mint", Caller: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+}
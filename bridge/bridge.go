@@ -1,11 +1,18 @@
 package bridge
 
 import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/common/util"
 	"github.com/BeDreamCoder/uwavm/contract/go/pb"
 	"github.com/BeDreamCoder/uwavm/vm/gas"
+	"github.com/golang/protobuf/proto"
 )
 
 // Executor 为用户态虚拟机工厂类
@@ -36,9 +43,22 @@ type Contract interface {
 	ReleaseCache() error
 }
 
+// CallContract 执行合约调用并返回读写集合，读写集合由调用方通过Bridge.CommitRWSet校验提交。
+// 调用和升级在消耗nonce之后失败时同时返回error和只包含nonce的读写集合，调用方同样需要提交，防止失败的请求被重放。
+// 资源消耗超出limits时返回*gas.ErrOutOfGas，其中包含实际的消耗
 type CallContract interface {
-	DeployContract(args map[string][]byte) (*pb.Response, gas.Limits, error)
-	InvokeContract(method string, args map[string][]byte) (*pb.Response, gas.Limits, error)
+	DeployContract(args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *RWSet, error)
+	InvokeContract(method string, args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *RWSet, error)
+	// UpgradeContract 替换合约代码并保留合约状态，只有部署者可以升级
+	UpgradeContract(args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *RWSet, error)
+	// QueryContract 以只读方式调用合约，合约中的写操作都会失败，不产生需要提交的写集合
+	QueryContract(method string, args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, error)
+}
+
+// CodeListener is optionally implemented by the Executors caching compiled codes,
+// ContractCodeCommitted is called after a deploy or upgrade of the contract is committed
+type CodeListener interface {
+	ContractCodeCommitted(name string, codeHash []byte)
 }
 
 // VirtualMachine define virtual machine interface
@@ -48,11 +68,20 @@ type VirtualMachine interface {
 	CallContract
 }
 
+// DefaultMaxCallDepth is the default maximum depth of nested contract calls,
+// the contract called by the transaction is at depth 1
+const DefaultMaxCallDepth = 16
+
 // Bridge 用于注册用户虚拟机以及向Xchain Core注册可被识别的vm.VirtualMachine
 type Bridge struct {
-	state   *StateManager
-	syscall *SyscallService
-	vms     map[string]VirtualMachine
+	db        db.Database
+	state     *StateManager
+	syscall   *SyscallService
+	committer *versionCommitter
+	vms       map[string]VirtualMachine
+
+	maxCallDepth int
+	requireSig   bool
 }
 
 var bridgeInstance *Bridge
@@ -63,10 +92,14 @@ func GetBridge(db db.Database) *Bridge {
 	bridgeOnce.Do(func() {
 		state := NewStateManager()
 		bridgeInstance = &Bridge{
-			state:   state,
-			syscall: NewSyscallService(state, db),
-			vms:     make(map[string]VirtualMachine),
+			db:        db,
+			state:     state,
+			committer: &versionCommitter{db: db},
+			vms:       make(map[string]VirtualMachine),
+
+			maxCallDepth: DefaultMaxCallDepth,
 		}
+		bridgeInstance.syscall = NewSyscallService(state, bridgeInstance, db)
 	})
 	return bridgeInstance
 }
@@ -74,17 +107,250 @@ func GetBridge(db db.Database) *Bridge {
 // RegisterExecutor register a Executor to Bridge
 func (v *Bridge) RegisterExecutor(name string, exec Executor) VirtualMachine {
 	wraper := &vmImpl{
-		state: v.state,
-		name:  name,
-		exec:  exec,
+		db:     v.db,
+		state:  v.state,
+		name:   name,
+		exec:   exec,
+		bridge: v,
 	}
 	exec.RegisterSyscallService(v.syscall)
 	v.vms[name] = wraper
 	return wraper
 }
 
+// SetMaxCallDepth sets the maximum depth of nested contract calls
+func (v *Bridge) SetMaxCallDepth(depth int) {
+	v.maxCallDepth = depth
+}
+
+// MaxCallDepth returns the maximum depth of nested contract calls
+func (v *Bridge) MaxCallDepth() int {
+	return v.maxCallDepth
+}
+
+// SetRequireSignature makes every request signed, callers without a registered key are rejected.
+// Otherwise only the callers with a registered key have to sign
+func (v *Bridge) SetRequireSignature(require bool) {
+	v.requireSig = require
+}
+
+// RequireSignature reports whether every request must be signed
+func (v *Bridge) RequireSignature() bool {
+	return v.requireSig
+}
+
+// RegisterAccountKey binds the ed25519 public key to an account without a key, only the admin can register keys
+// so that nobody claims an existing account. Like Issue args carries the admin as caller, account, public_key,
+// nonce and signature. 同时注册同一个账户时只有先提交的成功
+func (v *Bridge) RegisterAccountKey(args map[string][]byte) error {
+	caller, account := string(args["caller"]), string(args["account"])
+	pubkey := ed25519.PublicKey(args["public_key"])
+	return v.execRequest(OpRegisterKey, args, func(ws *WriteSet) error {
+		if err := requireAdmin(ws, caller, "register account keys"); err != nil {
+			return err
+		}
+		return RegisterAccountKey(ws, account, pubkey)
+	})
+}
+
+// GetAccountKey returns the registered public key of the account, nil if the account has none
+func (v *Bridge) GetAccountKey(account string) (ed25519.PublicKey, error) {
+	return GetAccountKey(NewWriteSet(v.db), account)
+}
+
+// GetNonce returns the nonce of the last invocation of the account, the next invocation must carry it plus one
+func (v *Bridge) GetNonce(account string) (uint64, error) {
+	return GetNonce(NewWriteSet(v.db), account)
+}
+
 // GetVirtualMachine returns a contract.VirtualMachine from the given name
 func (v *Bridge) GetVirtualMachine(name string) (VirtualMachine, bool) {
 	vm, ok := v.vms[name]
 	return vm, ok
 }
+
+// CommitRWSet validates the versions of the reads and commits the writes atomically,
+// a nil rwset of a failed call commits nothing
+func (v *Bridge) CommitRWSet(rwset *RWSet) error {
+	if rwset == nil {
+		return nil
+	}
+	if err := v.committer.commit(rwset); err != nil {
+		return err
+	}
+	v.notifyCodeCommitted(rwset)
+	return nil
+}
+
+// notifyCodeCommitted 通知Executor合约的代码已经替换，旧代码不会再被调用
+func (v *Bridge) notifyCodeCommitted(rwset *RWSet) {
+	start, limit := util.ContractCodeDescRange()
+	for _, write := range rwset.Writes {
+		if write.IsDelete || bytes.Compare(write.Key, start) < 0 || bytes.Compare(write.Key, limit) >= 0 {
+			continue
+		}
+		desc := new(pb.ContractDesc)
+		if err := proto.Unmarshal(write.Value, desc); err != nil {
+			continue
+		}
+		for _, vm := range v.vms {
+			impl, ok := vm.(*vmImpl)
+			if !ok {
+				continue
+			}
+			if listener, ok := impl.exec.(CodeListener); ok {
+				listener.ContractCodeCommitted(desc.GetName(), desc.GetCodeHash())
+			}
+		}
+	}
+}
+
+// GetContractDesc returns the descriptor of the deployed contract
+func (v *Bridge) GetContractDesc(name string) (*pb.ContractDesc, error) {
+	return GetContractDesc(NewWriteSet(v.db), name)
+}
+
+// ListContracts returns the descriptors of the deployed contracts matching the filter
+func (v *Bridge) ListContracts(filter ContractFilter) ([]*pb.ContractDesc, error) {
+	return ListContracts(v.db, filter)
+}
+
+// GetContractHistory returns the descriptors of all the versions of the contract
+func (v *Bridge) GetContractHistory(name string) ([]*pb.ContractDesc, error) {
+	return GetContractHistory(v.db, name)
+}
+
+// GetContractACL returns the method access control list of the contract, nil if the contract has none
+func (v *Bridge) GetContractACL(name string) (*pb.ContractACL, error) {
+	if _, err := GetContractDesc(NewWriteSet(v.db), name); err != nil {
+		return nil, err
+	}
+	return GetContractACL(NewWriteSet(v.db), name)
+}
+
+// SetContractACL replaces the method access control list of the contract, only the deployer of the contract can set it.
+// Like a contract request, args carries contract_name, caller, nonce, signature and the protobuf encoded acl
+func (v *Bridge) SetContractACL(args map[string][]byte) error {
+	return v.execRequest(OpSetACL, args, func(ws *WriteSet) error {
+		acl := new(pb.ContractACL)
+		if err := proto.Unmarshal(args["acl"], acl); err != nil {
+			return err
+		}
+		acl.Contract = string(args["contract_name"])
+		desc, err := GetContractDesc(ws, acl.GetContract())
+		if err != nil {
+			return err
+		}
+		if caller := string(args["caller"]); desc.GetDeployer() != caller {
+			return fmt.Errorf("only the deployer %s can set the acl of contract %s", desc.GetDeployer(), acl.GetContract())
+		}
+		return PutContractACL(ws, acl)
+	})
+}
+
+// QueryEvents returns the committed contract events filtered by contract and event name,
+// an empty filter matches all
+func (v *Bridge) QueryEvents(contract, name string) ([]*pb.ContractEvent, error) {
+	return queryEvents(v.db, contract, name)
+}
+
+// GetBalance returns the native token balance of the account
+func (v *Bridge) GetBalance(account string) (*big.Int, error) {
+	return NewLedger(NewWriteSet(v.db)).GetBalance(account)
+}
+
+// Transfer moves native tokens between accounts outside of contracts, like a contract request args carries
+// the caller as the source account, to, amount, nonce and signature. New tokens are issued by Issue
+func (v *Bridge) Transfer(args map[string][]byte) error {
+	amount, err := ParseAmount(string(args["amount"]))
+	if err != nil {
+		return err
+	}
+	from, to := string(args["caller"]), string(args["to"])
+	if from == "" {
+		return errors.New("missing transfer source account")
+	}
+	return v.execRequest(OpTransfer, args, func(ws *WriteSet) error {
+		return NewLedger(ws).Transfer(from, to, amount)
+	})
+}
+
+// InitAdmin registers the key of the admin account which issues native tokens,
+// 只能在创世时即数据库没有任何提交之前设置，之后不能更换
+func (v *Bridge) InitAdmin(account string, pubkey ed25519.PublicKey) error {
+	seq, err := v.db.Get(util.VersionSeqKey())
+	if err != nil {
+		return err
+	}
+	if len(seq) != 0 {
+		return errors.New("the admin can only be set at genesis")
+	}
+	ws := NewWriteSet(v.db)
+	admin, err := GetAdmin(ws)
+	if err != nil {
+		return err
+	}
+	if admin != "" {
+		return fmt.Errorf("the admin has been set to %s", admin)
+	}
+	if err = RegisterAccountKey(ws, account, pubkey); err != nil {
+		return err
+	}
+	if err = ws.Put(util.AdminKey(), []byte(account)); err != nil {
+		return err
+	}
+	return v.CommitRWSet(ws.RWSet())
+}
+
+// GetAdmin returns the admin account, empty if the database has no admin
+func (v *Bridge) GetAdmin() (string, error) {
+	return GetAdmin(NewWriteSet(v.db))
+}
+
+// Issue issues new native tokens to the target, only the admin can issue.
+// Like Transfer args carries the admin as caller, to, amount, nonce and signature
+func (v *Bridge) Issue(args map[string][]byte) error {
+	amount, err := ParseAmount(string(args["amount"]))
+	if err != nil {
+		return err
+	}
+	caller, to := string(args["caller"]), string(args["to"])
+	// admin的公钥在创世时注册，authenticate总是要求admin签名
+	return v.execRequest(OpIssue, args, func(ws *WriteSet) error {
+		if err := requireAdmin(ws, caller, "issue tokens"); err != nil {
+			return err
+		}
+		return NewLedger(ws).Transfer("", to, amount)
+	})
+}
+
+// execRequest 校验请求的签名并消耗caller的nonce后执行apply，
+// apply失败时只提交nonce，失败的请求同样不能被重放
+func (v *Bridge) execRequest(op string, args map[string][]byte, apply func(ws *WriteSet) error) error {
+	ws := NewWriteSet(v.db)
+	if err := authenticate(ws, op, "", args, v.requireSig); err != nil {
+		return err
+	}
+	nonce, err := ParseNonce(args)
+	if err != nil {
+		return err
+	}
+	if err = UseNonce(ws, string(args["caller"]), nonce); err != nil {
+		return err
+	}
+	update := ws.Fork()
+	err = apply(update)
+	if err == nil {
+		update.Commit()
+	}
+	if cerr := v.CommitRWSet(ws.RWSet()); cerr != nil {
+		return cerr
+	}
+	return err
+}
+
+// MigrateKeyLayout rewrites the data of the older key layouts to the current layout,
+// deployer becomes the deployer of the legacy contracts which did not record one
+func (v *Bridge) MigrateKeyLayout(deployer string) (int, int, error) {
+	return MigrateKeyLayout(v.db, deployer)
+}
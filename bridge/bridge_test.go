@@ -0,0 +1,125 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/common/db/memorydb"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+)
+
+// testContract 以Go函数模拟合约，通过SyscallService发起系统调用
+type testContract func(s *SyscallService, ctx *ContractState) error
+
+// testExecutor 执行注册的testContract，没有wasm虚拟机
+type testExecutor struct {
+	CallContract
+	syscall   *SyscallService
+	contracts map[string]testContract
+}
+
+func (e *testExecutor) RegisterSyscallService(syscall *SyscallService) {
+	e.syscall = syscall
+}
+
+func (e *testExecutor) NewCreatorInstance(ctx *ContractState) (Instance, error) {
+	contract, ok := e.contracts[ctx.ContractName]
+	if !ok {
+		return nil, errors.New("contract not found")
+	}
+	return &testInstance{
+		syscall:  e.syscall,
+		ctx:      ctx,
+		contract: contract,
+	}, nil
+}
+
+type testInstance struct {
+	syscall  *SyscallService
+	ctx      *ContractState
+	contract testContract
+}
+
+func (i *testInstance) Exec(function string) error {
+	return i.contract(i.syscall, i.ctx)
+}
+
+func (i *testInstance) ResourceUsed() gas.Limits {
+	used := i.ctx.SyscallResourceUsed
+	used.Add(i.ctx.SubResourceUsed)
+	return used
+}
+
+func (i *testInstance) Release() {}
+
+func (i *testInstance) Abort(msg string) {}
+
+// newTestBridge 创建基于内存数据库的Bridge，不使用全局的GetBridge
+func newTestBridge() (*Bridge, *testExecutor, VirtualMachine) {
+	database := memorydb.NewMemDB()
+	state := NewStateManager()
+	b := &Bridge{
+		db:        database,
+		state:     state,
+		committer: &versionCommitter{db: database},
+		vms:       make(map[string]VirtualMachine),
+
+		maxCallDepth: DefaultMaxCallDepth,
+	}
+	b.syscall = NewSyscallService(state, b, database)
+	executor := &testExecutor{
+		contracts: make(map[string]testContract),
+	}
+	return b, executor, b.RegisterExecutor("wasm", executor)
+}
+
+// deploy 注册合约并保存描述信息
+func (e *testExecutor) deploy(t *testing.T, b *Bridge, desc *pb.ContractDesc, contract testContract) {
+	e.contracts[desc.GetName()] = contract
+	if desc.Version == 0 {
+		desc.Version = 1
+	}
+	ws := NewWriteSet(b.db)
+	if err := PutContractDesc(ws, desc); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// invoke 以state调用合约，返回合约调用的结果及其根WriteSet
+func invoke(vm VirtualMachine, state *ContractState) (*pb.Response, *WriteSet, error) {
+	root := state.WriteSet
+	if root == nil {
+		root = NewWriteSet(vm.(*vmImpl).db)
+	}
+	state.WriteSet = root.Fork()
+	if state.Method == "" {
+		state.Method = "invoke"
+	}
+	ctx, err := vm.NewVM(state)
+	if err != nil {
+		return nil, root, err
+	}
+	defer ctx.ReleaseCache()
+	resp, err := ctx.Invoke(state.Method, nil)
+	return resp, root, err
+}
+
+func okResponse(s *SyscallService, ctx *ContractState, body string) error {
+	_, err := s.SetOutput(context.Background(), &pb.SetOutputRequest{
+		Header: header(ctx),
+		Response: &pb.Response{
+			Status: 200,
+			Body:   []byte(body),
+		},
+	})
+	return err
+}
+
+func header(ctx *ContractState) *pb.SyscallHeader {
+	return &pb.SyscallHeader{Ctxid: ctx.ID}
+}
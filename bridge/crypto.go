@@ -0,0 +1,104 @@
+package bridge
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"math/big"
+
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+)
+
+// 密码学系统调用按输入字节数计费，单位为cpu
+const (
+	hashBaseCost        = 1000
+	verifyPerByteCost   = 20
+	ed25519VerifyCost   = 500000
+	secp256k1VerifyCost = 1000000
+)
+
+type hashAlgorithm struct {
+	newHash     func() hash.Hash
+	perByteCost int64
+}
+
+var hashAlgorithms = map[string]hashAlgorithm{
+	"sha256":    {sha256.New, 20},
+	"keccak256": {sha3.NewLegacyKeccak256, 20},
+	"ripemd160": {ripemd160.New, 30},
+}
+
+// hashCost returns the cpu cost of hashing n bytes, the cost is charged before the computation
+func hashCost(algorithm string, n int) (gas.Limits, error) {
+	alg, ok := hashAlgorithms[algorithm]
+	if !ok {
+		return gas.Limits{}, fmt.Errorf("unsupported hash algorithm:%s", algorithm)
+	}
+	return gas.Limits{
+		Cpu: hashBaseCost + alg.perByteCost*int64(n),
+	}, nil
+}
+
+// hashData returns the digest of data, the algorithm must have been checked by hashCost
+func hashData(algorithm string, data []byte) []byte {
+	h := hashAlgorithms[algorithm].newHash()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// verifyCost returns the cpu cost of verifying the signature of a n bytes message
+func verifyCost(algorithm string, n int) (gas.Limits, error) {
+	cost := gas.Limits{
+		Cpu: verifyPerByteCost * int64(n),
+	}
+	switch algorithm {
+	case "ed25519":
+		cost.Cpu += ed25519VerifyCost
+	case "secp256k1":
+		cost.Cpu += secp256k1VerifyCost
+	default:
+		return gas.Limits{}, fmt.Errorf("unsupported signature algorithm:%s", algorithm)
+	}
+	return cost, nil
+}
+
+// verifySignature checks the signature of msg, a malformed public key or signature is treated as invalid.
+// The algorithm must have been checked by verifyCost
+func verifySignature(algorithm string, pubkey, msg, sign []byte) bool {
+	if algorithm == "secp256k1" {
+		return verifySecp256k1(pubkey, msg, sign)
+	}
+	if len(pubkey) != ed25519.PublicKeySize || len(sign) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(ed25519.PublicKey(pubkey), msg, sign)
+}
+
+// verifySecp256k1 accepts compressed or uncompressed public key,
+// and DER encoded or 64 bytes r||s signature of a 32 bytes digest
+func verifySecp256k1(pubkey, digest, sign []byte) bool {
+	if len(digest) != 32 {
+		return false
+	}
+	key, err := btcec.ParsePubKey(pubkey, btcec.S256())
+	if err != nil {
+		return false
+	}
+	var sig *btcec.Signature
+	if len(sign) == 64 {
+		sig = &btcec.Signature{
+			R: new(big.Int).SetBytes(sign[:32]),
+			S: new(big.Int).SetBytes(sign[32:]),
+		}
+	} else {
+		sig, err = btcec.ParseDERSignature(sign, btcec.S256())
+		if err != nil {
+			return false
+		}
+	}
+	return sig.Verify(digest, key)
+}
@@ -0,0 +1,107 @@
+package bridge
+
+import (
+	"fmt"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// GetContractDesc returns the descriptor of the deployed contract
+func GetContractDesc(store db.KVStore, name string) (*pb.ContractDesc, error) {
+	buf, err := store.Get(util.ContractCodeDescKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 {
+		return nil, fmt.Errorf("contract %s not found", name)
+	}
+	return decodeContractDesc(name, buf)
+}
+
+func decodeContractDesc(name string, buf []byte) (*pb.ContractDesc, error) {
+	desc := new(pb.ContractDesc)
+	if err := proto.Unmarshal(buf, desc); err != nil {
+		// 旧版本只保存了合约的语言
+		return &pb.ContractDesc{
+			Name:     name,
+			Language: string(buf),
+		}, nil
+	}
+	return desc, nil
+}
+
+// PutContractDesc saves the descriptor of the contract and records it in the version history,
+// 描述信息与合约代码写入同一个WriteSet，部署和升级随合约的读写集合一同提交
+func PutContractDesc(store db.KVStore, desc *pb.ContractDesc) error {
+	buf, err := proto.Marshal(desc)
+	if err != nil {
+		return err
+	}
+	if err = store.Put(util.ContractHistoryKey(desc.GetName(), desc.GetVersion()), buf); err != nil {
+		return err
+	}
+	return store.Put(util.ContractCodeDescKey(desc.GetName()), buf)
+}
+
+// GetContractHistory returns the descriptors of all the versions of the contract in version order
+func GetContractHistory(database db.Database, name string) ([]*pb.ContractDesc, error) {
+	start, limit := util.ContractHistoryRange(name)
+	iter, err := NewWriteSet(database).NewIterator(start, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+
+	var history []*pb.ContractDesc
+	for iter.Next() {
+		desc := new(pb.ContractDesc)
+		if err := proto.Unmarshal(iter.Value(), desc); err != nil {
+			return nil, err
+		}
+		history = append(history, desc)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// ContractFilter selects contracts by language and deployer, an empty field matches all
+type ContractFilter struct {
+	Language string
+	Deployer string
+}
+
+// ListContracts returns the descriptors of the deployed contracts in name order,
+// 合约描述信息以合约名为key保存，本身即为已部署合约的索引
+func ListContracts(database db.Database, filter ContractFilter) ([]*pb.ContractDesc, error) {
+	start, limit := util.ContractCodeDescRange()
+	iter, err := NewWriteSet(database).NewIterator(start, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+
+	var contracts []*pb.ContractDesc
+	for iter.Next() {
+		name := string(iter.Key()[len(start):])
+		desc, err := decodeContractDesc(name, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		if filter.Language != "" && desc.GetLanguage() != filter.Language {
+			continue
+		}
+		if filter.Deployer != "" && desc.GetDeployer() != filter.Deployer {
+			continue
+		}
+		contracts = append(contracts, desc)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, err
+	}
+	return contracts, nil
+}
@@ -0,0 +1,52 @@
+package bridge
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+)
+
+func TestListContracts(t *testing.T) {
+	b, executor, _ := newTestBridge()
+	for _, desc := range []*pb.ContractDesc{
+		{Name: "token", Language: "c", Deployer: "alice"},
+		{Name: "market", Language: "go", Deployer: "alice"},
+		{Name: "game", Language: "c", Deployer: "bob"},
+	} {
+		executor.deploy(t, b, desc, nil)
+	}
+	// 旧版本的描述信息只有合约的语言
+	ws := NewWriteSet(b.db)
+	ws.Put(util.ContractCodeDescKey("legacy"), []byte("c"))
+	// 其他合约的状态不在索引中
+	ws.Put(util.ContractStateKey("token", []byte("key")), []byte("value"))
+	if err := b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		filter ContractFilter
+		expect []string
+	}{
+		{ContractFilter{}, []string{"game", "legacy", "market", "token"}},
+		{ContractFilter{Language: "c"}, []string{"game", "legacy", "token"}},
+		{ContractFilter{Deployer: "alice"}, []string{"market", "token"}},
+		{ContractFilter{Language: "c", Deployer: "alice"}, []string{"token"}},
+		{ContractFilter{Language: "cpp"}, nil},
+	}
+	for _, c := range cases {
+		contracts, err := b.ListContracts(c.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, desc := range contracts {
+			names = append(names, desc.GetName())
+		}
+		if !reflect.DeepEqual(names, c.expect) {
+			t.Errorf("filter %+v: got %v, expect %v", c.filter, names, c.expect)
+		}
+	}
+}
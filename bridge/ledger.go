@@ -0,0 +1,91 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/common/util"
+)
+
+var (
+	// ErrBalanceNotEnough is returned when the account balance is less than the transfer amount
+	ErrBalanceNotEnough = errors.New("balance not enough")
+	// ErrNegativeAmount is returned when the transfer amount is negative
+	ErrNegativeAmount = errors.New("amount should not be negative")
+)
+
+// Ledger 为内置的原生代币账本，余额以十进制字符串保存在保留的命名空间下
+type Ledger struct {
+	store db.KVStore
+}
+
+// NewLedger instances a new Ledger, contracts operate the ledger through their WriteSet
+func NewLedger(store db.KVStore) *Ledger {
+	return &Ledger{store: store}
+}
+
+// ParseAmount parses a decimal amount string
+func ParseAmount(amount string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("bad amount:%s", amount)
+	}
+	if value.Sign() < 0 {
+		return nil, ErrNegativeAmount
+	}
+	return value, nil
+}
+
+// GetBalance returns the balance of the account, zero if the account does not exist
+func (l *Ledger) GetBalance(account string) (*big.Int, error) {
+	value, err := l.store.Get(util.BalanceKey(account))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return new(big.Int), nil
+	}
+	balance, ok := new(big.Int).SetString(string(value), 10)
+	if !ok {
+		return nil, fmt.Errorf("bad balance of account %s", account)
+	}
+	return balance, nil
+}
+
+func (l *Ledger) setBalance(account string, balance *big.Int) error {
+	return l.store.Put(util.BalanceKey(account), []byte(balance.String()))
+}
+
+// Transfer moves amount from one account to another.
+// 如果from为空表示增发，直接增加to的余额
+func (l *Ledger) Transfer(from, to string, amount *big.Int) error {
+	if to == "" {
+		return errors.New("empty transfer target")
+	}
+	if amount.Sign() < 0 {
+		return ErrNegativeAmount
+	}
+	if from == to || amount.Sign() == 0 {
+		return nil
+	}
+
+	toBalance, err := l.GetBalance(to)
+	if err != nil {
+		return err
+	}
+	if from != "" {
+		fromBalance, err := l.GetBalance(from)
+		if err != nil {
+			return err
+		}
+		if fromBalance.Cmp(amount) < 0 {
+			return ErrBalanceNotEnough
+		}
+		if err = l.setBalance(from, fromBalance.Sub(fromBalance, amount)); err != nil {
+			return err
+		}
+	}
+	return l.setBalance(to, toBalance.Add(toBalance, amount))
+}
@@ -0,0 +1,116 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+)
+
+func transfer(s *SyscallService, ctx *ContractState, from, to, amount string) error {
+	_, err := s.Transfer(context.Background(), &pb.TransferRequest{
+		Header: header(ctx),
+		From:   from,
+		To:     to,
+		Amount: amount,
+	})
+	return err
+}
+
+func expectBalance(t *testing.T, b *Bridge, account string, expect int64) {
+	t.Helper()
+	balance, err := b.GetBalance(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Cmp(big.NewInt(expect)) != 0 {
+		t.Errorf("balance of %s is %s, expect %d", account, balance, expect)
+	}
+}
+
+// issueTestTokens 直接写入账本，不经过admin的增发
+func issueTestTokens(t *testing.T, b *Bridge, to string, amount int64) {
+	ws := NewWriteSet(b.db)
+	if err := NewLedger(ws).Transfer("", to, big.NewInt(amount)); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLedgerTransfer(t *testing.T) {
+	b, _, _ := newTestBridge()
+	ws := NewWriteSet(b.db)
+	ledger := NewLedger(ws)
+	// from为空表示增发
+	if err := ledger.Transfer("", "alice", big.NewInt(100)); err != nil {
+		t.Fatal(err)
+	}
+	if err := ledger.Transfer("alice", "bob", big.NewInt(30)); err != nil {
+		t.Fatal(err)
+	}
+	if err := ledger.Transfer("alice", "bob", big.NewInt(71)); !errors.Is(err, ErrBalanceNotEnough) {
+		t.Errorf("expect balance not enough, got %v", err)
+	}
+	if err := ledger.Transfer("alice", "bob", big.NewInt(-1)); !errors.Is(err, ErrNegativeAmount) {
+		t.Errorf("expect negative amount, got %v", err)
+	}
+	if err := ledger.Transfer("alice", "", big.NewInt(1)); err == nil {
+		t.Error("expect an empty target to fail")
+	}
+	if err := b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+	expectBalance(t, b, "alice", 70)
+	expectBalance(t, b, "bob", 30)
+	expectBalance(t, b, "carol", 0)
+
+	for _, amount := range []string{"-1", "1.5", "abc", ""} {
+		if _, err := ParseAmount(amount); err == nil {
+			t.Errorf("expect amount %q to be rejected", amount)
+		}
+	}
+}
+
+func TestTransferSyscall(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	var errs []error
+	executor.deploy(t, b, &pb.ContractDesc{Name: "token"}, func(s *SyscallService, ctx *ContractState) error {
+		errs = []error{
+			transfer(s, ctx, "", "alice", "30"),
+			transfer(s, ctx, "token", "bob", "20"),
+			// 只能转出合约自己的余额
+			transfer(s, ctx, "alice", "bob", "1"),
+			transfer(s, ctx, "", "bob", "51"),
+			transfer(s, ctx, "", "bob", "-1"),
+		}
+		return okResponse(s, ctx, "")
+	})
+	issueTestTokens(t, b, "token", 100)
+
+	_, root, err := invoke(vm, &ContractState{ContractName: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = b.CommitRWSet(root.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil || errs[1] != nil {
+		t.Fatalf("transfer failed: %v %v", errs[0], errs[1])
+	}
+	if errs[2] == nil {
+		t.Error("expect transfer from another account to fail")
+	}
+	if !errors.Is(errs[3], ErrBalanceNotEnough) {
+		t.Errorf("expect balance not enough, got %v", errs[3])
+	}
+	if !errors.Is(errs[4], ErrNegativeAmount) {
+		t.Errorf("expect negative amount, got %v", errs[4])
+	}
+	expectBalance(t, b, "token", 50)
+	expectBalance(t, b, "alice", 30)
+	expectBalance(t, b, "bob", 20)
+}
@@ -0,0 +1,159 @@
+package bridge
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/common/log"
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// 旧版本的key格式，合约状态的key为"<contract>-<key>"
+const (
+	legacyCodePrefix = "contract-"
+	legacyDescPrefix = "contractdesc-"
+
+	// 版本2的合约代码、描述信息和状态都带有版本号，由第一次提交写入
+	keyLayoutVersion = "2"
+)
+
+// MigrateKeyLayout rewrites the data of the legacy layout to the current layout in one batch, all the values
+// are stored with a version and the legacy descriptors are converted with deployer as their deployer.
+// It returns the number of migrated keys and the keys which can not be recognized
+func MigrateKeyLayout(database db.Database, deployer string) (int, int, error) {
+	iteratee, ok := database.(db.Iteratee)
+	if !ok {
+		return 0, 0, errors.New("database does not support iterator")
+	}
+	batcher, ok := database.(db.Batcher)
+	if !ok {
+		return 0, 0, errors.New("database does not support batch")
+	}
+	layout, err := database.Get(util.LayoutVersionKey())
+	if err != nil {
+		return 0, 0, err
+	}
+	if string(layout) == keyLayoutVersion {
+		return 0, 0, nil
+	}
+
+	type kv struct {
+		key, value []byte
+	}
+	var legacy []kv
+	contracts := make(map[string]bool)
+	// 转换描述信息需要合约代码
+	codes := make(map[string][]byte)
+	iter := iteratee.NewIterator(nil, nil)
+	for iter.Next() {
+		key := append([]byte(nil), iter.Key()...)
+		if util.IsLayoutKey(key) {
+			continue
+		}
+		value := append([]byte(nil), iter.Value()...)
+		legacy = append(legacy, kv{key, value})
+		if strings.HasPrefix(string(key), legacyDescPrefix) {
+			contracts[strings.TrimPrefix(string(key), legacyDescPrefix)] = true
+		}
+		if strings.HasPrefix(string(key), legacyCodePrefix) {
+			codes[strings.TrimPrefix(string(key), legacyCodePrefix)] = value
+		}
+	}
+	err = iter.Error()
+	iter.Release()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// 迁移作为一次提交，所有的值使用同一个新版本
+	version, err := committedVersion(database)
+	if err != nil {
+		return 0, 0, err
+	}
+	version++
+
+	batch := batcher.NewBatch()
+	migrated, skipped := 0, 0
+	for _, item := range legacy {
+		key := string(item.key)
+		newKey := migrateLegacyKey(key, contracts)
+		if newKey == nil {
+			log.GetLogger().Warn("unrecognized key, skip", "key", key)
+			skipped++
+			continue
+		}
+		if strings.HasPrefix(key, legacyDescPrefix) {
+			// 旧版本的描述信息只有合约的语言
+			desc, err := convertLegacyDesc(strings.TrimPrefix(key, legacyDescPrefix), string(item.value), codes, deployer)
+			if err != nil {
+				return 0, 0, err
+			}
+			buf, err := proto.Marshal(desc)
+			if err != nil {
+				return 0, 0, err
+			}
+			batch.Put(newKey, encodeVersionedValue(version, buf))
+			batch.Put(util.ContractHistoryKey(desc.GetName(), desc.GetVersion()), encodeVersionedValue(version, buf))
+		} else {
+			batch.Put(newKey, encodeVersionedValue(version, item.value))
+		}
+		batch.Delete(item.key)
+		migrated++
+	}
+	seqBuf := make([]byte, versionLen)
+	binary.BigEndian.PutUint64(seqBuf, version)
+	batch.Put(util.VersionSeqKey(), seqBuf)
+	batch.Put(util.LayoutVersionKey(), []byte(keyLayoutVersion))
+	if err = batch.Write(); err != nil {
+		return 0, 0, err
+	}
+	return migrated, skipped, nil
+}
+
+// convertLegacyDesc 补全旧版本描述信息中缺少的代码哈希、大小和部署者
+func convertLegacyDesc(name, language string, codes map[string][]byte, deployer string) (*pb.ContractDesc, error) {
+	if deployer == "" {
+		return nil, fmt.Errorf("legacy contract %s has no deployer, provide the deployer of the legacy contracts", name)
+	}
+	code, ok := codes[name]
+	if !ok {
+		return nil, fmt.Errorf("code of legacy contract %s not found", name)
+	}
+	hash := sha256.Sum256(code)
+	return &pb.ContractDesc{
+		Name:     name,
+		Language: language,
+		CodeHash: hash[:],
+		CodeSize: int64(len(code)),
+		Deployer: deployer,
+		Version:  1,
+	}, nil
+}
+
+// migrateLegacyKey 旧格式本身是有歧义的，合约代码和描述信息的key优先，
+// 合约状态的key按照已部署的合约中最长的名字匹配
+func migrateLegacyKey(key string, contracts map[string]bool) []byte {
+	switch {
+	case strings.HasPrefix(key, legacyDescPrefix):
+		return util.ContractCodeDescKey(strings.TrimPrefix(key, legacyDescPrefix))
+	case strings.HasPrefix(key, legacyCodePrefix) && contracts[strings.TrimPrefix(key, legacyCodePrefix)]:
+		return util.ContractCodeKey(strings.TrimPrefix(key, legacyCodePrefix))
+	}
+
+	contract := ""
+	for name := range contracts {
+		if len(name) > len(contract) && strings.HasPrefix(key, name+"-") {
+			contract = name
+		}
+	}
+	if contract == "" {
+		return nil
+	}
+	return util.ContractStateKey(contract, []byte(key[len(contract)+1:]))
+}
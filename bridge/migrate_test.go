@@ -0,0 +1,131 @@
+package bridge
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+)
+
+// migrateTestBridge 在迁移后的数据库上创建Bridge，legacy为旧版本写入的原始key-value
+func migrateTestBridge(t *testing.T, legacy map[string]string, deployer string) (*Bridge, *testExecutor, VirtualMachine) {
+	b, executor, vm := newTestBridge()
+	for key, value := range legacy {
+		if err := b.db.Put([]byte(key), []byte(value)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := b.MigrateKeyLayout(deployer); err != nil {
+		t.Fatal(err)
+	}
+	return b, executor, vm
+}
+
+// getObjectContract 返回合约状态key的值
+func getObjectContract(key string) testContract {
+	return func(s *SyscallService, ctx *ContractState) error {
+		resp, err := s.GetObject(context.Background(), &pb.GetRequest{
+			Header: header(ctx),
+			Key:    []byte(key),
+		})
+		if err != nil {
+			return err
+		}
+		return okResponse(s, ctx, string(resp.GetValue()))
+	}
+}
+
+func TestMigrateThenInvoke(t *testing.T) {
+	code := "legacy wasm code"
+	b, executor, vm := migrateTestBridge(t, map[string]string{
+		"contract-erc20":     code,
+		"contractdesc-erc20": "c",
+		"erc20-alice":        "100",
+	}, "alice")
+
+	executor.contracts["erc20"] = getObjectContract("alice")
+	resp, root, err := invoke(vm, &ContractState{ContractName: "erc20", Limits: gas.MaxLimits})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.GetBody()) != "100" {
+		t.Fatalf("state after migrate %q", resp.GetBody())
+	}
+	// 迁移后的值带有版本号，提交之后读到的数据不变
+	if err = b.CommitRWSet(root.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := b.GetContractDesc("erc20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte(code))
+	if desc.GetLanguage() != "c" || desc.GetDeployer() != "alice" || desc.GetVersion() != 1 ||
+		string(desc.GetCodeHash()) != string(hash[:]) || desc.GetCodeSize() != int64(len(code)) {
+		t.Fatalf("bad desc after migrate %+v", desc)
+	}
+	history, err := b.GetContractHistory("erc20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].GetVersion() != 1 {
+		t.Fatalf("bad history after migrate %v", history)
+	}
+	storedCode, err := NewWriteSet(b.db).Get(util.ContractCodeKey("erc20"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(storedCode) != code {
+		t.Fatalf("code after migrate %q", storedCode)
+	}
+	contracts, err := b.ListContracts(ContractFilter{Deployer: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contracts) != 1 {
+		t.Fatalf("list after migrate %v", contracts)
+	}
+
+	// 再次迁移不做任何修改
+	migrated, _, err := b.MigrateKeyLayout("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if migrated != 0 {
+		t.Fatalf("migrated %d keys again", migrated)
+	}
+}
+
+func TestMigrateContractNamedBalance(t *testing.T) {
+	// 名为balance的合约的状态只属于合约，不会变成账本余额
+	b, executor, vm := migrateTestBridge(t, map[string]string{
+		"contract-balance":     "code",
+		"contractdesc-balance": "c",
+		"balance-alice":        "100",
+	}, "alice")
+
+	executor.contracts["balance"] = getObjectContract("alice")
+	resp, _, err := invoke(vm, &ContractState{ContractName: "balance", Limits: gas.MaxLimits})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.GetBody()) != "100" {
+		t.Fatalf("state after migrate %q", resp.GetBody())
+	}
+	expectBalance(t, b, "alice", 0)
+}
+
+func TestMigrateRequiresLegacyDeployer(t *testing.T) {
+	b, _, _ := newTestBridge()
+	b.db.Put([]byte("contract-erc20"), []byte("code"))
+	b.db.Put([]byte("contractdesc-erc20"), []byte("c"))
+	if _, _, err := b.MigrateKeyLayout(""); err == nil {
+		t.Fatal("expect legacy contracts to require a deployer")
+	}
+	if layout, _ := b.db.Get(util.LayoutVersionKey()); layout != nil {
+		t.Fatal("failed migration should not write anything")
+	}
+}
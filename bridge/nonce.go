@@ -0,0 +1,55 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/common/util"
+)
+
+// NonceArg is the request arg carrying the nonce of the caller
+const NonceArg = "nonce"
+
+// ErrBadNonce is returned when the nonce of the request is not the next nonce of the caller
+var ErrBadNonce = errors.New("bad nonce")
+
+// GetNonce returns the nonce of the last invocation of the account, zero if the account has never invoked.
+// nonce以十进制字符串保存在保留的命名空间下，与合约的写集合一同提交
+func GetNonce(store db.KVStore, account string) (uint64, error) {
+	value, err := store.Get(util.NonceKey(account))
+	if err != nil {
+		return 0, err
+	}
+	if len(value) == 0 {
+		return 0, nil
+	}
+	nonce, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad nonce of account %s", account)
+	}
+	return nonce, nil
+}
+
+// ParseNonce returns the nonce carried by the request args
+func ParseNonce(args map[string][]byte) (uint64, error) {
+	nonce, err := strconv.ParseUint(string(args[NonceArg]), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrBadNonce, args[NonceArg])
+	}
+	return nonce, nil
+}
+
+// UseNonce records the nonce of the account, it must be exactly the next nonce,
+// stale or duplicated nonces are rejected
+func UseNonce(store db.KVStore, account string, nonce uint64) error {
+	current, err := GetNonce(store, account)
+	if err != nil {
+		return err
+	}
+	if nonce != current+1 {
+		return fmt.Errorf("%w: account %s expects nonce %d, got %d", ErrBadNonce, account, current+1, nonce)
+	}
+	return store.Put(util.NonceKey(account), []byte(strconv.FormatUint(nonce, 10)))
+}
@@ -0,0 +1,292 @@
+package bridge
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+const versionLen = 8
+
+// tombstoneFlag 标记被删除的key，删除的key保留删除时的版本号，
+// 读到不存在的key之后该key被创建又删除时版本号不会回到0
+const tombstoneFlag = uint64(1) << 63
+
+// KVRead records a key read by the contract and the version it has seen,
+// version 0 means the key did not exist
+type KVRead struct {
+	Key     []byte
+	Version uint64
+}
+
+// KVWrite records a key written by the contract
+type KVWrite struct {
+	Key      []byte
+	Value    []byte
+	IsDelete bool
+}
+
+// KVRange records a key range [Start, Limit) iterated by the contract, a nil Limit means no upper bound.
+// Version is the latest committed version when the range was iterated
+type KVRange struct {
+	Start   []byte
+	Limit   []byte
+	Version uint64
+}
+
+// Contains reports whether the key is in the range
+func (r *KVRange) Contains(key []byte) bool {
+	if bytes.Compare(key, r.Start) < 0 {
+		return false
+	}
+	return r.Limit == nil || bytes.Compare(key, r.Limit) < 0
+}
+
+// RWSet 为一次合约调用的读写集合，用于乐观并发控制下的校验与提交。
+// 提交时校验Reads的版本，并重新扫描Ranges，范围内有比迭代时更新的key(包括新增和删除)即为冲突
+type RWSet struct {
+	Reads  []*KVRead
+	Ranges []*KVRange
+	Writes []*KVWrite
+	Events []*pb.ContractEvent
+}
+
+// ErrVersionConflict indicates that a key read by the transaction has been changed,
+// or a key in a range iterated by the transaction has been inserted, updated or deleted
+type ErrVersionConflict struct {
+	Key      []byte
+	Expected uint64
+	Actual   uint64
+}
+
+// Error implements error interface
+func (e *ErrVersionConflict) Error() string {
+	return fmt.Sprintf("version conflict on key [%s], read version:%d current version:%d",
+		string(e.Key), e.Expected, e.Actual)
+}
+
+func encodeVersionedValue(version uint64, value []byte) []byte {
+	buf := make([]byte, versionLen+len(value))
+	binary.BigEndian.PutUint64(buf, version)
+	copy(buf[versionLen:], value)
+	return buf
+}
+
+func encodeTombstone(version uint64) []byte {
+	buf := make([]byte, versionLen)
+	binary.BigEndian.PutUint64(buf, version|tombstoneFlag)
+	return buf
+}
+
+// decodeVersionedValue returns the version and value, the value of a tombstone is nil
+func decodeVersionedValue(buf []byte) (uint64, []byte, error) {
+	if len(buf) < versionLen {
+		return 0, nil, fmt.Errorf("bad versioned value, length:%d", len(buf))
+	}
+	version := binary.BigEndian.Uint64(buf)
+	if version&tombstoneFlag != 0 {
+		return version &^ tombstoneFlag, nil, nil
+	}
+	return version, buf[versionLen:], nil
+}
+
+// getVersioned returns the value and version of the key, a nil value with version 0 if not found,
+// a nil value with the version of the deletion if it has been deleted
+func getVersioned(store db.KVStore, key []byte) ([]byte, uint64, error) {
+	buf, err := store.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	if buf == nil {
+		return nil, 0, nil
+	}
+	version, value, err := decodeVersionedValue(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	return value, version, nil
+}
+
+// committedVersion returns the version of the latest commit, 0 if nothing has been committed
+func committedVersion(store db.KVStore) (uint64, error) {
+	buf, err := store.Get(util.VersionSeqKey())
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	if len(buf) != versionLen {
+		return 0, fmt.Errorf("bad version sequence, length:%d", len(buf))
+	}
+	return binary.BigEndian.Uint64(buf), nil
+}
+
+// versionCommitter 校验读集合并以新的版本号原子提交写集合
+type versionCommitter struct {
+	db    db.Database
+	mutex sync.Mutex
+}
+
+func (c *versionCommitter) validate(rwset *RWSet) error {
+	for _, read := range rwset.Reads {
+		_, version, err := getVersioned(c.db, read.Key)
+		if err != nil {
+			return err
+		}
+		if version != read.Version {
+			return &ErrVersionConflict{
+				Key:      read.Key,
+				Expected: read.Version,
+				Actual:   version,
+			}
+		}
+	}
+	if len(rwset.Ranges) == 0 {
+		return nil
+	}
+	iteratee, ok := c.db.(db.Iteratee)
+	if !ok {
+		return errors.New("database does not support iterator")
+	}
+	for _, r := range rwset.Ranges {
+		if err := validateRange(iteratee, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRange 重新扫描迭代过的范围，范围内的key及墓碑的版本都不能晚于迭代时的版本
+func validateRange(iteratee db.Iteratee, r *KVRange) error {
+	iter := iteratee.NewIterator(r.Start, r.Limit)
+	defer iter.Release()
+	for iter.Next() {
+		version, _, err := decodeVersionedValue(iter.Value())
+		if err != nil {
+			return err
+		}
+		if version > r.Version {
+			return &ErrVersionConflict{
+				Key:      append([]byte(nil), iter.Key()...),
+				Expected: r.Version,
+				Actual:   version,
+			}
+		}
+	}
+	return iter.Error()
+}
+
+func (c *versionCommitter) nextVersion() (uint64, error) {
+	version, err := committedVersion(c.db)
+	if err != nil {
+		return 0, err
+	}
+	return version + 1, nil
+}
+
+func (c *versionCommitter) commit(rwset *RWSet) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err := c.validate(rwset); err != nil {
+		return err
+	}
+	if len(rwset.Writes) == 0 && len(rwset.Events) == 0 {
+		return nil
+	}
+
+	version, err := c.nextVersion()
+	if err != nil {
+		return err
+	}
+	seq := make([]byte, versionLen)
+	binary.BigEndian.PutUint64(seq, version)
+	// 新数据库的第一次提交记录key格式的版本，迁移工具据此跳过
+	newDatabase := version == 1
+
+	events := make([][]byte, len(rwset.Events))
+	for i, event := range rwset.Events {
+		if events[i], err = proto.Marshal(event); err != nil {
+			return err
+		}
+	}
+
+	batcher, ok := c.db.(db.Batcher)
+	if !ok {
+		for _, write := range rwset.Writes {
+			if write.IsDelete {
+				err = c.db.Put(write.Key, encodeTombstone(version))
+			} else {
+				err = c.db.Put(write.Key, encodeVersionedValue(version, write.Value))
+			}
+			if err != nil {
+				return err
+			}
+		}
+		for i, event := range events {
+			if err = c.db.Put(util.EventKey(version, uint32(i)), event); err != nil {
+				return err
+			}
+		}
+		if newDatabase {
+			if err = c.db.Put(util.LayoutVersionKey(), []byte(keyLayoutVersion)); err != nil {
+				return err
+			}
+		}
+		return c.db.Put(util.VersionSeqKey(), seq)
+	}
+	batch := batcher.NewBatch()
+	for _, write := range rwset.Writes {
+		if write.IsDelete {
+			batch.Put(write.Key, encodeTombstone(version))
+		} else {
+			batch.Put(write.Key, encodeVersionedValue(version, write.Value))
+		}
+	}
+	for i, event := range events {
+		batch.Put(util.EventKey(version, uint32(i)), event)
+	}
+	if newDatabase {
+		batch.Put(util.LayoutVersionKey(), []byte(keyLayoutVersion))
+	}
+	batch.Put(util.VersionSeqKey(), seq)
+	return batch.Write()
+}
+
+// queryEvents returns the committed events in commit order,
+// empty contract or name matches all
+func queryEvents(database db.Database, contract, name string) ([]*pb.ContractEvent, error) {
+	iteratee, ok := database.(db.Iteratee)
+	if !ok {
+		return nil, errors.New("database does not support iterator")
+	}
+	start, limit := util.EventKeyRange()
+	iter := iteratee.NewIterator(start, limit)
+	defer iter.Release()
+
+	var events []*pb.ContractEvent
+	for iter.Next() {
+		event := new(pb.ContractEvent)
+		if err := proto.Unmarshal(iter.Value(), event); err != nil {
+			return nil, err
+		}
+		if contract != "" && event.GetContract() != contract {
+			continue
+		}
+		if name != "" && event.GetName() != name {
+			continue
+		}
+		events = append(events, event)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
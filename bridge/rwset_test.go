@@ -0,0 +1,169 @@
+package bridge
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRWSetRecordsReadVersions(t *testing.T) {
+	b, _, _ := newTestBridge()
+	ws := NewWriteSet(b.db)
+	ws.Put([]byte("k1"), []byte("v1"))
+	if err := b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+
+	ws = NewWriteSet(b.db)
+	child := ws.Fork()
+	// 子WriteSet的读操作记录在共享的读集合中，读自己写入的key不记录
+	for _, key := range []string{"k1", "k2"} {
+		if _, err := child.Get([]byte(key)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	child.Put([]byte("k3"), []byte("v3"))
+	if _, err := child.Get([]byte("k3")); err != nil {
+		t.Fatal(err)
+	}
+	child.Delete([]byte("k1"))
+	child.Commit()
+
+	rwset := ws.RWSet()
+	if len(rwset.Reads) != 2 || string(rwset.Reads[0].Key) != "k1" || rwset.Reads[0].Version != 1 ||
+		string(rwset.Reads[1].Key) != "k2" || rwset.Reads[1].Version != 0 {
+		t.Fatalf("unexpected reads %+v %+v", rwset.Reads[0], rwset.Reads[1])
+	}
+	if len(rwset.Writes) != 2 || !rwset.Writes[0].IsDelete || string(rwset.Writes[1].Value) != "v3" {
+		t.Fatalf("unexpected writes %+v", rwset.Writes)
+	}
+	if err := b.CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+	value, version, err := getVersioned(b.db, []byte("k3"))
+	if err != nil || string(value) != "v3" || version != 2 {
+		t.Fatalf("k3: value %q version %d error %v", value, version, err)
+	}
+	if value, _, _ = getVersioned(b.db, []byte("k1")); value != nil {
+		t.Fatalf("expect k1 to be deleted, got %q", value)
+	}
+}
+
+func TestCommitRWSetVersionConflict(t *testing.T) {
+	b, _, _ := newTestBridge()
+	first, second := NewWriteSet(b.db), NewWriteSet(b.db)
+	for _, ws := range []*WriteSet{first, second} {
+		value, err := ws.Get([]byte("counter"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ws.Put([]byte("counter"), append(value, '+'))
+	}
+	if err := b.CommitRWSet(first.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+	var conflict *ErrVersionConflict
+	err := b.CommitRWSet(second.RWSet())
+	if !errors.As(err, &conflict) || conflict.Expected != 0 || conflict.Actual != 1 {
+		t.Fatalf("expect version conflict, got %v", err)
+	}
+	// 冲突的写集合不会提交
+	value, _, _ := getVersioned(b.db, []byte("counter"))
+	if string(value) != "+" {
+		t.Fatalf("counter is %q", value)
+	}
+
+	// 只写不读的写集合不会冲突
+	blind := NewWriteSet(b.db)
+	blind.Put([]byte("counter"), []byte("reset"))
+	if err = b.CommitRWSet(blind.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCommitRWSetRangeConflict(t *testing.T) {
+	b, _, _ := newTestBridge()
+	commit := func(apply func(ws *WriteSet)) {
+		ws := NewWriteSet(b.db)
+		apply(ws)
+		if err := b.CommitRWSet(ws.RWSet()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	commit(func(ws *WriteSet) {
+		ws.Put([]byte("r/1"), []byte("1"))
+		ws.Put([]byte("r/3"), []byte("3"))
+	})
+	// scan 迭代r/范围并写入汇总，不读取单个key
+	scan := func() *WriteSet {
+		ws := NewWriteSet(b.db)
+		iter, err := ws.NewIterator([]byte("r/"), []byte("r/\xff"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for iter.Next() {
+			n++
+		}
+		iter.Release()
+		ws.Put([]byte("sum"), []byte{byte(n)})
+		return ws
+	}
+
+	cases := []struct {
+		name     string
+		apply    func(ws *WriteSet)
+		conflict bool
+	}{
+		{"outside", func(ws *WriteSet) { ws.Put([]byte("s/1"), []byte("1")) }, false},
+		{"insert", func(ws *WriteSet) { ws.Put([]byte("r/2"), []byte("2")) }, true},
+		{"update", func(ws *WriteSet) { ws.Put([]byte("r/3"), []byte("33")) }, true},
+		{"delete", func(ws *WriteSet) { ws.Delete([]byte("r/1")) }, true},
+	}
+	for _, c := range cases {
+		ws := scan()
+		commit(c.apply)
+		var conflict *ErrVersionConflict
+		err := b.CommitRWSet(ws.RWSet())
+		if c.conflict != errors.As(err, &conflict) {
+			t.Errorf("%s: unexpected commit result %v", c.name, err)
+		}
+	}
+
+	// 删除的key不会出现在迭代结果中
+	ws := scan()
+	if err := b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+	value, _, _ := getVersioned(b.db, []byte("sum"))
+	if len(value) != 1 || value[0] != 2 {
+		t.Fatalf("expect 2 keys in the range, got %v", value)
+	}
+}
+
+func TestDeletedKeyKeepsVersion(t *testing.T) {
+	b, _, _ := newTestBridge()
+	// 读到不存在的key之后，该key被创建又删除
+	reader := NewWriteSet(b.db)
+	if value, err := reader.Get([]byte("key")); err != nil || value != nil {
+		t.Fatalf("value %q error %v", value, err)
+	}
+	reader.Put([]byte("other"), []byte("1"))
+	for _, apply := range []func(ws *WriteSet){
+		func(ws *WriteSet) { ws.Put([]byte("key"), []byte("1")) },
+		func(ws *WriteSet) { ws.Delete([]byte("key")) },
+	} {
+		ws := NewWriteSet(b.db)
+		apply(ws)
+		if err := b.CommitRWSet(ws.RWSet()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	value, version, err := getVersioned(b.db, []byte("key"))
+	if err != nil || value != nil || version != 2 {
+		t.Fatalf("deleted key: value %q version %d error %v", value, version, err)
+	}
+	var conflict *ErrVersionConflict
+	if err = b.CommitRWSet(reader.RWSet()); !errors.As(err, &conflict) {
+		t.Fatalf("expect version conflict, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
 )
 
 // ContractState 保存了合约执行的内核状态，
@@ -21,7 +22,46 @@ type ContractState struct {
 
 	Caller string
 
+	// 执行环境，由调用方在发起合约调用时指定
+	Env *pb.Environment
+
 	Output *pb.Response
+
+	// 合约调用的写集合，成功后才提交
+	WriteSet *WriteSet
+
+	// 合约调用的资源上限，包括跨合约调用和系统调用的消耗
+	Limits gas.Limits
+
+	// 只读调用中所有的写操作都会失败，跨合约调用同样只读
+	ReadOnly bool
+
+	// 调用栈，按调用顺序记录当前合约之前的所有合约，交易直接调用的合约为空
+	CallStack []string
+
+	// 跨合约调用产生的资源消耗，计入当前合约
+	SubResourceUsed gas.Limits
+
+	// 系统调用产生的cpu及存储消耗，计入当前合约
+	SyscallResourceUsed gas.Limits
+}
+
+// Depth returns the depth of the contract in the call stack, starting from 1
+func (c *ContractState) Depth() int {
+	return len(c.CallStack) + 1
+}
+
+// OnCallStack reports whether the contract is being executed by the current call chain
+func (c *ContractState) OnCallStack(contract string) bool {
+	if c.ContractName == contract {
+		return true
+	}
+	for _, name := range c.CallStack {
+		if name == contract {
+			return true
+		}
+	}
+	return false
 }
 
 // StateManager 用于管理产生和销毁ContractState
@@ -9,18 +9,53 @@ import (
 	"sort"
 
 	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/common/util"
 	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+)
+
+const (
+	maxIteratorCap = 1000
+)
+
+var (
+	// ErrReadOnly is returned when a contract tries to write in a read-only call
+	ErrReadOnly = errors.New("write operation is not allowed in read-only call")
+	// ErrCallDepthExceeded is returned when nested contract calls go deeper than the max call depth
+	ErrCallDepthExceeded = errors.New("max call depth exceeded")
+	// ErrReentrantCall is returned when a non-reentrant contract is called while it is on the call stack
+	ErrReentrantCall = errors.New("reentrant call")
 )
 
 // SyscallService is the handler of contract syscalls
 type SyscallService struct {
-	state *StateManager
-	db    db.Database
+	state  *StateManager
+	bridge *Bridge
+	db     db.Database
 }
 
 // NewSyscallService instances a new SyscallService
-func NewSyscallService(state *StateManager, db db.Database) *SyscallService {
-	return &SyscallService{state, db}
+func NewSyscallService(state *StateManager, bridge *Bridge, db db.Database) *SyscallService {
+	return &SyscallService{state, bridge, db}
+}
+
+// ChargeResource adds the resource used by a syscall to the contract,
+// an error is returned if the resource used by syscalls and sub contract calls exceeds the limits
+func (c *SyscallService) ChargeResource(ctxid int64, limits gas.Limits) error {
+	nctx, ok := c.state.GetContractState(ctxid)
+	if !ok {
+		return fmt.Errorf("bad cts id:%d", ctxid)
+	}
+	nctx.SyscallResourceUsed.Add(limits)
+	used := nctx.SyscallResourceUsed
+	used.Add(nctx.SubResourceUsed)
+	if used.Exceed(nctx.Limits) {
+		return &gas.ErrOutOfGas{
+			Limits: nctx.Limits,
+			Used:   used,
+		}
+	}
+	return nil
 }
 
 // PutObject implements Syscall interface
@@ -29,13 +64,16 @@ func (c *SyscallService) PutObject(ctx context.Context, in *pb.PutRequest) (*pb.
 	if !ok {
 		return nil, fmt.Errorf("bad cts id:%d", in.Header.Ctxid)
 	}
+	if nctx.ReadOnly {
+		return nil, ErrReadOnly
+	}
 	if in.Value == nil {
 		return nil, errors.New("put nil value")
 	}
-	compk := fmt.Sprintf("%s-%s", nctx.ContractName, string(in.Key))
-	err := c.db.Put([]byte(compk), in.Value)
+	compk := util.ContractStateKey(nctx.ContractName, in.Key)
+	err := nctx.WriteSet.Put(compk, in.Value)
 	if err != nil {
-		return nil, errors.New(fmt.Sprintf("Failed to PutObject for key:[%s],value:[%s]", compk, string(in.Value)))
+		return nil, errors.New(fmt.Sprintf("Failed to PutObject for contract:[%s],key:[%s],value:[%s]", nctx.ContractName, string(in.Key), string(in.Value)))
 	}
 
 	return &pb.PutResponse{}, nil
@@ -47,10 +85,10 @@ func (c *SyscallService) GetObject(ctx context.Context, in *pb.GetRequest) (*pb.
 	if !ok {
 		return nil, fmt.Errorf("bad cts id:%d", in.Header.Ctxid)
 	}
-	compk := fmt.Sprintf("%s-%s", nctx.ContractName, string(in.Key))
-	value, err := c.db.Get([]byte(compk))
+	compk := util.ContractStateKey(nctx.ContractName, in.Key)
+	value, err := nctx.WriteSet.Get(compk)
 	if err != nil {
-		return nil, errors.New(fmt.Sprintf("Cant GetObject for key: [%s]", compk))
+		return nil, errors.New(fmt.Sprintf("Cant GetObject for contract:[%s],key:[%s]", nctx.ContractName, string(in.Key)))
 	}
 	return &pb.GetResponse{
 		Value: value,
@@ -63,11 +101,53 @@ func (c *SyscallService) DeleteObject(ctx context.Context, in *pb.DeleteRequest)
 	if !ok {
 		return nil, fmt.Errorf("bad cts id:%d", in.Header.Ctxid)
 	}
-	compk := fmt.Sprintf("%s-%s", nctx.ContractName, string(in.Key))
-	err := c.db.Delete([]byte(compk))
+	if nctx.ReadOnly {
+		return nil, ErrReadOnly
+	}
+	compk := util.ContractStateKey(nctx.ContractName, in.Key)
+	err := nctx.WriteSet.Delete(compk)
 	return &pb.DeleteResponse{}, err
 }
 
+// NewIterator implements Syscall interface
+func (c *SyscallService) NewIterator(ctx context.Context, in *pb.IteratorRequest) (*pb.IteratorResponse, error) {
+	nctx, ok := c.state.GetContractState(in.GetHeader().Ctxid)
+	if !ok {
+		return nil, fmt.Errorf("bad cts id:%d", in.Header.Ctxid)
+	}
+	batch := in.GetCap()
+	if batch <= 0 || batch > maxIteratorCap {
+		batch = maxIteratorCap
+	}
+
+	// 迭代范围限定在当前合约的命名空间内
+	prefix := util.ContractStatePrefix(nctx.ContractName)
+	start := util.ContractStateKey(nctx.ContractName, in.GetStart())
+	var end []byte
+	if len(in.GetLimit()) == 0 {
+		_, end = util.ContractStateRange(nctx.ContractName)
+	} else {
+		end = util.ContractStateKey(nctx.ContractName, in.GetLimit())
+	}
+
+	iter, err := nctx.WriteSet.NewIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	out := new(pb.IteratorResponse)
+	for int32(len(out.Items)) < batch && iter.Next() {
+		out.Items = append(out.Items, &pb.IteratorItem{
+			Key:   append([]byte(nil), iter.Key()[len(prefix):]...),
+			Value: append([]byte(nil), iter.Value()...),
+		})
+	}
+	if err = iter.Error(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GetCallArgs implements Syscall interface
 func (c *SyscallService) GetCallArgs(ctx context.Context, in *pb.GetCallArgsRequest) (*pb.CallArgs, error) {
 	nctx, ok := c.state.GetContractState(in.GetHeader().Ctxid)
@@ -91,6 +171,15 @@ func (c *SyscallService) GetCallArgs(ctx context.Context, in *pb.GetCallArgsRequ
 	}, nil
 }
 
+// GetEnvironment implements Syscall interface
+func (c *SyscallService) GetEnvironment(ctx context.Context, in *pb.GetEnvironmentRequest) (*pb.Environment, error) {
+	nctx, ok := c.state.GetContractState(in.GetHeader().Ctxid)
+	if !ok {
+		return nil, fmt.Errorf("bad cts id:%d", in.Header.Ctxid)
+	}
+	return nctx.Env, nil
+}
+
 // SetOutput implements Syscall interface
 func (c *SyscallService) SetOutput(ctx context.Context, in *pb.SetOutputRequest) (*pb.SetOutputResponse, error) {
 	nctx, ok := c.state.GetContractState(in.Header.Ctxid)
@@ -100,3 +189,145 @@ func (c *SyscallService) SetOutput(ctx context.Context, in *pb.SetOutputRequest)
 	nctx.Output = in.GetResponse()
 	return new(pb.SetOutputResponse), nil
 }
+
+// Transfer implements Syscall interface
+func (c *SyscallService) Transfer(ctx context.Context, in *pb.TransferRequest) (*pb.TransferResponse, error) {
+	nctx, ok := c.state.GetContractState(in.GetHeader().Ctxid)
+	if !ok {
+		return nil, fmt.Errorf("bad cts id:%d", in.Header.Ctxid)
+	}
+	if nctx.ReadOnly {
+		return nil, ErrReadOnly
+	}
+	// 合约只能转出自己账户上的余额
+	if in.GetFrom() != "" && in.GetFrom() != nctx.ContractName {
+		return nil, fmt.Errorf("contract %s can not transfer from %s", nctx.ContractName, in.GetFrom())
+	}
+	amount, err := ParseAmount(in.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+	err = NewLedger(nctx.WriteSet).Transfer(nctx.ContractName, in.GetTo(), amount)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TransferResponse{}, nil
+}
+
+// EmitEvent implements Syscall interface
+func (c *SyscallService) EmitEvent(ctx context.Context, in *pb.EmitEventRequest) (*pb.EmitEventResponse, error) {
+	nctx, ok := c.state.GetContractState(in.GetHeader().Ctxid)
+	if !ok {
+		return nil, fmt.Errorf("bad cts id:%d", in.Header.Ctxid)
+	}
+	if nctx.ReadOnly {
+		return nil, ErrReadOnly
+	}
+	if in.GetName() == "" {
+		return nil, errors.New("empty event name")
+	}
+	nctx.WriteSet.AddEvent(&pb.ContractEvent{
+		Contract: nctx.ContractName,
+		Name:     in.GetName(),
+		Body:     in.GetBody(),
+	})
+	return &pb.EmitEventResponse{}, nil
+}
+
+// Hash implements Syscall interface, the cost is charged by the size of the data before hashing
+func (c *SyscallService) Hash(ctx context.Context, in *pb.HashRequest) (*pb.HashResponse, error) {
+	cost, err := hashCost(in.GetAlgorithm(), len(in.GetData()))
+	if err != nil {
+		return nil, err
+	}
+	if err = c.ChargeResource(in.GetHeader().Ctxid, cost); err != nil {
+		return nil, err
+	}
+	return &pb.HashResponse{
+		Digest: hashData(in.GetAlgorithm(), in.GetData()),
+	}, nil
+}
+
+// VerifySignature implements Syscall interface, the cost is charged by the size of the message before verifying
+func (c *SyscallService) VerifySignature(ctx context.Context, in *pb.VerifySignatureRequest) (*pb.VerifySignatureResponse, error) {
+	cost, err := verifyCost(in.GetAlgorithm(), len(in.GetMsg()))
+	if err != nil {
+		return nil, err
+	}
+	if err = c.ChargeResource(in.GetHeader().Ctxid, cost); err != nil {
+		return nil, err
+	}
+	return &pb.VerifySignatureResponse{
+		Valid: verifySignature(in.GetAlgorithm(), in.GetPubkey(), in.GetMsg(), in.GetSign()),
+	}, nil
+}
+
+// ContractCall implements Syscall interface
+func (c *SyscallService) ContractCall(ctx context.Context, in *pb.ContractCallRequest) (*pb.ContractCallResponse, error) {
+	nctx, ok := c.state.GetContractState(in.GetHeader().Ctxid)
+	if !ok {
+		return nil, fmt.Errorf("bad cts id:%d", in.Header.Ctxid)
+	}
+	vm, ok := c.bridge.GetVirtualMachine(in.GetModule())
+	if !ok {
+		return nil, fmt.Errorf("vm module %s not found", in.GetModule())
+	}
+	if nctx.Depth() >= c.bridge.MaxCallDepth() {
+		return nil, fmt.Errorf("%w: %d", ErrCallDepthExceeded, c.bridge.MaxCallDepth())
+	}
+	desc, err := GetContractDesc(nctx.WriteSet, in.GetContract())
+	if err != nil {
+		return nil, err
+	}
+	if desc.GetNonReentrant() && nctx.OnCallStack(in.GetContract()) {
+		return nil, fmt.Errorf("%w: contract %s is on the call stack", ErrReentrantCall, in.GetContract())
+	}
+
+	// 调用栈需要复制，避免同一层的多次调用共享底层数组
+	callStack := make([]string, 0, nctx.Depth())
+	callStack = append(callStack, nctx.CallStack...)
+	callStack = append(callStack, nctx.ContractName)
+
+	args := make(map[string][]byte)
+	for _, arg := range in.GetArgs() {
+		args[arg.GetKey()] = arg.GetValue()
+	}
+
+	// 被调合约的资源上限为当前合约剩余的资源，当前合约自身的消耗在执行结束时统一校验
+	used := nctx.SubResourceUsed
+	used.Add(nctx.SyscallResourceUsed)
+	if used.Exceed(nctx.Limits) {
+		return nil, &gas.ErrOutOfGas{
+			Limits: nctx.Limits,
+			Used:   used,
+		}
+	}
+	limits := nctx.Limits
+	limits.Sub(used)
+
+	// 被调合约的Caller为发起调用的合约，Env中的Initiator仍为交易发起者
+	cctx, err := vm.NewVM(&ContractState{
+		ContractName: in.GetContract(),
+		Method:       in.GetMethod(),
+		Language:     desc.GetLanguage(),
+		Caller:       nctx.ContractName,
+		Env:          nctx.Env,
+		WriteSet:     nctx.WriteSet.Fork(),
+		Limits:       limits,
+		ReadOnly:     nctx.ReadOnly,
+		CallStack:    callStack,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cctx.ReleaseCache()
+
+	resp, err := cctx.Invoke(in.GetMethod(), args)
+	nctx.SubResourceUsed.Add(cctx.ResourceUsed())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ContractCallResponse{
+		Response: resp,
+	}, nil
+}
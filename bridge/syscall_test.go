@@ -0,0 +1,596 @@
+package bridge
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestCryptoSyscallsChargeGas(t *testing.T) {
+	data := []byte("hello uwavm")
+	pubkey, privkey, _ := ed25519.GenerateKey(nil)
+	sign := ed25519.Sign(privkey, data)
+	hashCost := int64(hashBaseCost + 20*len(data))
+	verifyCost := int64(ed25519VerifyCost + verifyPerByteCost*len(data))
+
+	cases := []struct {
+		name    string
+		cost    int64
+		syscall func(s *SyscallService, ctx *ContractState) ([]byte, error)
+		expect  []byte
+	}{
+		{
+			name: "hash",
+			cost: hashCost,
+			syscall: func(s *SyscallService, ctx *ContractState) ([]byte, error) {
+				resp, err := s.Hash(context.Background(), &pb.HashRequest{
+					Header:    header(ctx),
+					Algorithm: "sha256",
+					Data:      data,
+				})
+				return resp.GetDigest(), err
+			},
+			expect: func() []byte { h := sha256.Sum256(data); return h[:] }(),
+		},
+		{
+			name: "verify",
+			cost: verifyCost,
+			syscall: func(s *SyscallService, ctx *ContractState) ([]byte, error) {
+				resp, err := s.VerifySignature(context.Background(), &pb.VerifySignatureRequest{
+					Header:    header(ctx),
+					Algorithm: "ed25519",
+					Pubkey:    pubkey,
+					Msg:       data,
+					Sign:      sign,
+				})
+				if resp.GetValid() {
+					return []byte("valid"), err
+				}
+				return nil, err
+			},
+			expect: []byte("valid"),
+		},
+	}
+	for _, c := range cases {
+		b, executor, vm := newTestBridge()
+		var (
+			result     []byte
+			syscallErr error
+			used       gas.Limits
+		)
+		executor.deploy(t, b, &pb.ContractDesc{Name: "crypto"}, func(s *SyscallService, ctx *ContractState) error {
+			result, syscallErr = c.syscall(s, ctx)
+			used = ctx.SyscallResourceUsed
+			if syscallErr != nil {
+				return syscallErr
+			}
+			return okResponse(s, ctx, "")
+		})
+
+		if _, _, err := invoke(vm, &ContractState{ContractName: "crypto", Limits: gas.MaxLimits}); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if string(result) != string(c.expect) {
+			t.Errorf("%s: result %x, expect %x", c.name, result, c.expect)
+		}
+		if used.Cpu != c.cost {
+			t.Errorf("%s: charged %d, expect %d", c.name, used.Cpu, c.cost)
+		}
+
+		// 超出gas上限时系统调用本身失败，不会在合约执行结束后才发现
+		limits := gas.MaxLimits
+		limits.Cpu = c.cost - 1
+		_, _, err := invoke(vm, &ContractState{ContractName: "crypto", Limits: limits})
+		var outOfGas *gas.ErrOutOfGas
+		if !errors.As(syscallErr, &outOfGas) {
+			t.Errorf("%s: expect the syscall to fail with out of gas, got %v", c.name, syscallErr)
+		}
+		if !errors.As(err, &outOfGas) {
+			t.Errorf("%s: expect out of gas, got %v", c.name, err)
+		}
+	}
+}
+
+func TestCryptoSyscallsUnsupportedAlgorithm(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	var hashErr, verifyErr error
+	executor.deploy(t, b, &pb.ContractDesc{Name: "crypto"}, func(s *SyscallService, ctx *ContractState) error {
+		_, hashErr = s.Hash(context.Background(), &pb.HashRequest{
+			Header:    header(ctx),
+			Algorithm: "md5",
+		})
+		_, verifyErr = s.VerifySignature(context.Background(), &pb.VerifySignatureRequest{
+			Header:    header(ctx),
+			Algorithm: "rsa",
+		})
+		if ctx.SyscallResourceUsed != (gas.Limits{}) {
+			return errors.New("unsupported algorithm should not be charged")
+		}
+		return okResponse(s, ctx, "")
+	})
+	if _, _, err := invoke(vm, &ContractState{ContractName: "crypto"}); err != nil {
+		t.Fatal(err)
+	}
+	if hashErr == nil || verifyErr == nil {
+		t.Fatalf("expect unsupported algorithm errors, got %v and %v", hashErr, verifyErr)
+	}
+}
+
+func putObject(s *SyscallService, ctx *ContractState, key, value string) error {
+	_, err := s.PutObject(context.Background(), &pb.PutRequest{
+		Header: header(ctx),
+		Key:    []byte(key),
+		Value:  []byte(value),
+	})
+	return err
+}
+
+func iterate(s *SyscallService, ctx *ContractState, start, limit string, cap int32) ([]string, error) {
+	resp, err := s.NewIterator(context.Background(), &pb.IteratorRequest{
+		Header: header(ctx),
+		Start:  []byte(start),
+		Limit:  []byte(limit),
+		Cap:    cap,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var items []string
+	for _, item := range resp.GetItems() {
+		items = append(items, string(item.GetKey())+"="+string(item.GetValue()))
+	}
+	return items, nil
+}
+
+func TestIteratorSyscall(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	ws := NewWriteSet(b.db)
+	for _, key := range []string{"a/1", "a/2", "a/3", "b/1"} {
+		ws.Put(util.ContractStateKey("store", []byte(key)), []byte(key))
+	}
+	// 其他合约的key不会出现在迭代结果中
+	ws.Put(util.ContractStateKey("other", []byte("a/0")), []byte("a/0"))
+	ws.Put(util.ContractStateKey("storex", []byte("a/0")), []byte("a/0"))
+	if err := b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+
+	results := make(map[string][]string)
+	executor.deploy(t, b, &pb.ContractDesc{Name: "store"}, func(s *SyscallService, ctx *ContractState) error {
+		// 迭代结果包含未提交的写操作
+		if err := putObject(s, ctx, "a/4", "a/4"); err != nil {
+			return err
+		}
+		if _, err := s.DeleteObject(context.Background(), &pb.DeleteRequest{
+			Header: header(ctx),
+			Key:    []byte("a/2"),
+		}); err != nil {
+			return err
+		}
+		for name, r := range map[string]struct {
+			start, limit string
+			cap          int32
+		}{
+			"prefix": {"a/", "a/\xff", 0},
+			"page":   {"a/", "a/\xff", 2},
+			"next":   {"a/3\x00", "a/\xff", 2},
+			"all":    {"", "", 0},
+			"empty":  {"c/", "c/\xff", 0},
+		} {
+			items, err := iterate(s, ctx, r.start, r.limit, r.cap)
+			if err != nil {
+				return err
+			}
+			results[name] = items
+		}
+		return okResponse(s, ctx, "")
+	})
+	_, root, err := invoke(vm, &ContractState{ContractName: "store"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := map[string][]string{
+		"prefix": {"a/1=a/1", "a/3=a/3", "a/4=a/4"},
+		"page":   {"a/1=a/1", "a/3=a/3"},
+		"next":   {"a/4=a/4"},
+		"all":    {"a/1=a/1", "a/3=a/3", "a/4=a/4", "b/1=b/1"},
+		"empty":  nil,
+	}
+	for name, items := range expect {
+		if !reflect.DeepEqual(results[name], items) {
+			t.Errorf("%s: got %v, expect %v", name, results[name], items)
+		}
+	}
+	// 迭代的范围记录在读集合中
+	if len(root.RWSet().Ranges) != len(expect) {
+		t.Errorf("expect %d ranges, got %d", len(expect), len(root.RWSet().Ranges))
+	}
+}
+
+func emitEvent(s *SyscallService, ctx *ContractState, name, body string) error {
+	_, err := s.EmitEvent(context.Background(), &pb.EmitEventRequest{
+		Header: header(ctx),
+		Name:   name,
+		Body:   []byte(body),
+	})
+	return err
+}
+
+func TestEmitEventSyscall(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	var emptyErr error
+	executor.deploy(t, b, &pb.ContractDesc{Name: "token"}, func(s *SyscallService, ctx *ContractState) error {
+		for _, name := range []string{"mint", "transfer"} {
+			if err := emitEvent(s, ctx, name, name); err != nil {
+				return err
+			}
+		}
+		emptyErr = emitEvent(s, ctx, "", "")
+		// 失败的子调用产生的事件被丢弃
+		if _, err := callContract(s, ctx, "market", "ok"); err != nil {
+			return err
+		}
+		if _, err := callContract(s, ctx, "market", "fail"); err == nil {
+			return errors.New("expect the call to fail")
+		}
+		return okResponse(s, ctx, "")
+	})
+	executor.deploy(t, b, &pb.ContractDesc{Name: "market"}, func(s *SyscallService, ctx *ContractState) error {
+		if err := emitEvent(s, ctx, "transfer", ctx.Method); err != nil {
+			return err
+		}
+		if ctx.Method == "fail" {
+			return errors.New("fail")
+		}
+		return okResponse(s, ctx, "")
+	})
+
+	_, root, err := invoke(vm, &ContractState{ContractName: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if emptyErr == nil {
+		t.Error("expect an empty event name to fail")
+	}
+	if err = b.CommitRWSet(root.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+	// 失败的调用不会提交事件
+	if _, root, err = invoke(vm, &ContractState{ContractName: "market", Method: "fail"}); err == nil {
+		t.Fatal("expect the invocation to fail")
+	}
+	if err = b.CommitRWSet(root.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		contract, name string
+		expect         []string
+	}{
+		{"", "", []string{"token/mint/mint", "token/transfer/transfer", "market/transfer/ok"}},
+		{"token", "", []string{"token/mint/mint", "token/transfer/transfer"}},
+		{"", "transfer", []string{"token/transfer/transfer", "market/transfer/ok"}},
+		{"market", "mint", nil},
+	}
+	for _, c := range cases {
+		events, err := b.QueryEvents(c.contract, c.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, event := range events {
+			got = append(got, event.GetContract()+"/"+event.GetName()+"/"+string(event.GetBody()))
+		}
+		if !reflect.DeepEqual(got, c.expect) {
+			t.Errorf("query %q %q: got %v, expect %v", c.contract, c.name, got, c.expect)
+		}
+	}
+}
+
+func TestGetEnvironmentSyscall(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	envs := make(map[string]*pb.Environment)
+	callers := make(map[string]string)
+	record := func(s *SyscallService, ctx *ContractState) error {
+		env, err := s.GetEnvironment(context.Background(), &pb.GetEnvironmentRequest{Header: header(ctx)})
+		if err != nil {
+			return err
+		}
+		args, err := s.GetCallArgs(context.Background(), &pb.GetCallArgsRequest{Header: header(ctx)})
+		if err != nil {
+			return err
+		}
+		envs[ctx.ContractName] = env
+		callers[ctx.ContractName] = args.GetCaller()
+		return nil
+	}
+	executor.deploy(t, b, &pb.ContractDesc{Name: "outer"}, func(s *SyscallService, ctx *ContractState) error {
+		if err := record(s, ctx); err != nil {
+			return err
+		}
+		if _, err := callContract(s, ctx, "inner", "invoke"); err != nil {
+			return err
+		}
+		return okResponse(s, ctx, "")
+	})
+	executor.deploy(t, b, &pb.ContractDesc{Name: "inner"}, func(s *SyscallService, ctx *ContractState) error {
+		if err := record(s, ctx); err != nil {
+			return err
+		}
+		return okResponse(s, ctx, "")
+	})
+
+	env := &pb.Environment{
+		Height:    10,
+		Timestamp: 1600000000,
+		Txid:      "tx1",
+		Initiator: "alice",
+	}
+	if _, _, err := invoke(vm, &ContractState{ContractName: "outer", Caller: "alice", Env: env}); err != nil {
+		t.Fatal(err)
+	}
+	// 子调用的caller为发起调用的合约，执行环境不变
+	for _, name := range []string{"outer", "inner"} {
+		if !proto.Equal(envs[name], env) {
+			t.Errorf("%s: env %v, expect %v", name, envs[name], env)
+		}
+	}
+	if callers["outer"] != "alice" || callers["inner"] != "outer" {
+		t.Errorf("unexpected callers %v", callers)
+	}
+
+	// 没有指定执行环境时initiator为caller
+	if _, _, err := invoke(vm, &ContractState{ContractName: "inner", Caller: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if envs["inner"].GetInitiator() != "bob" || envs["inner"].GetHeight() != 0 {
+		t.Errorf("unexpected default env %v", envs["inner"])
+	}
+
+	if _, err := b.syscall.GetEnvironment(context.Background(), &pb.GetEnvironmentRequest{
+		Header: &pb.SyscallHeader{Ctxid: -1},
+	}); err == nil {
+		t.Error("expect a bad context id to fail")
+	}
+}
+
+func TestContractCallGasLimit(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	var (
+		calleeLimits []int64
+		callErrs     []error
+	)
+	executor.deploy(t, b, &pb.ContractDesc{Name: "outer"}, func(s *SyscallService, ctx *ContractState) error {
+		if err := s.ChargeResource(ctx.ID, gas.Limits{Cpu: 300}); err != nil {
+			return err
+		}
+		for i := 0; i < 2; i++ {
+			_, err := callContract(s, ctx, "inner", "invoke")
+			callErrs = append(callErrs, err)
+		}
+		return okResponse(s, ctx, "")
+	})
+	executor.deploy(t, b, &pb.ContractDesc{Name: "inner"}, func(s *SyscallService, ctx *ContractState) error {
+		calleeLimits = append(calleeLimits, ctx.Limits.Cpu)
+		if err := putObject(s, ctx, "key", "value"); err != nil {
+			return err
+		}
+		if err := s.ChargeResource(ctx.ID, gas.Limits{Cpu: 500}); err != nil {
+			return err
+		}
+		return okResponse(s, ctx, "")
+	})
+
+	limits := gas.MaxLimits
+	limits.Cpu = 1000
+	_, root, err := invoke(vm, &ContractState{ContractName: "outer", Limits: limits})
+	// 被调合约的上限为调用方剩余的资源
+	if len(calleeLimits) != 2 || calleeLimits[0] != 700 || calleeLimits[1] != 200 {
+		t.Fatalf("unexpected callee limits %v", calleeLimits)
+	}
+	var outOfGas *gas.ErrOutOfGas
+	if callErrs[0] != nil || !errors.As(callErrs[1], &outOfGas) {
+		t.Fatalf("unexpected call errors %v", callErrs)
+	}
+	// 失败的子调用的消耗同样计入调用方
+	if !errors.As(err, &outOfGas) || outOfGas.Used.Cpu != 1300 {
+		t.Fatalf("expect out of gas with cpu 1300, got %v", err)
+	}
+	if len(root.RWSet().Writes) != 0 {
+		t.Fatal("writes of the out of gas call are committed")
+	}
+}
+
+func TestReadOnlySyscalls(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	ws := NewWriteSet(b.db)
+	ws.Put(util.ContractStateKey("reader", []byte("key")), []byte("value"))
+	if err := b.CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+
+	var writeErrs []error
+	var calleeErr error
+	executor.deploy(t, b, &pb.ContractDesc{Name: "reader"}, func(s *SyscallService, ctx *ContractState) error {
+		resp, err := s.GetObject(context.Background(), &pb.GetRequest{Header: header(ctx), Key: []byte("key")})
+		if err != nil {
+			return err
+		}
+		if _, err = iterate(s, ctx, "", "", 0); err != nil {
+			return err
+		}
+		_, deleteErr := s.DeleteObject(context.Background(), &pb.DeleteRequest{Header: header(ctx), Key: []byte("key")})
+		writeErrs = []error{
+			putObject(s, ctx, "key", "new"),
+			deleteErr,
+			transfer(s, ctx, "", "alice", "1"),
+			emitEvent(s, ctx, "event", ""),
+		}
+		// 跨合约调用同样只读
+		_, calleeErr = callContract(s, ctx, "writer", "invoke")
+		return okResponse(s, ctx, string(resp.GetValue()))
+	})
+	executor.deploy(t, b, &pb.ContractDesc{Name: "writer"}, func(s *SyscallService, ctx *ContractState) error {
+		if err := putObject(s, ctx, "key", "value"); err != nil {
+			return err
+		}
+		return okResponse(s, ctx, "")
+	})
+
+	resp, root, err := invoke(vm, &ContractState{ContractName: "reader", ReadOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.GetBody()) != "value" {
+		t.Errorf("read %q in read-only call", resp.GetBody())
+	}
+	for i, err := range writeErrs {
+		if !errors.Is(err, ErrReadOnly) {
+			t.Errorf("write %d: expect read-only error, got %v", i, err)
+		}
+	}
+	if !errors.Is(calleeErr, ErrReadOnly) {
+		t.Errorf("expect the callee to be read-only, got %v", calleeErr)
+	}
+	if rwset := root.RWSet(); len(rwset.Writes) != 0 || len(rwset.Events) != 0 {
+		t.Errorf("read-only call writes %+v", rwset)
+	}
+}
+
+func TestContractCallDepth(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	b.SetMaxCallDepth(3)
+	var (
+		callStacks [][]string
+		depthErr   error
+	)
+	executor.deploy(t, b, &pb.ContractDesc{Name: "recursive"}, func(s *SyscallService, ctx *ContractState) error {
+		callStacks = append(callStacks, ctx.CallStack)
+		if _, err := callContract(s, ctx, "recursive", "invoke"); err != nil {
+			depthErr = err
+		}
+		return okResponse(s, ctx, "")
+	})
+	if _, _, err := invoke(vm, &ContractState{ContractName: "recursive"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(callStacks) != 3 || len(callStacks[2]) != 2 {
+		t.Fatalf("unexpected call stacks %v", callStacks)
+	}
+	if !errors.Is(depthErr, ErrCallDepthExceeded) {
+		t.Fatalf("expect max call depth exceeded, got %v", depthErr)
+	}
+}
+
+func TestContractCallReentrancy(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	results := make(map[string]error)
+	// caller -> callee -> caller
+	reenter := func(s *SyscallService, ctx *ContractState) error {
+		if ctx.Depth() == 1 {
+			_, err := callContract(s, ctx, "callee", "invoke")
+			results[ctx.ContractName] = err
+		} else if ctx.Depth() == 2 {
+			if _, err := callContract(s, ctx, ctx.Caller, "invoke"); err != nil {
+				return err
+			}
+		}
+		return okResponse(s, ctx, "")
+	}
+	executor.deploy(t, b, &pb.ContractDesc{Name: "guarded", NonReentrant: true}, reenter)
+	executor.deploy(t, b, &pb.ContractDesc{Name: "open"}, reenter)
+	executor.deploy(t, b, &pb.ContractDesc{Name: "callee"}, reenter)
+
+	for _, name := range []string{"guarded", "open"} {
+		if _, _, err := invoke(vm, &ContractState{ContractName: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !errors.Is(results["guarded"], ErrReentrantCall) {
+		t.Errorf("expect reentrant call error, got %v", results["guarded"])
+	}
+	if results["open"] != nil {
+		t.Errorf("expect the reentrant contract to be called, got %v", results["open"])
+	}
+}
+
+func TestContractCall(t *testing.T) {
+	b, executor, vm := newTestBridge()
+	var (
+		calleeArgs *pb.CallArgs
+		callResp   *pb.ContractCallResponse
+		callErrs   []error
+		outerUsed  gas.Limits
+	)
+	executor.deploy(t, b, &pb.ContractDesc{Name: "outer"}, func(s *SyscallService, ctx *ContractState) error {
+		if err := s.ChargeResource(ctx.ID, gas.Limits{Cpu: 100}); err != nil {
+			return err
+		}
+		var err error
+		callResp, err = s.ContractCall(context.Background(), &pb.ContractCallRequest{
+			Header:   header(ctx),
+			Module:   "wasm",
+			Contract: "inner",
+			Method:   "get",
+			Args:     []*pb.ArgPair{{Key: "key", Value: []byte("value")}},
+		})
+		if err != nil {
+			return err
+		}
+		for _, req := range []*pb.ContractCallRequest{
+			{Module: "evm", Contract: "inner"},
+			{Module: "wasm", Contract: "missing"},
+		} {
+			req.Header = header(ctx)
+			_, err := s.ContractCall(context.Background(), req)
+			callErrs = append(callErrs, err)
+		}
+		outerUsed = ctx.SyscallResourceUsed
+		outerUsed.Add(ctx.SubResourceUsed)
+		return okResponse(s, ctx, "")
+	})
+	executor.deploy(t, b, &pb.ContractDesc{Name: "inner"}, func(s *SyscallService, ctx *ContractState) error {
+		var err error
+		if calleeArgs, err = s.GetCallArgs(context.Background(), &pb.GetCallArgsRequest{Header: header(ctx)}); err != nil {
+			return err
+		}
+		if err = s.ChargeResource(ctx.ID, gas.Limits{Cpu: 500, Disk: 10}); err != nil {
+			return err
+		}
+		_, err = s.SetOutput(context.Background(), &pb.SetOutputRequest{
+			Header:   header(ctx),
+			Response: &pb.Response{Status: 201, Message: "created", Body: []byte("inner body")},
+		})
+		return err
+	})
+
+	_, _, err := invoke(vm, &ContractState{ContractName: "outer", Caller: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 被调合约的caller为发起调用的合约
+	if calleeArgs.GetCaller() != "outer" || calleeArgs.GetMethod() != "get" ||
+		len(calleeArgs.GetArgs()) != 1 || string(calleeArgs.GetArgs()[0].GetValue()) != "value" {
+		t.Errorf("unexpected callee args %v", calleeArgs)
+	}
+	resp := callResp.GetResponse()
+	if resp.GetStatus() != 201 || resp.GetMessage() != "created" || string(resp.GetBody()) != "inner body" {
+		t.Errorf("unexpected callee response %v", resp)
+	}
+	// 被调合约的消耗计入调用方
+	if outerUsed != (gas.Limits{Cpu: 600, Disk: 10}) {
+		t.Errorf("caller used %+v", outerUsed)
+	}
+	if callErrs[0] == nil || callErrs[1] == nil {
+		t.Errorf("expect unknown module and contract to fail, got %v", callErrs)
+	}
+}
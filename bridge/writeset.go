@@ -0,0 +1,282 @@
+package bridge
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+)
+
+// WriteSet 缓存合约执行过程中的读写操作，合约执行失败时直接丢弃写操作。
+// 跨合约调用时子调用的WriteSet从父WriteSet派生，提交时合并到父WriteSet，
+// 根WriteSet的读写集合由调用方通过RWSet取出后校验并提交
+type WriteSet struct {
+	parent *WriteSet
+	db     db.Database
+	// value为nil表示删除
+	writes map[string][]byte
+	// 从db中读到的key及其版本，所有派生的WriteSet共享
+	reads *readSet
+	// 合约调用产生的事件，与写操作一同提交或丢弃
+	events []*pb.ContractEvent
+}
+
+type readSet struct {
+	keys map[string]uint64
+	// 迭代过的key范围，用于发现范围内新增的key
+	ranges []*KVRange
+}
+
+// NewWriteSet instances a WriteSet on top of db
+func NewWriteSet(db db.Database) *WriteSet {
+	return &WriteSet{
+		db:     db,
+		writes: make(map[string][]byte),
+		reads: &readSet{
+			keys: make(map[string]uint64),
+		},
+	}
+}
+
+// Fork returns a child WriteSet which can see all the writes of w
+func (w *WriteSet) Fork() *WriteSet {
+	return &WriteSet{
+		parent: w,
+		db:     w.db,
+		writes: make(map[string][]byte),
+		reads:  w.reads,
+	}
+}
+
+func (w *WriteSet) recordRead(key string, version uint64) {
+	if _, ok := w.reads.keys[key]; !ok {
+		w.reads.keys[key] = version
+	}
+}
+
+// Get returns the value of key, buffered writes take precedence over the db
+func (w *WriteSet) Get(key []byte) ([]byte, error) {
+	if value, ok := w.writes[string(key)]; ok {
+		return value, nil
+	}
+	if w.parent != nil {
+		return w.parent.Get(key)
+	}
+	value, version, err := getVersioned(w.db, key)
+	if err != nil {
+		return nil, err
+	}
+	w.recordRead(string(key), version)
+	return value, nil
+}
+
+// Put buffers a put operation
+func (w *WriteSet) Put(key []byte, value []byte) error {
+	if value == nil {
+		value = []byte{}
+	}
+	w.writes[string(key)] = value
+	return nil
+}
+
+// Delete buffers a delete operation
+func (w *WriteSet) Delete(key []byte) error {
+	w.writes[string(key)] = nil
+	return nil
+}
+
+// AddEvent buffers an event emitted by the contract
+func (w *WriteSet) AddEvent(event *pb.ContractEvent) {
+	w.events = append(w.events, event)
+}
+
+// Len returns the number of buffered writes
+func (w *WriteSet) Len() int {
+	return len(w.writes)
+}
+
+// NewIterator returns an iterator over keys in [start, limit) which merges the buffered writes
+func (w *WriteSet) NewIterator(start []byte, limit []byte) (db.Iterator, error) {
+	root := w
+	var layers []*WriteSet
+	for ; root.parent != nil; root = root.parent {
+		layers = append(layers, root)
+	}
+	layers = append(layers, root)
+	iteratee, ok := root.db.(db.Iteratee)
+	if !ok {
+		return nil, errors.New("database does not support iterator")
+	}
+	// 先取得已提交的版本再创建迭代器，迭代过程中提交的key在提交时一定会被发现
+	version, err := committedVersion(root.db)
+	if err != nil {
+		return nil, err
+	}
+	w.reads.ranges = append(w.reads.ranges, &KVRange{
+		Start:   append([]byte(nil), start...),
+		Limit:   append([]byte(nil), limit...),
+		Version: version,
+	})
+
+	// 子WriteSet的写操作覆盖父WriteSet
+	writes := make(map[string][]byte)
+	for i := len(layers) - 1; i >= 0; i-- {
+		for key, value := range layers[i].writes {
+			k := []byte(key)
+			if bytes.Compare(k, start) < 0 {
+				continue
+			}
+			if limit != nil && bytes.Compare(k, limit) >= 0 {
+				continue
+			}
+			writes[key] = value
+		}
+	}
+	keys := make([]string, 0, len(writes))
+	for key := range writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return &mergeIterator{
+		base:   iteratee.NewIterator(start, limit),
+		keys:   keys,
+		writes: writes,
+		ws:     root,
+	}, nil
+}
+
+// Commit merges the writes into the parent WriteSet,
+// the writes of the root WriteSet are kept until they are fetched by RWSet
+func (w *WriteSet) Commit() {
+	if w.parent == nil {
+		return
+	}
+	for key, value := range w.writes {
+		w.parent.writes[key] = value
+	}
+	w.parent.events = append(w.parent.events, w.events...)
+	w.Discard()
+}
+
+// Discard drops all the buffered writes and events, the reads are kept
+func (w *WriteSet) Discard() {
+	w.writes = make(map[string][]byte)
+	w.events = nil
+}
+
+// RWSet returns the reads and writes sorted by key, along with the events in emission order
+func (w *WriteSet) RWSet() *RWSet {
+	rwset := &RWSet{
+		Events: append([]*pb.ContractEvent(nil), w.events...),
+	}
+	for key, version := range w.reads.keys {
+		rwset.Reads = append(rwset.Reads, &KVRead{
+			Key:     []byte(key),
+			Version: version,
+		})
+	}
+	for key, value := range w.writes {
+		rwset.Writes = append(rwset.Writes, &KVWrite{
+			Key:      []byte(key),
+			Value:    value,
+			IsDelete: value == nil,
+		})
+	}
+	sort.Slice(rwset.Reads, func(i, j int) bool {
+		return bytes.Compare(rwset.Reads[i].Key, rwset.Reads[j].Key) < 0
+	})
+	sort.Slice(rwset.Writes, func(i, j int) bool {
+		return bytes.Compare(rwset.Writes[i].Key, rwset.Writes[j].Key) < 0
+	})
+	for _, r := range w.reads.ranges {
+		rwset.Ranges = append(rwset.Ranges, &KVRange{
+			Start:   r.Start,
+			Limit:   r.Limit,
+			Version: r.Version,
+		})
+	}
+	return rwset
+}
+
+// mergeIterator 合并db迭代器与WriteSet中的有序写操作，相同key以WriteSet为准，
+// 从db中迭代出的key会记录到读集合
+type mergeIterator struct {
+	base      db.Iterator
+	baseValid bool
+	started   bool
+
+	keys   []string
+	writes map[string][]byte
+	idx    int
+	ws     *WriteSet
+
+	key   []byte
+	value []byte
+	err   error
+}
+
+func (m *mergeIterator) Next() bool {
+	if m.err != nil {
+		return false
+	}
+	if !m.started {
+		m.baseValid = m.base.Next()
+		m.started = true
+	}
+	for {
+		hasKey := m.idx < len(m.keys)
+		if !hasKey && !m.baseValid {
+			return false
+		}
+		if hasKey && (!m.baseValid || bytes.Compare([]byte(m.keys[m.idx]), m.base.Key()) <= 0) {
+			key := m.keys[m.idx]
+			m.idx++
+			if m.baseValid && key == string(m.base.Key()) {
+				m.baseValid = m.base.Next()
+			}
+			value := m.writes[key]
+			if value == nil {
+				// deleted
+				continue
+			}
+			m.key, m.value = []byte(key), value
+			return true
+		}
+		version, value, err := decodeVersionedValue(m.base.Value())
+		if err != nil {
+			m.err = err
+			return false
+		}
+		if value == nil {
+			// 墓碑由范围的校验覆盖，不返回也不记录
+			m.baseValid = m.base.Next()
+			continue
+		}
+		m.key = append([]byte(nil), m.base.Key()...)
+		m.value = append([]byte(nil), value...)
+		m.ws.recordRead(string(m.key), version)
+		m.baseValid = m.base.Next()
+		return true
+	}
+}
+
+func (m *mergeIterator) Key() []byte {
+	return m.key
+}
+
+func (m *mergeIterator) Value() []byte {
+	return m.value
+}
+
+func (m *mergeIterator) Error() error {
+	if m.err != nil {
+		return m.err
+	}
+	return m.base.Error()
+}
+
+func (m *mergeIterator) Release() {
+	m.base.Release()
+}
@@ -1,8 +1,42 @@
 package db
 
-type Database interface {
+// KVStore is the basic read-write interface of a key-value store
+type KVStore interface {
 	Get(key []byte) ([]byte, error)
 	Put(key []byte, value []byte) error
 	Delete(key []byte) error
+}
+
+type Database interface {
+	KVStore
 	Close()
 }
+
+// Iterator iterates over a range of key-value pairs, it should be released after the use
+type Iterator interface {
+	Next() bool
+	Key() []byte
+	Value() []byte
+	Error() error
+	Release()
+}
+
+// Iteratee is implemented by the Database which supports range iteration
+type Iteratee interface {
+	// NewIterator returns an iterator over keys in [start, limit),
+	// a nil limit represents a logical key after the last available key
+	NewIterator(start []byte, limit []byte) Iterator
+}
+
+// Batch is a write-only buffer that commits its changes atomically when Write is called
+type Batch interface {
+	Put(key []byte, value []byte)
+	Delete(key []byte)
+	Len() int
+	Write() error
+}
+
+// Batcher is implemented by the Database which supports atomic batch writes
+type Batcher interface {
+	NewBatch() Batch
+}
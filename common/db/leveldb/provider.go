@@ -89,10 +89,19 @@ func (h *DBHandle) GetIterator(startKey []byte, endKey []byte) *Iterator {
 		// replace the last byte 'dbNameKeySep' by 'lastKeyIndicator'
 		eKey[len(eKey)-1] = lastKeyIndicator
 	}
-	logger.Error("Getting iterator for range ", sKey, eKey)
 	return &Iterator{h.db.GetIterator(sKey, eKey)}
 }
 
+// NewIterator implements db.Iteratee
+func (h *DBHandle) NewIterator(startKey []byte, endKey []byte) db.Iterator {
+	return h.GetIterator(startKey, endKey)
+}
+
+// NewBatch implements db.Batcher
+func (h *DBHandle) NewBatch() db.Batch {
+	return &handleBatch{NewUpdateBatch(), h}
+}
+
 func (h *DBHandle) Close() {
 	h.db.Close()
 }
@@ -125,6 +134,17 @@ func (batch *UpdateBatch) Len() int {
 	return len(batch.KVs)
 }
 
+// handleBatch binds an UpdateBatch to the DBHandle it writes to
+type handleBatch struct {
+	*UpdateBatch
+	handle *DBHandle
+}
+
+// Write writes the batch to the DBHandle
+func (b *handleBatch) Write() error {
+	return b.handle.WriteBatch(b.UpdateBatch, true)
+}
+
 // Iterator extends actual leveldb iterator
 type Iterator struct {
 	iterator.Iterator
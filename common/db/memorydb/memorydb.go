@@ -0,0 +1,160 @@
+package memorydb
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+)
+
+// MemDB is an in-memory key-value database, it supports range iteration and atomic batch writes
+type MemDB struct {
+	lock sync.RWMutex
+	kvs  map[string][]byte
+}
+
+// NewMemDB instances an empty MemDB
+func NewMemDB() *MemDB {
+	return &MemDB{
+		kvs: make(map[string][]byte),
+	}
+}
+
+// Get returns the value of the key, nil if not found
+func (m *MemDB) Get(key []byte) ([]byte, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	value, ok := m.kvs[string(key)]
+	if !ok {
+		return nil, nil
+	}
+	return append([]byte(nil), value...), nil
+}
+
+// Put saves the key/value
+func (m *MemDB) Put(key []byte, value []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.kvs[string(key)] = append([]byte{}, value...)
+	return nil
+}
+
+// Delete deletes the given key
+func (m *MemDB) Delete(key []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.kvs, string(key))
+	return nil
+}
+
+// Close implements db.Database
+func (m *MemDB) Close() {
+}
+
+// NewIterator implements db.Iteratee, the iterator works on a snapshot of the keys in range
+func (m *MemDB) NewIterator(start []byte, limit []byte) db.Iterator {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	iter := &memIterator{idx: -1}
+	for key, value := range m.kvs {
+		k := []byte(key)
+		if bytes.Compare(k, start) < 0 {
+			continue
+		}
+		if limit != nil && bytes.Compare(k, limit) >= 0 {
+			continue
+		}
+		iter.keys = append(iter.keys, k)
+		iter.values = append(iter.values, value)
+	}
+	sort.Sort(iter)
+	return iter
+}
+
+// NewBatch implements db.Batcher
+func (m *MemDB) NewBatch() db.Batch {
+	return &memBatch{
+		db:     m,
+		writes: make(map[string][]byte),
+	}
+}
+
+type memIterator struct {
+	keys   [][]byte
+	values [][]byte
+	idx    int
+}
+
+func (it *memIterator) Len() int {
+	return len(it.keys)
+}
+
+func (it *memIterator) Less(i, j int) bool {
+	return bytes.Compare(it.keys[i], it.keys[j]) < 0
+}
+
+func (it *memIterator) Swap(i, j int) {
+	it.keys[i], it.keys[j] = it.keys[j], it.keys[i]
+	it.values[i], it.values[j] = it.values[j], it.values[i]
+}
+
+func (it *memIterator) Next() bool {
+	if it.idx < len(it.keys) {
+		it.idx++
+	}
+	return it.idx < len(it.keys)
+}
+
+func (it *memIterator) Key() []byte {
+	if it.idx < 0 || it.idx >= len(it.keys) {
+		return nil
+	}
+	return it.keys[it.idx]
+}
+
+func (it *memIterator) Value() []byte {
+	if it.idx < 0 || it.idx >= len(it.keys) {
+		return nil
+	}
+	return it.values[it.idx]
+}
+
+func (it *memIterator) Error() error {
+	return nil
+}
+
+func (it *memIterator) Release() {
+	it.keys, it.values = nil, nil
+}
+
+// memBatch 缓存写操作，Write时在一次加锁内全部生效，value为nil表示删除
+type memBatch struct {
+	db     *MemDB
+	writes map[string][]byte
+}
+
+func (b *memBatch) Put(key []byte, value []byte) {
+	b.writes[string(key)] = append([]byte{}, value...)
+}
+
+func (b *memBatch) Delete(key []byte) {
+	b.writes[string(key)] = nil
+}
+
+func (b *memBatch) Len() int {
+	return len(b.writes)
+}
+
+func (b *memBatch) Write() error {
+	b.db.lock.Lock()
+	defer b.db.lock.Unlock()
+	for key, value := range b.writes {
+		if value == nil {
+			delete(b.db.kvs, key)
+		} else {
+			b.db.kvs[key] = value
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"encoding/binary"
 	"github.com/pkg/errors"
 	"go/build"
 	"io"
@@ -11,7 +12,8 @@ import (
 )
 
 const (
-	InitContractMethod   = "initialize"
+	InitContractMethod    = "initialize"
+	MigrateContractMethod = "migrate"
 )
 
 // CreateDirIfMissing creates a dir for dirPath if not already exists. If the dir is empty it returns true
@@ -54,10 +56,133 @@ func GoPath() string {
 	return gps[0]
 }
 
+// 存储的key分为两个命名空间：系统元数据以"s/"开头，合约状态以"c/"开头
+const (
+	systemKeyPrefix = "s/"
+	stateKeyPrefix  = "c/"
+	eventKeyPrefix  = systemKeyPrefix + "event/"
+)
+
+func systemKey(kind, id string) []byte {
+	return []byte(systemKeyPrefix + kind + "/" + id)
+}
+
 func ContractCodeKey(contractName string) []byte {
-	return []byte("contract-" + contractName)
+	return systemKey("code", contractName)
 }
 
 func ContractCodeDescKey(contractName string) []byte {
-	return []byte("contractdesc-" + contractName)
+	return systemKey("desc", contractName)
+}
+
+// ContractCodeDescRange returns the key range [start, limit) of the descriptors of all contracts
+func ContractCodeDescRange() ([]byte, []byte) {
+	prefix := systemKey("desc", "")
+	return prefix, prefixLimit(prefix)
+}
+
+// ContractACLKey returns the key of the method access control list of the contract
+func ContractACLKey(contractName string) []byte {
+	return systemKey("acl", contractName)
+}
+
+// AccountKeyKey returns the key of the registered public key of the account
+func AccountKeyKey(account string) []byte {
+	return systemKey("pubkey", account)
+}
+
+// NonceKey returns the key of the nonce of the last invocation of the account
+func NonceKey(account string) []byte {
+	return systemKey("nonce", account)
+}
+
+func BalanceKey(account string) []byte {
+	return systemKey("balance", account)
+}
+
+// AdminKey returns the key of the admin account which issues native tokens
+func AdminKey() []byte {
+	return []byte(systemKeyPrefix + "admin")
+}
+
+func VersionSeqKey() []byte {
+	return []byte(systemKeyPrefix + "versionseq")
+}
+
+// LayoutVersionKey records the version of the key layout, it is written by the migration tool
+func LayoutVersionKey() []byte {
+	return []byte(systemKeyPrefix + "layout")
+}
+
+// IsLayoutKey reports whether the key belongs to one of the namespaces of the current key layout
+func IsLayoutKey(key []byte) bool {
+	return strings.HasPrefix(string(key), systemKeyPrefix) || strings.HasPrefix(string(key), stateKeyPrefix)
+}
+
+// lengthPrefixed 以uvarint编码的长度作前缀，任意名字和后续key的组合都不会互相冲突
+func lengthPrefixed(prefix, name string) []byte {
+	buf := make([]byte, len(prefix)+binary.MaxVarintLen64+len(name))
+	n := copy(buf, prefix)
+	n += binary.PutUvarint(buf[n:], uint64(len(name)))
+	n += copy(buf[n:], name)
+	return buf[:n]
+}
+
+// ContractHistoryKey returns the key of the descriptor of the given version of the contract
+func ContractHistoryKey(contractName string, version int64) []byte {
+	prefix := lengthPrefixed(systemKeyPrefix+"history/", contractName)
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], uint64(version))
+	return key
+}
+
+// ContractHistoryRange returns the key range [start, limit) of all the versions of the contract
+func ContractHistoryRange(contractName string) ([]byte, []byte) {
+	prefix := lengthPrefixed(systemKeyPrefix+"history/", contractName)
+	return prefix, prefixLimit(prefix)
+}
+
+// ContractStatePrefix returns the common prefix of all the state keys of the contract
+func ContractStatePrefix(contractName string) []byte {
+	return lengthPrefixed(stateKeyPrefix, contractName)
+}
+
+// ContractStateKey returns the storage key of a contract state key
+func ContractStateKey(contractName string, key []byte) []byte {
+	prefix := ContractStatePrefix(contractName)
+	return append(prefix, key...)
+}
+
+// ContractStateRange returns the key range [start, limit) of all the state keys of the contract
+func ContractStateRange(contractName string) ([]byte, []byte) {
+	prefix := ContractStatePrefix(contractName)
+	return prefix, prefixLimit(prefix)
+}
+
+// prefixLimit returns the smallest key greater than all keys with the prefix
+func prefixLimit(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xff {
+			limit := make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i]++
+			return limit
+		}
+	}
+	return nil
+}
+
+// EventKey returns the key of the index-th event committed in version
+func EventKey(version uint64, index uint32) []byte {
+	key := make([]byte, len(eventKeyPrefix)+12)
+	copy(key, eventKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(eventKeyPrefix):], version)
+	binary.BigEndian.PutUint32(key[len(eventKeyPrefix)+8:], index)
+	return key
+}
+
+// EventKeyRange returns the key range [start, limit) of all events
+func EventKeyRange() ([]byte, []byte) {
+	return []byte(eventKeyPrefix), prefixLimit([]byte(eventKeyPrefix))
 }
@@ -2,6 +2,7 @@
 #include <stdio.h>
 #include "pb/contract.pb.h"
 #include "driver/syscall.h"
+#include "driver/iterator_impl.h"
 
 namespace uwavm {
 
@@ -21,6 +22,12 @@ bool ContextImpl::init() {
         auto arg_pair = _call_args.args(i);
         _args.insert(std::make_pair(arg_pair.key(), arg_pair.value()));
     }
+
+    pb::GetEnvironmentRequest env_req;
+    ok = syscall("GetEnvironment", env_req, &_env);
+    if (!ok) {
+        return false;
+    }
     _resp.status = 200;
 
     return true;
@@ -43,6 +50,14 @@ const std::string& ContextImpl::caller() const {
     return _call_args.caller();
 }
 
+int64_t ContextImpl::block_height() const { return _env.height(); }
+
+int64_t ContextImpl::timestamp() const { return _env.timestamp(); }
+
+const std::string& ContextImpl::tx_id() const { return _env.txid(); }
+
+const std::string& ContextImpl::initiator() const { return _env.initiator(); }
+
 bool ContextImpl::get_object(const std::string& key, std::string* value) {
     pb::GetRequest req;
     pb::GetResponse rep;
@@ -78,6 +93,89 @@ bool ContextImpl::delete_object(const std::string& key) {
     return true;
 }
 
+std::unique_ptr<Iterator> ContextImpl::new_iterator(const std::string& start,
+                                                   const std::string& limit) {
+    return std::unique_ptr<Iterator>(new IteratorImpl(start, limit));
+}
+
+bool ContextImpl::transfer(const std::string& to, const std::string& amount) {
+    pb::TransferRequest req;
+    pb::TransferResponse rep;
+    req.set_to(to);
+    req.set_amount(amount);
+    bool ok = syscall("Transfer", req, &rep);
+    if (!ok) {
+        return false;
+    }
+    return true;
+}
+
+bool ContextImpl::emit_event(const std::string& name, const std::string& body) {
+    pb::EmitEventRequest req;
+    pb::EmitEventResponse rep;
+    req.set_name(name);
+    req.set_body(body);
+    bool ok = syscall("EmitEvent", req, &rep);
+    if (!ok) {
+        return false;
+    }
+    return true;
+}
+
+bool ContextImpl::hash(const std::string& algorithm, const std::string& data,
+                       std::string* digest) {
+    pb::HashRequest req;
+    pb::HashResponse rep;
+    req.set_algorithm(algorithm);
+    req.set_data(data);
+    bool ok = syscall("Hash", req, &rep);
+    if (!ok) {
+        return false;
+    }
+    *digest = rep.digest();
+    return true;
+}
+
+bool ContextImpl::sha256(const std::string& data, std::string* digest) {
+    return hash("sha256", data, digest);
+}
+
+bool ContextImpl::keccak256(const std::string& data, std::string* digest) {
+    return hash("keccak256", data, digest);
+}
+
+bool ContextImpl::ripemd160(const std::string& data, std::string* digest) {
+    return hash("ripemd160", data, digest);
+}
+
+bool ContextImpl::verify(const std::string& algorithm,
+                         const std::string& pubkey, const std::string& msg,
+                         const std::string& sign) {
+    pb::VerifySignatureRequest req;
+    pb::VerifySignatureResponse rep;
+    req.set_algorithm(algorithm);
+    req.set_pubkey(pubkey);
+    req.set_msg(msg);
+    req.set_sign(sign);
+    bool ok = syscall("VerifySignature", req, &rep);
+    if (!ok) {
+        return false;
+    }
+    return rep.valid();
+}
+
+bool ContextImpl::verify_ed25519(const std::string& pubkey,
+                                 const std::string& msg,
+                                 const std::string& sign) {
+    return verify("ed25519", pubkey, msg, sign);
+}
+
+bool ContextImpl::verify_secp256k1(const std::string& pubkey,
+                                   const std::string& digest,
+                                   const std::string& sign) {
+    return verify("secp256k1", pubkey, digest, sign);
+}
+
 void ContextImpl::ok(const std::string& body) {
     _resp.status = 200;
     _resp.body = body;
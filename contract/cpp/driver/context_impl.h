@@ -18,9 +18,26 @@ public:
     virtual const std::map<std::string, std::string>& args() const;
     virtual const std::string& arg(const std::string& name) const;
     virtual const std::string& caller() const;
+    virtual int64_t block_height() const;
+    virtual int64_t timestamp() const;
+    virtual const std::string& tx_id() const;
+    virtual const std::string& initiator() const;
     virtual bool get_object(const std::string& key, std::string* value);
     virtual bool put_object(const std::string& key, const std::string& value);
     virtual bool delete_object(const std::string& key);
+    virtual std::unique_ptr<Iterator> new_iterator(const std::string& start,
+                                                   const std::string& limit);
+    virtual bool transfer(const std::string& to, const std::string& amount);
+    virtual bool emit_event(const std::string& name, const std::string& body);
+    virtual bool sha256(const std::string& data, std::string* digest);
+    virtual bool keccak256(const std::string& data, std::string* digest);
+    virtual bool ripemd160(const std::string& data, std::string* digest);
+    virtual bool verify_ed25519(const std::string& pubkey,
+                                const std::string& msg,
+                                const std::string& sign);
+    virtual bool verify_secp256k1(const std::string& pubkey,
+                                  const std::string& digest,
+                                  const std::string& sign);
     virtual void ok(const std::string& body);
     virtual void error(const std::string& body);
     virtual Response* mutable_response();
@@ -30,7 +47,13 @@ public:
                       Response* response);
 
 private:
+    bool hash(const std::string& algorithm, const std::string& data,
+              std::string* digest);
+    bool verify(const std::string& algorithm, const std::string& pubkey,
+                const std::string& msg, const std::string& sign);
+
     pb::CallArgs _call_args;
+    pb::Environment _env;
     std::map<std::string, std::string> _args;
     Response _resp;
 };
@@ -1,6 +1,7 @@
 #ifndef DRIVER_DRIVER_H
 #define DRIVER_DRIVER_H
 
+#include <stdint.h>
 #include <map>
 #include <string>
 #include <vector>
@@ -16,16 +17,49 @@ struct Response {
 
 const std::string kUnknownKey = "";
 
+class Iterator {
+public:
+    virtual ~Iterator() {}
+    // next moves to the next key-value pair, returns false when exhausted or failed
+    virtual bool next() = 0;
+    virtual const std::string& key() const = 0;
+    virtual const std::string& value() const = 0;
+    // error returns true if the iteration failed
+    virtual bool error() const = 0;
+};
+
 class Context {
 public:
     virtual ~Context() {}
     virtual const std::map<std::string, std::string>& args() const = 0;
     virtual const std::string& arg(const std::string& name) const = 0;
     virtual const std::string& caller() const = 0;
+    virtual int64_t block_height() const = 0;
+    virtual int64_t timestamp() const = 0;
+    virtual const std::string& tx_id() const = 0;
+    virtual const std::string& initiator() const = 0;
     virtual bool get_object(const std::string& key, std::string* value) = 0;
     virtual bool put_object(const std::string& key,
                             const std::string& value) = 0;
     virtual bool delete_object(const std::string& key) = 0;
+    virtual std::unique_ptr<Iterator> new_iterator(const std::string& start,
+                                                   const std::string& limit) = 0;
+    virtual bool transfer(const std::string& to,
+                          const std::string& amount) = 0;
+    virtual bool emit_event(const std::string& name,
+                            const std::string& body) = 0;
+    // digests are computed by the host, returns false on failure
+    virtual bool sha256(const std::string& data, std::string* digest) = 0;
+    virtual bool keccak256(const std::string& data, std::string* digest) = 0;
+    virtual bool ripemd160(const std::string& data, std::string* digest) = 0;
+    // returns true only if the signature is valid
+    virtual bool verify_ed25519(const std::string& pubkey,
+                                const std::string& msg,
+                                const std::string& sign) = 0;
+    // sign is DER encoded or 64 bytes r||s, digest must be 32 bytes
+    virtual bool verify_secp256k1(const std::string& pubkey,
+                                  const std::string& digest,
+                                  const std::string& sign) = 0;
     virtual void ok(const std::string& body) = 0;
     virtual void error(const std::string& body) = 0;
     virtual Response* mutable_response() = 0;
@@ -0,0 +1,65 @@
+#include "driver/iterator_impl.h"
+#include "driver/syscall.h"
+
+namespace uwavm {
+
+static const int kIteratorBatchSize = 100;
+
+IteratorImpl::IteratorImpl(const std::string& start, const std::string& limit)
+    : _start(start), _limit(limit), _idx(0), _end(false), _error(false) {}
+
+IteratorImpl::~IteratorImpl() {}
+
+bool IteratorImpl::load() {
+    pb::IteratorRequest req;
+    req.set_start(_start);
+    req.set_limit(_limit);
+    req.set_cap(kIteratorBatchSize);
+    _items.Clear();
+    _idx = 0;
+    bool ok = syscall("NewIterator", req, &_items);
+    if (!ok) {
+        _error = true;
+        return false;
+    }
+    if (_items.items_size() < kIteratorBatchSize) {
+        _end = true;
+        return true;
+    }
+    // the next batch starts from the successor of the last key
+    _start = _items.items(_items.items_size() - 1).key();
+    _start.push_back('\0');
+    return true;
+}
+
+bool IteratorImpl::next() {
+    if (_error) {
+        return false;
+    }
+    // _idx points to the item after the current one
+    if (_idx >= _items.items_size()) {
+        if (_end || !load() || _items.items_size() == 0) {
+            return false;
+        }
+    }
+    _idx++;
+    return true;
+}
+
+const std::string& IteratorImpl::key() const {
+    if (_idx <= 0 || _idx > _items.items_size()) {
+        return kUnknownKey;
+    }
+    return _items.items(_idx - 1).key();
+}
+
+const std::string& IteratorImpl::value() const {
+    if (_idx <= 0 || _idx > _items.items_size()) {
+        return kUnknownKey;
+    }
+    return _items.items(_idx - 1).value();
+}
+
+bool IteratorImpl::error() const { return _error; }
+
+}  // namespace uwavm
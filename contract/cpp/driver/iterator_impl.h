@@ -0,0 +1,33 @@
+#ifndef DRIVER_ITERATOR_IMPL_H
+#define DRIVER_ITERATOR_IMPL_H
+
+#include "pb/contract.pb.h"
+#include "driver/driver.h"
+
+namespace pb = ::contract;
+
+namespace uwavm {
+
+class IteratorImpl : public Iterator {
+public:
+    IteratorImpl(const std::string& start, const std::string& limit);
+    virtual ~IteratorImpl();
+    virtual bool next();
+    virtual const std::string& key() const;
+    virtual const std::string& value() const;
+    virtual bool error() const;
+
+private:
+    bool load();
+
+    std::string _start;
+    std::string _limit;
+    pb::IteratorResponse _items;
+    int _idx;
+    bool _end;
+    bool _error;
+};
+
+}  // namespace uwavm
+
+#endif
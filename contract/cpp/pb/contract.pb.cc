@@ -13,7 +13,10 @@
 // @@protoc_insertion_point(includes)
 #include <google/protobuf/port_def.inc>
 
+extern PROTOBUF_INTERNAL_EXPORT_contract_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_ACLRole_contract_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_contract_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_ArgPair_contract_2eproto;
+extern PROTOBUF_INTERNAL_EXPORT_contract_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_IteratorItem_contract_2eproto;
+extern PROTOBUF_INTERNAL_EXPORT_contract_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_MethodACL_contract_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_contract_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_Response_contract_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_contract_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_SyscallHeader_contract_2eproto;
 namespace contract {
@@ -53,6 +56,18 @@ class DeleteResponseDefaultTypeInternal {
  public:
   ::google::protobuf::internal::ExplicitlyConstructed<DeleteResponse> _instance;
 } _DeleteResponse_default_instance_;
+class IteratorRequestDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<IteratorRequest> _instance;
+} _IteratorRequest_default_instance_;
+class IteratorItemDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<IteratorItem> _instance;
+} _IteratorItem_default_instance_;
+class IteratorResponseDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<IteratorResponse> _instance;
+} _IteratorResponse_default_instance_;
 class TransferRequestDefaultTypeInternal {
  public:
   ::google::protobuf::internal::ExplicitlyConstructed<TransferRequest> _instance;
@@ -61,6 +76,18 @@ class TransferResponseDefaultTypeInternal {
  public:
   ::google::protobuf::internal::ExplicitlyConstructed<TransferResponse> _instance;
 } _TransferResponse_default_instance_;
+class ContractEventDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<ContractEvent> _instance;
+} _ContractEvent_default_instance_;
+class EmitEventRequestDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<EmitEventRequest> _instance;
+} _EmitEventRequest_default_instance_;
+class EmitEventResponseDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<EmitEventResponse> _instance;
+} _EmitEventResponse_default_instance_;
 class ContractCallRequestDefaultTypeInternal {
  public:
   ::google::protobuf::internal::ExplicitlyConstructed<ContractCallRequest> _instance;
@@ -85,6 +112,46 @@ class GetCallArgsRequestDefaultTypeInternal {
  public:
   ::google::protobuf::internal::ExplicitlyConstructed<GetCallArgsRequest> _instance;
 } _GetCallArgsRequest_default_instance_;
+class EnvironmentDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<Environment> _instance;
+} _Environment_default_instance_;
+class GetEnvironmentRequestDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<GetEnvironmentRequest> _instance;
+} _GetEnvironmentRequest_default_instance_;
+class HashRequestDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<HashRequest> _instance;
+} _HashRequest_default_instance_;
+class HashResponseDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<HashResponse> _instance;
+} _HashResponse_default_instance_;
+class VerifySignatureRequestDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<VerifySignatureRequest> _instance;
+} _VerifySignatureRequest_default_instance_;
+class VerifySignatureResponseDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<VerifySignatureResponse> _instance;
+} _VerifySignatureResponse_default_instance_;
+class ContractDescDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<ContractDesc> _instance;
+} _ContractDesc_default_instance_;
+class MethodACLDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<MethodACL> _instance;
+} _MethodACL_default_instance_;
+class ACLRoleDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<ACLRole> _instance;
+} _ACLRole_default_instance_;
+class ContractACLDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<ContractACL> _instance;
+} _ContractACL_default_instance_;
 }  // namespace contract
 static void InitDefaultsArgPair_contract_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
@@ -216,6 +283,50 @@ static void InitDefaultsDeleteResponse_contract_2eproto() {
 ::google::protobuf::internal::SCCInfo<0> scc_info_DeleteResponse_contract_2eproto =
     {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsDeleteResponse_contract_2eproto}, {}};
 
+static void InitDefaultsIteratorRequest_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_IteratorRequest_default_instance_;
+    new (ptr) ::contract::IteratorRequest();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::IteratorRequest::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<1> scc_info_IteratorRequest_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsIteratorRequest_contract_2eproto}, {
+      &scc_info_SyscallHeader_contract_2eproto.base,}};
+
+static void InitDefaultsIteratorItem_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_IteratorItem_default_instance_;
+    new (ptr) ::contract::IteratorItem();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::IteratorItem::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<0> scc_info_IteratorItem_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsIteratorItem_contract_2eproto}, {}};
+
+static void InitDefaultsIteratorResponse_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_IteratorResponse_default_instance_;
+    new (ptr) ::contract::IteratorResponse();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::IteratorResponse::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<1> scc_info_IteratorResponse_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsIteratorResponse_contract_2eproto}, {
+      &scc_info_IteratorItem_contract_2eproto.base,}};
+
 static void InitDefaultsTransferRequest_contract_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
 
@@ -245,6 +356,49 @@ static void InitDefaultsTransferResponse_contract_2eproto() {
 ::google::protobuf::internal::SCCInfo<0> scc_info_TransferResponse_contract_2eproto =
     {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsTransferResponse_contract_2eproto}, {}};
 
+static void InitDefaultsContractEvent_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_ContractEvent_default_instance_;
+    new (ptr) ::contract::ContractEvent();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::ContractEvent::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<0> scc_info_ContractEvent_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsContractEvent_contract_2eproto}, {}};
+
+static void InitDefaultsEmitEventRequest_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_EmitEventRequest_default_instance_;
+    new (ptr) ::contract::EmitEventRequest();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::EmitEventRequest::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<1> scc_info_EmitEventRequest_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsEmitEventRequest_contract_2eproto}, {
+      &scc_info_SyscallHeader_contract_2eproto.base,}};
+
+static void InitDefaultsEmitEventResponse_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_EmitEventResponse_default_instance_;
+    new (ptr) ::contract::EmitEventResponse();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::EmitEventResponse::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<0> scc_info_EmitEventResponse_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsEmitEventResponse_contract_2eproto}, {}};
+
 static void InitDefaultsContractCallRequest_contract_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
 
@@ -335,6 +489,151 @@ static void InitDefaultsGetCallArgsRequest_contract_2eproto() {
     {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsGetCallArgsRequest_contract_2eproto}, {
       &scc_info_SyscallHeader_contract_2eproto.base,}};
 
+static void InitDefaultsEnvironment_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_Environment_default_instance_;
+    new (ptr) ::contract::Environment();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::Environment::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<0> scc_info_Environment_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsEnvironment_contract_2eproto}, {}};
+
+static void InitDefaultsGetEnvironmentRequest_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_GetEnvironmentRequest_default_instance_;
+    new (ptr) ::contract::GetEnvironmentRequest();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::GetEnvironmentRequest::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<1> scc_info_GetEnvironmentRequest_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsGetEnvironmentRequest_contract_2eproto}, {
+      &scc_info_SyscallHeader_contract_2eproto.base,}};
+
+static void InitDefaultsHashRequest_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_HashRequest_default_instance_;
+    new (ptr) ::contract::HashRequest();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::HashRequest::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<1> scc_info_HashRequest_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsHashRequest_contract_2eproto}, {
+      &scc_info_SyscallHeader_contract_2eproto.base,}};
+
+static void InitDefaultsHashResponse_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_HashResponse_default_instance_;
+    new (ptr) ::contract::HashResponse();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::HashResponse::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<0> scc_info_HashResponse_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsHashResponse_contract_2eproto}, {}};
+
+static void InitDefaultsVerifySignatureRequest_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_VerifySignatureRequest_default_instance_;
+    new (ptr) ::contract::VerifySignatureRequest();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::VerifySignatureRequest::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<1> scc_info_VerifySignatureRequest_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsVerifySignatureRequest_contract_2eproto}, {
+      &scc_info_SyscallHeader_contract_2eproto.base,}};
+
+static void InitDefaultsVerifySignatureResponse_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_VerifySignatureResponse_default_instance_;
+    new (ptr) ::contract::VerifySignatureResponse();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::VerifySignatureResponse::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<0> scc_info_VerifySignatureResponse_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsVerifySignatureResponse_contract_2eproto}, {}};
+
+static void InitDefaultsContractDesc_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_ContractDesc_default_instance_;
+    new (ptr) ::contract::ContractDesc();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::ContractDesc::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<0> scc_info_ContractDesc_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsContractDesc_contract_2eproto}, {}};
+
+static void InitDefaultsMethodACL_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_MethodACL_default_instance_;
+    new (ptr) ::contract::MethodACL();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::MethodACL::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<0> scc_info_MethodACL_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsMethodACL_contract_2eproto}, {}};
+
+static void InitDefaultsACLRole_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_ACLRole_default_instance_;
+    new (ptr) ::contract::ACLRole();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::ACLRole::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<0> scc_info_ACLRole_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsACLRole_contract_2eproto}, {}};
+
+static void InitDefaultsContractACL_contract_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::contract::_ContractACL_default_instance_;
+    new (ptr) ::contract::ContractACL();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::contract::ContractACL::InitAsDefaultInstance();
+}
+
+::google::protobuf::internal::SCCInfo<2> scc_info_ContractACL_contract_2eproto =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 2, InitDefaultsContractACL_contract_2eproto}, {
+      &scc_info_MethodACL_contract_2eproto.base,
+      &scc_info_ACLRole_contract_2eproto.base,}};
+
 namespace contract {
 
 // ===================================================================
@@ -2813,105 +3112,102 @@ void DeleteResponse::InternalSwap(DeleteResponse* other) {
 
 // ===================================================================
 
-void TransferRequest::InitAsDefaultInstance() {
-  ::contract::_TransferRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
+void IteratorRequest::InitAsDefaultInstance() {
+  ::contract::_IteratorRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
       ::contract::SyscallHeader::internal_default_instance());
 }
-class TransferRequest::HasBitSetters {
+class IteratorRequest::HasBitSetters {
  public:
-  static const ::contract::SyscallHeader& header(const TransferRequest* msg);
+  static const ::contract::SyscallHeader& header(const IteratorRequest* msg);
 };
 
 const ::contract::SyscallHeader&
-TransferRequest::HasBitSetters::header(const TransferRequest* msg) {
+IteratorRequest::HasBitSetters::header(const IteratorRequest* msg) {
   return *msg->header_;
 }
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int TransferRequest::kHeaderFieldNumber;
-const int TransferRequest::kFromFieldNumber;
-const int TransferRequest::kToFieldNumber;
-const int TransferRequest::kAmountFieldNumber;
+const int IteratorRequest::kHeaderFieldNumber;
+const int IteratorRequest::kStartFieldNumber;
+const int IteratorRequest::kLimitFieldNumber;
+const int IteratorRequest::kCapFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-TransferRequest::TransferRequest()
+IteratorRequest::IteratorRequest()
   : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:contract.TransferRequest)
+  // @@protoc_insertion_point(constructor:contract.IteratorRequest)
 }
-TransferRequest::TransferRequest(const TransferRequest& from)
+IteratorRequest::IteratorRequest(const IteratorRequest& from)
   : ::google::protobuf::MessageLite(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  from_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.from().size() > 0) {
-    from_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.from_);
-  }
-  to_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.to().size() > 0) {
-    to_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.to_);
+  start_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.start().size() > 0) {
+    start_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.start_);
   }
-  amount_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.amount().size() > 0) {
-    amount_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.amount_);
+  limit_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.limit().size() > 0) {
+    limit_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.limit_);
   }
   if (from.has_header()) {
     header_ = new ::contract::SyscallHeader(*from.header_);
   } else {
     header_ = nullptr;
   }
-  // @@protoc_insertion_point(copy_constructor:contract.TransferRequest)
+  cap_ = from.cap_;
+  // @@protoc_insertion_point(copy_constructor:contract.IteratorRequest)
 }
 
-void TransferRequest::SharedCtor() {
+void IteratorRequest::SharedCtor() {
   ::google::protobuf::internal::InitSCC(
-      &scc_info_TransferRequest_contract_2eproto.base);
-  from_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  to_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  amount_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  header_ = nullptr;
+      &scc_info_IteratorRequest_contract_2eproto.base);
+  start_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  limit_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(&header_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&cap_) -
+      reinterpret_cast<char*>(&header_)) + sizeof(cap_));
 }
 
-TransferRequest::~TransferRequest() {
-  // @@protoc_insertion_point(destructor:contract.TransferRequest)
+IteratorRequest::~IteratorRequest() {
+  // @@protoc_insertion_point(destructor:contract.IteratorRequest)
   SharedDtor();
 }
 
-void TransferRequest::SharedDtor() {
-  from_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  to_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  amount_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+void IteratorRequest::SharedDtor() {
+  start_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  limit_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (this != internal_default_instance()) delete header_;
 }
 
-void TransferRequest::SetCachedSize(int size) const {
+void IteratorRequest::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const TransferRequest& TransferRequest::default_instance() {
-  ::google::protobuf::internal::InitSCC(&::scc_info_TransferRequest_contract_2eproto.base);
+const IteratorRequest& IteratorRequest::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_IteratorRequest_contract_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void TransferRequest::Clear() {
-// @@protoc_insertion_point(message_clear_start:contract.TransferRequest)
+void IteratorRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.IteratorRequest)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  from_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  to_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  amount_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  start_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  limit_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
     delete header_;
   }
   header_ = nullptr;
+  cap_ = 0;
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* TransferRequest::_InternalParse(const char* begin, const char* end, void* object,
+const char* IteratorRequest::_InternalParse(const char* begin, const char* end, void* object,
                   ::google::protobuf::internal::ParseContext* ctx) {
-  auto msg = static_cast<TransferRequest*>(object);
+  auto msg = static_cast<IteratorRequest*>(object);
   ::google::protobuf::int32 size; (void)size;
   int depth; (void)depth;
   ::google::protobuf::uint32 tag;
@@ -2934,52 +3230,41 @@ const char* TransferRequest::_InternalParse(const char* begin, const char* end,
             {parser_till_end, object}, ptr - size, ptr));
         break;
       }
-      // string from = 2;
+      // bytes start = 2;
       case 2: {
         if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
         ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        ctx->extra_parse_data().SetFieldName(nullptr);
-        object = msg->mutable_from();
+        object = msg->mutable_start();
         if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
-          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
           goto string_till_end;
         }
-        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
         ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
         ptr += size;
         break;
       }
-      // string to = 3;
+      // bytes limit = 3;
       case 3: {
         if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
         ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        ctx->extra_parse_data().SetFieldName(nullptr);
-        object = msg->mutable_to();
+        object = msg->mutable_limit();
         if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
-          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
           goto string_till_end;
         }
-        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
         ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
         ptr += size;
         break;
       }
-      // string amount = 4;
+      // int32 cap = 4;
       case 4: {
-        if (static_cast<::google::protobuf::uint8>(tag) != 34) goto handle_unusual;
-        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        if (static_cast<::google::protobuf::uint8>(tag) != 32) goto handle_unusual;
+        msg->set_cap(::google::protobuf::internal::ReadVarint(&ptr));
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        ctx->extra_parse_data().SetFieldName(nullptr);
-        object = msg->mutable_amount();
-        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
-          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
-          goto string_till_end;
-        }
-        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
-        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
-        ptr += size;
         break;
       }
       default: {
@@ -3006,7 +3291,7 @@ len_delim_till_end:
                                {parser_till_end, object}, size);
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool TransferRequest::MergePartialFromCodedStream(
+bool IteratorRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
@@ -3016,7 +3301,7 @@ bool TransferRequest::MergePartialFromCodedStream(
       unknown_fields_setter.buffer());
   ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
       &unknown_fields_output, false);
-  // @@protoc_insertion_point(parse_start:contract.TransferRequest)
+  // @@protoc_insertion_point(parse_start:contract.IteratorRequest)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
@@ -3033,45 +3318,35 @@ bool TransferRequest::MergePartialFromCodedStream(
         break;
       }
 
-      // string from = 2;
+      // bytes start = 2;
       case 2: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_from()));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->from().data(), static_cast<int>(this->from().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "contract.TransferRequest.from"));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_start()));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // string to = 3;
+      // bytes limit = 3;
       case 3: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_to()));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->to().data(), static_cast<int>(this->to().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "contract.TransferRequest.to"));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_limit()));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // string amount = 4;
+      // int32 cap = 4;
       case 4: {
-        if (static_cast< ::google::protobuf::uint8>(tag) == (34 & 0xFF)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_amount()));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->amount().data(), static_cast<int>(this->amount().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "contract.TransferRequest.amount"));
+        if (static_cast< ::google::protobuf::uint8>(tag) == (32 & 0xFF)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &cap_)));
         } else {
           goto handle_unusual;
         }
@@ -3090,18 +3365,18 @@ bool TransferRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:contract.TransferRequest)
+  // @@protoc_insertion_point(parse_success:contract.IteratorRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:contract.TransferRequest)
+  // @@protoc_insertion_point(parse_failure:contract.IteratorRequest)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void TransferRequest::SerializeWithCachedSizes(
+void IteratorRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:contract.TransferRequest)
+  // @@protoc_insertion_point(serialize_start:contract.IteratorRequest)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -3111,43 +3386,30 @@ void TransferRequest::SerializeWithCachedSizes(
       1, HasBitSetters::header(this), output);
   }
 
-  // string from = 2;
-  if (this->from().size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->from().data(), static_cast<int>(this->from().length()),
-      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "contract.TransferRequest.from");
-    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      2, this->from(), output);
+  // bytes start = 2;
+  if (this->start().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->start(), output);
   }
 
-  // string to = 3;
-  if (this->to().size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->to().data(), static_cast<int>(this->to().length()),
-      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "contract.TransferRequest.to");
-    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      3, this->to(), output);
+  // bytes limit = 3;
+  if (this->limit().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->limit(), output);
   }
 
-  // string amount = 4;
-  if (this->amount().size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->amount().data(), static_cast<int>(this->amount().length()),
-      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "contract.TransferRequest.amount");
-    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      4, this->amount(), output);
+  // int32 cap = 4;
+  if (this->cap() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(4, this->cap(), output);
   }
 
   output->WriteRaw(_internal_metadata_.unknown_fields().data(),
                    static_cast<int>(_internal_metadata_.unknown_fields().size()));
-  // @@protoc_insertion_point(serialize_end:contract.TransferRequest)
+  // @@protoc_insertion_point(serialize_end:contract.IteratorRequest)
 }
 
-size_t TransferRequest::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:contract.TransferRequest)
+size_t IteratorRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.IteratorRequest)
   size_t total_size = 0;
 
   total_size += _internal_metadata_.unknown_fields().size();
@@ -3156,25 +3418,18 @@ size_t TransferRequest::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // string from = 2;
-  if (this->from().size() > 0) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->from());
-  }
-
-  // string to = 3;
-  if (this->to().size() > 0) {
+  // bytes start = 2;
+  if (this->start().size() > 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->to());
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->start());
   }
 
-  // string amount = 4;
-  if (this->amount().size() > 0) {
+  // bytes limit = 3;
+  if (this->limit().size() > 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->amount());
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->limit());
   }
 
   // .contract.SyscallHeader header = 1;
@@ -3184,128 +3439,151 @@ size_t TransferRequest::ByteSizeLong() const {
         *header_);
   }
 
+  // int32 cap = 4;
+  if (this->cap() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int32Size(
+        this->cap());
+  }
+
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
   SetCachedSize(cached_size);
   return total_size;
 }
 
-void TransferRequest::CheckTypeAndMergeFrom(
+void IteratorRequest::CheckTypeAndMergeFrom(
     const ::google::protobuf::MessageLite& from) {
-  MergeFrom(*::google::protobuf::down_cast<const TransferRequest*>(&from));
+  MergeFrom(*::google::protobuf::down_cast<const IteratorRequest*>(&from));
 }
 
-void TransferRequest::MergeFrom(const TransferRequest& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:contract.TransferRequest)
+void IteratorRequest::MergeFrom(const IteratorRequest& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.IteratorRequest)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.from().size() > 0) {
-
-    from_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.from_);
-  }
-  if (from.to().size() > 0) {
+  if (from.start().size() > 0) {
 
-    to_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.to_);
+    start_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.start_);
   }
-  if (from.amount().size() > 0) {
+  if (from.limit().size() > 0) {
 
-    amount_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.amount_);
+    limit_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.limit_);
   }
   if (from.has_header()) {
     mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
   }
+  if (from.cap() != 0) {
+    set_cap(from.cap());
+  }
 }
 
-void TransferRequest::CopyFrom(const TransferRequest& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:contract.TransferRequest)
+void IteratorRequest::CopyFrom(const IteratorRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.IteratorRequest)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool TransferRequest::IsInitialized() const {
+bool IteratorRequest::IsInitialized() const {
   return true;
 }
 
-void TransferRequest::Swap(TransferRequest* other) {
+void IteratorRequest::Swap(IteratorRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void TransferRequest::InternalSwap(TransferRequest* other) {
+void IteratorRequest::InternalSwap(IteratorRequest* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  from_.Swap(&other->from_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-    GetArenaNoVirtual());
-  to_.Swap(&other->to_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  start_.Swap(&other->start_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
-  amount_.Swap(&other->amount_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  limit_.Swap(&other->limit_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
   swap(header_, other->header_);
+  swap(cap_, other->cap_);
 }
 
-::std::string TransferRequest::GetTypeName() const {
-  return "contract.TransferRequest";
+::std::string IteratorRequest::GetTypeName() const {
+  return "contract.IteratorRequest";
 }
 
 
 // ===================================================================
 
-void TransferResponse::InitAsDefaultInstance() {
+void IteratorItem::InitAsDefaultInstance() {
 }
-class TransferResponse::HasBitSetters {
+class IteratorItem::HasBitSetters {
  public:
 };
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int IteratorItem::kKeyFieldNumber;
+const int IteratorItem::kValueFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-TransferResponse::TransferResponse()
+IteratorItem::IteratorItem()
   : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:contract.TransferResponse)
+  // @@protoc_insertion_point(constructor:contract.IteratorItem)
 }
-TransferResponse::TransferResponse(const TransferResponse& from)
+IteratorItem::IteratorItem(const IteratorItem& from)
   : ::google::protobuf::MessageLite(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:contract.TransferResponse)
+  key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.key().size() > 0) {
+    key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.key_);
+  }
+  value_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.value().size() > 0) {
+    value_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.value_);
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.IteratorItem)
 }
 
-void TransferResponse::SharedCtor() {
+void IteratorItem::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_IteratorItem_contract_2eproto.base);
+  key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-TransferResponse::~TransferResponse() {
-  // @@protoc_insertion_point(destructor:contract.TransferResponse)
+IteratorItem::~IteratorItem() {
+  // @@protoc_insertion_point(destructor:contract.IteratorItem)
   SharedDtor();
 }
 
-void TransferResponse::SharedDtor() {
+void IteratorItem::SharedDtor() {
+  key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-void TransferResponse::SetCachedSize(int size) const {
+void IteratorItem::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const TransferResponse& TransferResponse::default_instance() {
-  ::google::protobuf::internal::InitSCC(&::scc_info_TransferResponse_contract_2eproto.base);
+const IteratorItem& IteratorItem::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_IteratorItem_contract_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void TransferResponse::Clear() {
-// @@protoc_insertion_point(message_clear_start:contract.TransferResponse)
+void IteratorItem::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.IteratorItem)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* TransferResponse::_InternalParse(const char* begin, const char* end, void* object,
+const char* IteratorItem::_InternalParse(const char* begin, const char* end, void* object,
                   ::google::protobuf::internal::ParseContext* ctx) {
-  auto msg = static_cast<TransferResponse*>(object);
+  auto msg = static_cast<IteratorItem*>(object);
   ::google::protobuf::int32 size; (void)size;
   int depth; (void)depth;
   ::google::protobuf::uint32 tag;
@@ -3315,7 +3593,38 @@ const char* TransferResponse::_InternalParse(const char* begin, const char* end,
     ptr = ::google::protobuf::io::Parse32(ptr, &tag);
     GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
     switch (tag >> 3) {
+      // bytes key = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        object = msg->mutable_key();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // bytes value = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        object = msg->mutable_value();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
       default: {
+      handle_unusual:
         if ((tag & 7) == 4 || tag == 0) {
           ctx->EndGroup(tag);
           return ptr;
@@ -3329,9 +3638,16 @@ const char* TransferResponse::_InternalParse(const char* begin, const char* end,
     }  // switch
   }  // while
   return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool TransferResponse::MergePartialFromCodedStream(
+bool IteratorItem::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
@@ -3341,41 +3657,80 @@ bool TransferResponse::MergePartialFromCodedStream(
       unknown_fields_setter.buffer());
   ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
       &unknown_fields_output, false);
-  // @@protoc_insertion_point(parse_start:contract.TransferResponse)
+  // @@protoc_insertion_point(parse_start:contract.IteratorItem)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
-  handle_unusual:
-    if (tag == 0) {
-      goto success;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // bytes key = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bytes value = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
     }
-    DO_(::google::protobuf::internal::WireFormatLite::SkipField(
-        input, tag, &unknown_fields_stream));
   }
 success:
-  // @@protoc_insertion_point(parse_success:contract.TransferResponse)
+  // @@protoc_insertion_point(parse_success:contract.IteratorItem)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:contract.TransferResponse)
+  // @@protoc_insertion_point(parse_failure:contract.IteratorItem)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void TransferResponse::SerializeWithCachedSizes(
+void IteratorItem::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:contract.TransferResponse)
+  // @@protoc_insertion_point(serialize_start:contract.IteratorItem)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
+  // bytes key = 1;
+  if (this->key().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  // bytes value = 2;
+  if (this->value().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->value(), output);
+  }
+
   output->WriteRaw(_internal_metadata_.unknown_fields().data(),
                    static_cast<int>(_internal_metadata_.unknown_fields().size()));
-  // @@protoc_insertion_point(serialize_end:contract.TransferResponse)
+  // @@protoc_insertion_point(serialize_end:contract.IteratorItem)
 }
 
-size_t TransferResponse::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:contract.TransferResponse)
+size_t IteratorItem::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.IteratorItem)
   size_t total_size = 0;
 
   total_size += _internal_metadata_.unknown_fields().size();
@@ -3384,143 +3739,5036 @@ size_t TransferResponse::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
+  // bytes key = 1;
+  if (this->key().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->key());
+  }
+
+  // bytes value = 2;
+  if (this->value().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->value());
+  }
+
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
   SetCachedSize(cached_size);
   return total_size;
 }
 
-void TransferResponse::CheckTypeAndMergeFrom(
+void IteratorItem::CheckTypeAndMergeFrom(
     const ::google::protobuf::MessageLite& from) {
-  MergeFrom(*::google::protobuf::down_cast<const TransferResponse*>(&from));
+  MergeFrom(*::google::protobuf::down_cast<const IteratorItem*>(&from));
 }
 
-void TransferResponse::MergeFrom(const TransferResponse& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:contract.TransferResponse)
+void IteratorItem::MergeFrom(const IteratorItem& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.IteratorItem)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
+  if (from.key().size() > 0) {
+
+    key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.key_);
+  }
+  if (from.value().size() > 0) {
+
+    value_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.value_);
+  }
 }
 
-void TransferResponse::CopyFrom(const TransferResponse& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:contract.TransferResponse)
+void IteratorItem::CopyFrom(const IteratorItem& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.IteratorItem)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool TransferResponse::IsInitialized() const {
+bool IteratorItem::IsInitialized() const {
   return true;
 }
 
-void TransferResponse::Swap(TransferResponse* other) {
+void IteratorItem::Swap(IteratorItem* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void TransferResponse::InternalSwap(TransferResponse* other) {
+void IteratorItem::InternalSwap(IteratorItem* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
+  key_.Swap(&other->key_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  value_.Swap(&other->value_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
 }
 
-::std::string TransferResponse::GetTypeName() const {
-  return "contract.TransferResponse";
+::std::string IteratorItem::GetTypeName() const {
+  return "contract.IteratorItem";
 }
 
 
 // ===================================================================
 
-void ContractCallRequest::InitAsDefaultInstance() {
-  ::contract::_ContractCallRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
-      ::contract::SyscallHeader::internal_default_instance());
+void IteratorResponse::InitAsDefaultInstance() {
 }
-class ContractCallRequest::HasBitSetters {
+class IteratorResponse::HasBitSetters {
  public:
-  static const ::contract::SyscallHeader& header(const ContractCallRequest* msg);
 };
 
-const ::contract::SyscallHeader&
-ContractCallRequest::HasBitSetters::header(const ContractCallRequest* msg) {
-  return *msg->header_;
-}
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int ContractCallRequest::kHeaderFieldNumber;
-const int ContractCallRequest::kModuleFieldNumber;
-const int ContractCallRequest::kContractFieldNumber;
-const int ContractCallRequest::kMethodFieldNumber;
-const int ContractCallRequest::kArgsFieldNumber;
+const int IteratorResponse::kItemsFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-ContractCallRequest::ContractCallRequest()
+IteratorResponse::IteratorResponse()
   : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:contract.ContractCallRequest)
+  // @@protoc_insertion_point(constructor:contract.IteratorResponse)
 }
-ContractCallRequest::ContractCallRequest(const ContractCallRequest& from)
+IteratorResponse::IteratorResponse(const IteratorResponse& from)
   : ::google::protobuf::MessageLite(),
       _internal_metadata_(nullptr),
-      args_(from.args_) {
+      items_(from.items_) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  module_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.module().size() > 0) {
-    module_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.module_);
-  }
-  contract_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(copy_constructor:contract.IteratorResponse)
+}
+
+void IteratorResponse::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_IteratorResponse_contract_2eproto.base);
+}
+
+IteratorResponse::~IteratorResponse() {
+  // @@protoc_insertion_point(destructor:contract.IteratorResponse)
+  SharedDtor();
+}
+
+void IteratorResponse::SharedDtor() {
+}
+
+void IteratorResponse::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const IteratorResponse& IteratorResponse::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_IteratorResponse_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void IteratorResponse::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.IteratorResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  items_.Clear();
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* IteratorResponse::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<IteratorResponse*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // repeated .contract.IteratorItem items = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        do {
+          ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+          parser_till_end = ::contract::IteratorItem::_InternalParse;
+          object = msg->add_items();
+          if (size > end - ptr) goto len_delim_till_end;
+          ptr += size;
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+              {parser_till_end, object}, ptr - size, ptr));
+          if (ptr >= end) break;
+        } while ((::google::protobuf::io::UnalignedLoad<::google::protobuf::uint64>(ptr) & 255) == 10 && (ptr += 1));
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool IteratorResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.IteratorResponse)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // repeated .contract.IteratorItem items = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+                input, add_items()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.IteratorResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.IteratorResponse)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void IteratorResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.IteratorResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // repeated .contract.IteratorItem items = 1;
+  for (unsigned int i = 0,
+      n = static_cast<unsigned int>(this->items_size()); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      1,
+      this->items(static_cast<int>(i)),
+      output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.IteratorResponse)
+}
+
+size_t IteratorResponse::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.IteratorResponse)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // repeated .contract.IteratorItem items = 1;
+  {
+    unsigned int count = static_cast<unsigned int>(this->items_size());
+    total_size += 1UL * count;
+    for (unsigned int i = 0; i < count; i++) {
+      total_size +=
+        ::google::protobuf::internal::WireFormatLite::MessageSize(
+          this->items(static_cast<int>(i)));
+    }
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void IteratorResponse::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const IteratorResponse*>(&from));
+}
+
+void IteratorResponse::MergeFrom(const IteratorResponse& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.IteratorResponse)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  items_.MergeFrom(from.items_);
+}
+
+void IteratorResponse::CopyFrom(const IteratorResponse& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.IteratorResponse)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool IteratorResponse::IsInitialized() const {
+  return true;
+}
+
+void IteratorResponse::Swap(IteratorResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void IteratorResponse::InternalSwap(IteratorResponse* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  CastToBase(&items_)->InternalSwap(CastToBase(&other->items_));
+}
+
+::std::string IteratorResponse::GetTypeName() const {
+  return "contract.IteratorResponse";
+}
+
+
+// ===================================================================
+
+void TransferRequest::InitAsDefaultInstance() {
+  ::contract::_TransferRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
+      ::contract::SyscallHeader::internal_default_instance());
+}
+class TransferRequest::HasBitSetters {
+ public:
+  static const ::contract::SyscallHeader& header(const TransferRequest* msg);
+};
+
+const ::contract::SyscallHeader&
+TransferRequest::HasBitSetters::header(const TransferRequest* msg) {
+  return *msg->header_;
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int TransferRequest::kHeaderFieldNumber;
+const int TransferRequest::kFromFieldNumber;
+const int TransferRequest::kToFieldNumber;
+const int TransferRequest::kAmountFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+TransferRequest::TransferRequest()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.TransferRequest)
+}
+TransferRequest::TransferRequest(const TransferRequest& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  from_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.from().size() > 0) {
+    from_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.from_);
+  }
+  to_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.to().size() > 0) {
+    to_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.to_);
+  }
+  amount_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.amount().size() > 0) {
+    amount_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.amount_);
+  }
+  if (from.has_header()) {
+    header_ = new ::contract::SyscallHeader(*from.header_);
+  } else {
+    header_ = nullptr;
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.TransferRequest)
+}
+
+void TransferRequest::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_TransferRequest_contract_2eproto.base);
+  from_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  to_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  amount_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  header_ = nullptr;
+}
+
+TransferRequest::~TransferRequest() {
+  // @@protoc_insertion_point(destructor:contract.TransferRequest)
+  SharedDtor();
+}
+
+void TransferRequest::SharedDtor() {
+  from_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  to_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  amount_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != internal_default_instance()) delete header_;
+}
+
+void TransferRequest::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const TransferRequest& TransferRequest::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_TransferRequest_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void TransferRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.TransferRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  from_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  to_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  amount_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* TransferRequest::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<TransferRequest*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        parser_till_end = ::contract::SyscallHeader::_InternalParse;
+        object = msg->mutable_header();
+        if (size > end - ptr) goto len_delim_till_end;
+        ptr += size;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      // string from = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_from();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // string to = 3;
+      case 3: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_to();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // string amount = 4;
+      case 4: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 34) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_amount();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool TransferRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.TransferRequest)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string from = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_from()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->from().data(), static_cast<int>(this->from().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.TransferRequest.from"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string to = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_to()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->to().data(), static_cast<int>(this->to().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.TransferRequest.to"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string amount = 4;
+      case 4: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (34 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_amount()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->amount().data(), static_cast<int>(this->amount().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.TransferRequest.amount"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.TransferRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.TransferRequest)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void TransferRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.TransferRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      1, HasBitSetters::header(this), output);
+  }
+
+  // string from = 2;
+  if (this->from().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->from().data(), static_cast<int>(this->from().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.TransferRequest.from");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->from(), output);
+  }
+
+  // string to = 3;
+  if (this->to().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->to().data(), static_cast<int>(this->to().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.TransferRequest.to");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      3, this->to(), output);
+  }
+
+  // string amount = 4;
+  if (this->amount().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->amount().data(), static_cast<int>(this->amount().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.TransferRequest.amount");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      4, this->amount(), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.TransferRequest)
+}
+
+size_t TransferRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.TransferRequest)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string from = 2;
+  if (this->from().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->from());
+  }
+
+  // string to = 3;
+  if (this->to().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->to());
+  }
+
+  // string amount = 4;
+  if (this->amount().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->amount());
+  }
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *header_);
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void TransferRequest::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const TransferRequest*>(&from));
+}
+
+void TransferRequest::MergeFrom(const TransferRequest& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.TransferRequest)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.from().size() > 0) {
+
+    from_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.from_);
+  }
+  if (from.to().size() > 0) {
+
+    to_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.to_);
+  }
+  if (from.amount().size() > 0) {
+
+    amount_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.amount_);
+  }
+  if (from.has_header()) {
+    mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
+  }
+}
+
+void TransferRequest::CopyFrom(const TransferRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.TransferRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool TransferRequest::IsInitialized() const {
+  return true;
+}
+
+void TransferRequest::Swap(TransferRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void TransferRequest::InternalSwap(TransferRequest* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  from_.Swap(&other->from_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  to_.Swap(&other->to_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  amount_.Swap(&other->amount_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(header_, other->header_);
+}
+
+::std::string TransferRequest::GetTypeName() const {
+  return "contract.TransferRequest";
+}
+
+
+// ===================================================================
+
+void TransferResponse::InitAsDefaultInstance() {
+}
+class TransferResponse::HasBitSetters {
+ public:
+};
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+TransferResponse::TransferResponse()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.TransferResponse)
+}
+TransferResponse::TransferResponse(const TransferResponse& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:contract.TransferResponse)
+}
+
+void TransferResponse::SharedCtor() {
+}
+
+TransferResponse::~TransferResponse() {
+  // @@protoc_insertion_point(destructor:contract.TransferResponse)
+  SharedDtor();
+}
+
+void TransferResponse::SharedDtor() {
+}
+
+void TransferResponse::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const TransferResponse& TransferResponse::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_TransferResponse_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void TransferResponse::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.TransferResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* TransferResponse::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<TransferResponse*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      default: {
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool TransferResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.TransferResponse)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+  handle_unusual:
+    if (tag == 0) {
+      goto success;
+    }
+    DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+        input, tag, &unknown_fields_stream));
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.TransferResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.TransferResponse)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void TransferResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.TransferResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.TransferResponse)
+}
+
+size_t TransferResponse::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.TransferResponse)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void TransferResponse::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const TransferResponse*>(&from));
+}
+
+void TransferResponse::MergeFrom(const TransferResponse& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.TransferResponse)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+}
+
+void TransferResponse::CopyFrom(const TransferResponse& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.TransferResponse)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool TransferResponse::IsInitialized() const {
+  return true;
+}
+
+void TransferResponse::Swap(TransferResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void TransferResponse::InternalSwap(TransferResponse* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+}
+
+::std::string TransferResponse::GetTypeName() const {
+  return "contract.TransferResponse";
+}
+
+
+// ===================================================================
+
+void ContractEvent::InitAsDefaultInstance() {
+}
+class ContractEvent::HasBitSetters {
+ public:
+};
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int ContractEvent::kContractFieldNumber;
+const int ContractEvent::kNameFieldNumber;
+const int ContractEvent::kBodyFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+ContractEvent::ContractEvent()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.ContractEvent)
+}
+ContractEvent::ContractEvent(const ContractEvent& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  contract_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.contract().size() > 0) {
+    contract_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.contract_);
+  }
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.name().size() > 0) {
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  body_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.body().size() > 0) {
+    body_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.body_);
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.ContractEvent)
+}
+
+void ContractEvent::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_ContractEvent_contract_2eproto.base);
+  contract_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  body_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+ContractEvent::~ContractEvent() {
+  // @@protoc_insertion_point(destructor:contract.ContractEvent)
+  SharedDtor();
+}
+
+void ContractEvent::SharedDtor() {
+  contract_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  name_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  body_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+void ContractEvent::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const ContractEvent& ContractEvent::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_ContractEvent_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void ContractEvent::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.ContractEvent)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  contract_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  body_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* ContractEvent::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<ContractEvent*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // string contract = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_contract();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // string name = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_name();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // bytes body = 3;
+      case 3: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        object = msg->mutable_body();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool ContractEvent::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.ContractEvent)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // string contract = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_contract()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->contract().data(), static_cast<int>(this->contract().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ContractEvent.contract"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string name = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->name().data(), static_cast<int>(this->name().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ContractEvent.name"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bytes body = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_body()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.ContractEvent)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.ContractEvent)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void ContractEvent::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.ContractEvent)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // string contract = 1;
+  if (this->contract().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->contract().data(), static_cast<int>(this->contract().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ContractEvent.contract");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->contract(), output);
+  }
+
+  // string name = 2;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ContractEvent.name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->name(), output);
+  }
+
+  // bytes body = 3;
+  if (this->body().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->body(), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.ContractEvent)
+}
+
+size_t ContractEvent::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.ContractEvent)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string contract = 1;
+  if (this->contract().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->contract());
+  }
+
+  // string name = 2;
+  if (this->name().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->name());
+  }
+
+  // bytes body = 3;
+  if (this->body().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->body());
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void ContractEvent::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const ContractEvent*>(&from));
+}
+
+void ContractEvent::MergeFrom(const ContractEvent& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.ContractEvent)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.contract().size() > 0) {
+
+    contract_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.contract_);
+  }
+  if (from.name().size() > 0) {
+
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  if (from.body().size() > 0) {
+
+    body_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.body_);
+  }
+}
+
+void ContractEvent::CopyFrom(const ContractEvent& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.ContractEvent)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ContractEvent::IsInitialized() const {
+  return true;
+}
+
+void ContractEvent::Swap(ContractEvent* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ContractEvent::InternalSwap(ContractEvent* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  contract_.Swap(&other->contract_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  name_.Swap(&other->name_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  body_.Swap(&other->body_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+}
+
+::std::string ContractEvent::GetTypeName() const {
+  return "contract.ContractEvent";
+}
+
+
+// ===================================================================
+
+void EmitEventRequest::InitAsDefaultInstance() {
+  ::contract::_EmitEventRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
+      ::contract::SyscallHeader::internal_default_instance());
+}
+class EmitEventRequest::HasBitSetters {
+ public:
+  static const ::contract::SyscallHeader& header(const EmitEventRequest* msg);
+};
+
+const ::contract::SyscallHeader&
+EmitEventRequest::HasBitSetters::header(const EmitEventRequest* msg) {
+  return *msg->header_;
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int EmitEventRequest::kHeaderFieldNumber;
+const int EmitEventRequest::kNameFieldNumber;
+const int EmitEventRequest::kBodyFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+EmitEventRequest::EmitEventRequest()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.EmitEventRequest)
+}
+EmitEventRequest::EmitEventRequest(const EmitEventRequest& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.name().size() > 0) {
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  body_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.body().size() > 0) {
+    body_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.body_);
+  }
+  if (from.has_header()) {
+    header_ = new ::contract::SyscallHeader(*from.header_);
+  } else {
+    header_ = nullptr;
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.EmitEventRequest)
+}
+
+void EmitEventRequest::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_EmitEventRequest_contract_2eproto.base);
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  body_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  header_ = nullptr;
+}
+
+EmitEventRequest::~EmitEventRequest() {
+  // @@protoc_insertion_point(destructor:contract.EmitEventRequest)
+  SharedDtor();
+}
+
+void EmitEventRequest::SharedDtor() {
+  name_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  body_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != internal_default_instance()) delete header_;
+}
+
+void EmitEventRequest::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const EmitEventRequest& EmitEventRequest::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_EmitEventRequest_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void EmitEventRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.EmitEventRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  body_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* EmitEventRequest::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<EmitEventRequest*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        parser_till_end = ::contract::SyscallHeader::_InternalParse;
+        object = msg->mutable_header();
+        if (size > end - ptr) goto len_delim_till_end;
+        ptr += size;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      // string name = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_name();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // bytes body = 3;
+      case 3: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        object = msg->mutable_body();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool EmitEventRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.EmitEventRequest)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string name = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->name().data(), static_cast<int>(this->name().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.EmitEventRequest.name"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bytes body = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_body()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.EmitEventRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.EmitEventRequest)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void EmitEventRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.EmitEventRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      1, HasBitSetters::header(this), output);
+  }
+
+  // string name = 2;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.EmitEventRequest.name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->name(), output);
+  }
+
+  // bytes body = 3;
+  if (this->body().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->body(), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.EmitEventRequest)
+}
+
+size_t EmitEventRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.EmitEventRequest)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string name = 2;
+  if (this->name().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->name());
+  }
+
+  // bytes body = 3;
+  if (this->body().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->body());
+  }
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *header_);
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void EmitEventRequest::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const EmitEventRequest*>(&from));
+}
+
+void EmitEventRequest::MergeFrom(const EmitEventRequest& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.EmitEventRequest)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.name().size() > 0) {
+
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  if (from.body().size() > 0) {
+
+    body_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.body_);
+  }
+  if (from.has_header()) {
+    mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
+  }
+}
+
+void EmitEventRequest::CopyFrom(const EmitEventRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.EmitEventRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EmitEventRequest::IsInitialized() const {
+  return true;
+}
+
+void EmitEventRequest::Swap(EmitEventRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void EmitEventRequest::InternalSwap(EmitEventRequest* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  name_.Swap(&other->name_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  body_.Swap(&other->body_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(header_, other->header_);
+}
+
+::std::string EmitEventRequest::GetTypeName() const {
+  return "contract.EmitEventRequest";
+}
+
+
+// ===================================================================
+
+void EmitEventResponse::InitAsDefaultInstance() {
+}
+class EmitEventResponse::HasBitSetters {
+ public:
+};
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+EmitEventResponse::EmitEventResponse()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.EmitEventResponse)
+}
+EmitEventResponse::EmitEventResponse(const EmitEventResponse& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:contract.EmitEventResponse)
+}
+
+void EmitEventResponse::SharedCtor() {
+}
+
+EmitEventResponse::~EmitEventResponse() {
+  // @@protoc_insertion_point(destructor:contract.EmitEventResponse)
+  SharedDtor();
+}
+
+void EmitEventResponse::SharedDtor() {
+}
+
+void EmitEventResponse::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const EmitEventResponse& EmitEventResponse::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_EmitEventResponse_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void EmitEventResponse::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.EmitEventResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* EmitEventResponse::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<EmitEventResponse*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      default: {
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool EmitEventResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.EmitEventResponse)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+  handle_unusual:
+    if (tag == 0) {
+      goto success;
+    }
+    DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+        input, tag, &unknown_fields_stream));
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.EmitEventResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.EmitEventResponse)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void EmitEventResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.EmitEventResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.EmitEventResponse)
+}
+
+size_t EmitEventResponse::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.EmitEventResponse)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void EmitEventResponse::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const EmitEventResponse*>(&from));
+}
+
+void EmitEventResponse::MergeFrom(const EmitEventResponse& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.EmitEventResponse)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+}
+
+void EmitEventResponse::CopyFrom(const EmitEventResponse& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.EmitEventResponse)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EmitEventResponse::IsInitialized() const {
+  return true;
+}
+
+void EmitEventResponse::Swap(EmitEventResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void EmitEventResponse::InternalSwap(EmitEventResponse* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+}
+
+::std::string EmitEventResponse::GetTypeName() const {
+  return "contract.EmitEventResponse";
+}
+
+
+// ===================================================================
+
+void ContractCallRequest::InitAsDefaultInstance() {
+  ::contract::_ContractCallRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
+      ::contract::SyscallHeader::internal_default_instance());
+}
+class ContractCallRequest::HasBitSetters {
+ public:
+  static const ::contract::SyscallHeader& header(const ContractCallRequest* msg);
+};
+
+const ::contract::SyscallHeader&
+ContractCallRequest::HasBitSetters::header(const ContractCallRequest* msg) {
+  return *msg->header_;
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int ContractCallRequest::kHeaderFieldNumber;
+const int ContractCallRequest::kModuleFieldNumber;
+const int ContractCallRequest::kContractFieldNumber;
+const int ContractCallRequest::kMethodFieldNumber;
+const int ContractCallRequest::kArgsFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+ContractCallRequest::ContractCallRequest()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.ContractCallRequest)
+}
+ContractCallRequest::ContractCallRequest(const ContractCallRequest& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr),
+      args_(from.args_) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  module_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.module().size() > 0) {
+    module_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.module_);
+  }
+  contract_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.contract().size() > 0) {
+    contract_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.contract_);
+  }
+  method_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.method().size() > 0) {
+    method_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.method_);
+  }
+  if (from.has_header()) {
+    header_ = new ::contract::SyscallHeader(*from.header_);
+  } else {
+    header_ = nullptr;
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.ContractCallRequest)
+}
+
+void ContractCallRequest::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_ContractCallRequest_contract_2eproto.base);
+  module_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  contract_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  method_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  header_ = nullptr;
+}
+
+ContractCallRequest::~ContractCallRequest() {
+  // @@protoc_insertion_point(destructor:contract.ContractCallRequest)
+  SharedDtor();
+}
+
+void ContractCallRequest::SharedDtor() {
+  module_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  contract_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  method_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != internal_default_instance()) delete header_;
+}
+
+void ContractCallRequest::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const ContractCallRequest& ContractCallRequest::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_ContractCallRequest_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void ContractCallRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.ContractCallRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  args_.Clear();
+  module_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  contract_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  method_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* ContractCallRequest::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<ContractCallRequest*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        parser_till_end = ::contract::SyscallHeader::_InternalParse;
+        object = msg->mutable_header();
+        if (size > end - ptr) goto len_delim_till_end;
+        ptr += size;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      // string module = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_module();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // string contract = 3;
+      case 3: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_contract();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // string method = 4;
+      case 4: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 34) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_method();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // repeated .contract.ArgPair args = 5;
+      case 5: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 42) goto handle_unusual;
+        do {
+          ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+          parser_till_end = ::contract::ArgPair::_InternalParse;
+          object = msg->add_args();
+          if (size > end - ptr) goto len_delim_till_end;
+          ptr += size;
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+              {parser_till_end, object}, ptr - size, ptr));
+          if (ptr >= end) break;
+        } while ((::google::protobuf::io::UnalignedLoad<::google::protobuf::uint64>(ptr) & 255) == 42 && (ptr += 1));
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool ContractCallRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.ContractCallRequest)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string module = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_module()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->module().data(), static_cast<int>(this->module().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ContractCallRequest.module"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string contract = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_contract()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->contract().data(), static_cast<int>(this->contract().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ContractCallRequest.contract"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string method = 4;
+      case 4: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (34 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_method()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->method().data(), static_cast<int>(this->method().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ContractCallRequest.method"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // repeated .contract.ArgPair args = 5;
+      case 5: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (42 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+                input, add_args()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.ContractCallRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.ContractCallRequest)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void ContractCallRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.ContractCallRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      1, HasBitSetters::header(this), output);
+  }
+
+  // string module = 2;
+  if (this->module().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->module().data(), static_cast<int>(this->module().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ContractCallRequest.module");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->module(), output);
+  }
+
+  // string contract = 3;
+  if (this->contract().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->contract().data(), static_cast<int>(this->contract().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ContractCallRequest.contract");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      3, this->contract(), output);
+  }
+
+  // string method = 4;
+  if (this->method().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->method().data(), static_cast<int>(this->method().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ContractCallRequest.method");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      4, this->method(), output);
+  }
+
+  // repeated .contract.ArgPair args = 5;
+  for (unsigned int i = 0,
+      n = static_cast<unsigned int>(this->args_size()); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      5,
+      this->args(static_cast<int>(i)),
+      output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.ContractCallRequest)
+}
+
+size_t ContractCallRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.ContractCallRequest)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // repeated .contract.ArgPair args = 5;
+  {
+    unsigned int count = static_cast<unsigned int>(this->args_size());
+    total_size += 1UL * count;
+    for (unsigned int i = 0; i < count; i++) {
+      total_size +=
+        ::google::protobuf::internal::WireFormatLite::MessageSize(
+          this->args(static_cast<int>(i)));
+    }
+  }
+
+  // string module = 2;
+  if (this->module().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->module());
+  }
+
+  // string contract = 3;
+  if (this->contract().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->contract());
+  }
+
+  // string method = 4;
+  if (this->method().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->method());
+  }
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *header_);
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void ContractCallRequest::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const ContractCallRequest*>(&from));
+}
+
+void ContractCallRequest::MergeFrom(const ContractCallRequest& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.ContractCallRequest)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  args_.MergeFrom(from.args_);
+  if (from.module().size() > 0) {
+
+    module_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.module_);
+  }
   if (from.contract().size() > 0) {
+
     contract_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.contract_);
   }
-  method_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.method().size() > 0) {
-    method_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.method_);
+  if (from.method().size() > 0) {
+
+    method_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.method_);
+  }
+  if (from.has_header()) {
+    mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
+  }
+}
+
+void ContractCallRequest::CopyFrom(const ContractCallRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.ContractCallRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ContractCallRequest::IsInitialized() const {
+  return true;
+}
+
+void ContractCallRequest::Swap(ContractCallRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ContractCallRequest::InternalSwap(ContractCallRequest* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  CastToBase(&args_)->InternalSwap(CastToBase(&other->args_));
+  module_.Swap(&other->module_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  contract_.Swap(&other->contract_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  method_.Swap(&other->method_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(header_, other->header_);
+}
+
+::std::string ContractCallRequest::GetTypeName() const {
+  return "contract.ContractCallRequest";
+}
+
+
+// ===================================================================
+
+void ContractCallResponse::InitAsDefaultInstance() {
+  ::contract::_ContractCallResponse_default_instance_._instance.get_mutable()->response_ = const_cast< ::contract::Response*>(
+      ::contract::Response::internal_default_instance());
+}
+class ContractCallResponse::HasBitSetters {
+ public:
+  static const ::contract::Response& response(const ContractCallResponse* msg);
+};
+
+const ::contract::Response&
+ContractCallResponse::HasBitSetters::response(const ContractCallResponse* msg) {
+  return *msg->response_;
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int ContractCallResponse::kResponseFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+ContractCallResponse::ContractCallResponse()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.ContractCallResponse)
+}
+ContractCallResponse::ContractCallResponse(const ContractCallResponse& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  if (from.has_response()) {
+    response_ = new ::contract::Response(*from.response_);
+  } else {
+    response_ = nullptr;
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.ContractCallResponse)
+}
+
+void ContractCallResponse::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_ContractCallResponse_contract_2eproto.base);
+  response_ = nullptr;
+}
+
+ContractCallResponse::~ContractCallResponse() {
+  // @@protoc_insertion_point(destructor:contract.ContractCallResponse)
+  SharedDtor();
+}
+
+void ContractCallResponse::SharedDtor() {
+  if (this != internal_default_instance()) delete response_;
+}
+
+void ContractCallResponse::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const ContractCallResponse& ContractCallResponse::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_ContractCallResponse_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void ContractCallResponse::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.ContractCallResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaNoVirtual() == nullptr && response_ != nullptr) {
+    delete response_;
+  }
+  response_ = nullptr;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* ContractCallResponse::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<ContractCallResponse*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // .contract.Response response = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        parser_till_end = ::contract::Response::_InternalParse;
+        object = msg->mutable_response();
+        if (size > end - ptr) goto len_delim_till_end;
+        ptr += size;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool ContractCallResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.ContractCallResponse)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .contract.Response response = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_response()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.ContractCallResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.ContractCallResponse)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void ContractCallResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.ContractCallResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .contract.Response response = 1;
+  if (this->has_response()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      1, HasBitSetters::response(this), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.ContractCallResponse)
+}
+
+size_t ContractCallResponse::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.ContractCallResponse)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .contract.Response response = 1;
+  if (this->has_response()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *response_);
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void ContractCallResponse::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const ContractCallResponse*>(&from));
+}
+
+void ContractCallResponse::MergeFrom(const ContractCallResponse& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.ContractCallResponse)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.has_response()) {
+    mutable_response()->::contract::Response::MergeFrom(from.response());
+  }
+}
+
+void ContractCallResponse::CopyFrom(const ContractCallResponse& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.ContractCallResponse)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ContractCallResponse::IsInitialized() const {
+  return true;
+}
+
+void ContractCallResponse::Swap(ContractCallResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ContractCallResponse::InternalSwap(ContractCallResponse* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  swap(response_, other->response_);
+}
+
+::std::string ContractCallResponse::GetTypeName() const {
+  return "contract.ContractCallResponse";
+}
+
+
+// ===================================================================
+
+void Response::InitAsDefaultInstance() {
+}
+class Response::HasBitSetters {
+ public:
+};
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int Response::kStatusFieldNumber;
+const int Response::kMessageFieldNumber;
+const int Response::kBodyFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+Response::Response()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.Response)
+}
+Response::Response(const Response& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  message_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.message().size() > 0) {
+    message_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.message_);
+  }
+  body_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.body().size() > 0) {
+    body_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.body_);
+  }
+  status_ = from.status_;
+  // @@protoc_insertion_point(copy_constructor:contract.Response)
+}
+
+void Response::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_Response_contract_2eproto.base);
+  message_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  body_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  status_ = 0;
+}
+
+Response::~Response() {
+  // @@protoc_insertion_point(destructor:contract.Response)
+  SharedDtor();
+}
+
+void Response::SharedDtor() {
+  message_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  body_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+void Response::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const Response& Response::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_Response_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void Response::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.Response)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  message_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  body_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  status_ = 0;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* Response::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<Response*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // int32 status = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 8) goto handle_unusual;
+        msg->set_status(::google::protobuf::internal::ReadVarint(&ptr));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        break;
+      }
+      // string message = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_message();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // bytes body = 3;
+      case 3: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        object = msg->mutable_body();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool Response::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.Response)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // int32 status = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (8 & 0xFF)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &status_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string message = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_message()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->message().data(), static_cast<int>(this->message().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.Response.message"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bytes body = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_body()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.Response)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.Response)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void Response::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.Response)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int32 status = 1;
+  if (this->status() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->status(), output);
+  }
+
+  // string message = 2;
+  if (this->message().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->message().data(), static_cast<int>(this->message().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.Response.message");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->message(), output);
+  }
+
+  // bytes body = 3;
+  if (this->body().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->body(), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.Response)
+}
+
+size_t Response::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.Response)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string message = 2;
+  if (this->message().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->message());
+  }
+
+  // bytes body = 3;
+  if (this->body().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->body());
+  }
+
+  // int32 status = 1;
+  if (this->status() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int32Size(
+        this->status());
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void Response::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const Response*>(&from));
+}
+
+void Response::MergeFrom(const Response& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.Response)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.message().size() > 0) {
+
+    message_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.message_);
+  }
+  if (from.body().size() > 0) {
+
+    body_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.body_);
+  }
+  if (from.status() != 0) {
+    set_status(from.status());
+  }
+}
+
+void Response::CopyFrom(const Response& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.Response)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Response::IsInitialized() const {
+  return true;
+}
+
+void Response::Swap(Response* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void Response::InternalSwap(Response* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  message_.Swap(&other->message_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  body_.Swap(&other->body_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(status_, other->status_);
+}
+
+::std::string Response::GetTypeName() const {
+  return "contract.Response";
+}
+
+
+// ===================================================================
+
+void SetOutputRequest::InitAsDefaultInstance() {
+  ::contract::_SetOutputRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
+      ::contract::SyscallHeader::internal_default_instance());
+  ::contract::_SetOutputRequest_default_instance_._instance.get_mutable()->response_ = const_cast< ::contract::Response*>(
+      ::contract::Response::internal_default_instance());
+}
+class SetOutputRequest::HasBitSetters {
+ public:
+  static const ::contract::SyscallHeader& header(const SetOutputRequest* msg);
+  static const ::contract::Response& response(const SetOutputRequest* msg);
+};
+
+const ::contract::SyscallHeader&
+SetOutputRequest::HasBitSetters::header(const SetOutputRequest* msg) {
+  return *msg->header_;
+}
+const ::contract::Response&
+SetOutputRequest::HasBitSetters::response(const SetOutputRequest* msg) {
+  return *msg->response_;
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int SetOutputRequest::kHeaderFieldNumber;
+const int SetOutputRequest::kResponseFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+SetOutputRequest::SetOutputRequest()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.SetOutputRequest)
+}
+SetOutputRequest::SetOutputRequest(const SetOutputRequest& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  if (from.has_header()) {
+    header_ = new ::contract::SyscallHeader(*from.header_);
+  } else {
+    header_ = nullptr;
+  }
+  if (from.has_response()) {
+    response_ = new ::contract::Response(*from.response_);
+  } else {
+    response_ = nullptr;
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.SetOutputRequest)
+}
+
+void SetOutputRequest::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_SetOutputRequest_contract_2eproto.base);
+  ::memset(&header_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&response_) -
+      reinterpret_cast<char*>(&header_)) + sizeof(response_));
+}
+
+SetOutputRequest::~SetOutputRequest() {
+  // @@protoc_insertion_point(destructor:contract.SetOutputRequest)
+  SharedDtor();
+}
+
+void SetOutputRequest::SharedDtor() {
+  if (this != internal_default_instance()) delete header_;
+  if (this != internal_default_instance()) delete response_;
+}
+
+void SetOutputRequest::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const SetOutputRequest& SetOutputRequest::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_SetOutputRequest_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void SetOutputRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.SetOutputRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && response_ != nullptr) {
+    delete response_;
+  }
+  response_ = nullptr;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* SetOutputRequest::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<SetOutputRequest*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        parser_till_end = ::contract::SyscallHeader::_InternalParse;
+        object = msg->mutable_header();
+        if (size > end - ptr) goto len_delim_till_end;
+        ptr += size;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      // .contract.Response response = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        parser_till_end = ::contract::Response::_InternalParse;
+        object = msg->mutable_response();
+        if (size > end - ptr) goto len_delim_till_end;
+        ptr += size;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool SetOutputRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.SetOutputRequest)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .contract.Response response = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_response()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.SetOutputRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.SetOutputRequest)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void SetOutputRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.SetOutputRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      1, HasBitSetters::header(this), output);
+  }
+
+  // .contract.Response response = 2;
+  if (this->has_response()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      2, HasBitSetters::response(this), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.SetOutputRequest)
+}
+
+size_t SetOutputRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.SetOutputRequest)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *header_);
+  }
+
+  // .contract.Response response = 2;
+  if (this->has_response()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *response_);
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void SetOutputRequest::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const SetOutputRequest*>(&from));
+}
+
+void SetOutputRequest::MergeFrom(const SetOutputRequest& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.SetOutputRequest)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.has_header()) {
+    mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
+  }
+  if (from.has_response()) {
+    mutable_response()->::contract::Response::MergeFrom(from.response());
+  }
+}
+
+void SetOutputRequest::CopyFrom(const SetOutputRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.SetOutputRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool SetOutputRequest::IsInitialized() const {
+  return true;
+}
+
+void SetOutputRequest::Swap(SetOutputRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void SetOutputRequest::InternalSwap(SetOutputRequest* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  swap(header_, other->header_);
+  swap(response_, other->response_);
+}
+
+::std::string SetOutputRequest::GetTypeName() const {
+  return "contract.SetOutputRequest";
+}
+
+
+// ===================================================================
+
+void SetOutputResponse::InitAsDefaultInstance() {
+}
+class SetOutputResponse::HasBitSetters {
+ public:
+};
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+SetOutputResponse::SetOutputResponse()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.SetOutputResponse)
+}
+SetOutputResponse::SetOutputResponse(const SetOutputResponse& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:contract.SetOutputResponse)
+}
+
+void SetOutputResponse::SharedCtor() {
+}
+
+SetOutputResponse::~SetOutputResponse() {
+  // @@protoc_insertion_point(destructor:contract.SetOutputResponse)
+  SharedDtor();
+}
+
+void SetOutputResponse::SharedDtor() {
+}
+
+void SetOutputResponse::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const SetOutputResponse& SetOutputResponse::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_SetOutputResponse_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void SetOutputResponse::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.SetOutputResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* SetOutputResponse::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<SetOutputResponse*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      default: {
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool SetOutputResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.SetOutputResponse)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+  handle_unusual:
+    if (tag == 0) {
+      goto success;
+    }
+    DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+        input, tag, &unknown_fields_stream));
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.SetOutputResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.SetOutputResponse)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void SetOutputResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.SetOutputResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.SetOutputResponse)
+}
+
+size_t SetOutputResponse::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.SetOutputResponse)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void SetOutputResponse::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const SetOutputResponse*>(&from));
+}
+
+void SetOutputResponse::MergeFrom(const SetOutputResponse& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.SetOutputResponse)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+}
+
+void SetOutputResponse::CopyFrom(const SetOutputResponse& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.SetOutputResponse)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool SetOutputResponse::IsInitialized() const {
+  return true;
+}
+
+void SetOutputResponse::Swap(SetOutputResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void SetOutputResponse::InternalSwap(SetOutputResponse* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+}
+
+::std::string SetOutputResponse::GetTypeName() const {
+  return "contract.SetOutputResponse";
+}
+
+
+// ===================================================================
+
+void GetCallArgsRequest::InitAsDefaultInstance() {
+  ::contract::_GetCallArgsRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
+      ::contract::SyscallHeader::internal_default_instance());
+}
+class GetCallArgsRequest::HasBitSetters {
+ public:
+  static const ::contract::SyscallHeader& header(const GetCallArgsRequest* msg);
+};
+
+const ::contract::SyscallHeader&
+GetCallArgsRequest::HasBitSetters::header(const GetCallArgsRequest* msg) {
+  return *msg->header_;
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int GetCallArgsRequest::kHeaderFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+GetCallArgsRequest::GetCallArgsRequest()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.GetCallArgsRequest)
+}
+GetCallArgsRequest::GetCallArgsRequest(const GetCallArgsRequest& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  if (from.has_header()) {
+    header_ = new ::contract::SyscallHeader(*from.header_);
+  } else {
+    header_ = nullptr;
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.GetCallArgsRequest)
+}
+
+void GetCallArgsRequest::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_GetCallArgsRequest_contract_2eproto.base);
+  header_ = nullptr;
+}
+
+GetCallArgsRequest::~GetCallArgsRequest() {
+  // @@protoc_insertion_point(destructor:contract.GetCallArgsRequest)
+  SharedDtor();
+}
+
+void GetCallArgsRequest::SharedDtor() {
+  if (this != internal_default_instance()) delete header_;
+}
+
+void GetCallArgsRequest::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const GetCallArgsRequest& GetCallArgsRequest::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_GetCallArgsRequest_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void GetCallArgsRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.GetCallArgsRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* GetCallArgsRequest::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<GetCallArgsRequest*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        parser_till_end = ::contract::SyscallHeader::_InternalParse;
+        object = msg->mutable_header();
+        if (size > end - ptr) goto len_delim_till_end;
+        ptr += size;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool GetCallArgsRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.GetCallArgsRequest)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.GetCallArgsRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.GetCallArgsRequest)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void GetCallArgsRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.GetCallArgsRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      1, HasBitSetters::header(this), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.GetCallArgsRequest)
+}
+
+size_t GetCallArgsRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.GetCallArgsRequest)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *header_);
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void GetCallArgsRequest::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const GetCallArgsRequest*>(&from));
+}
+
+void GetCallArgsRequest::MergeFrom(const GetCallArgsRequest& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.GetCallArgsRequest)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.has_header()) {
+    mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
+  }
+}
+
+void GetCallArgsRequest::CopyFrom(const GetCallArgsRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.GetCallArgsRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetCallArgsRequest::IsInitialized() const {
+  return true;
+}
+
+void GetCallArgsRequest::Swap(GetCallArgsRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void GetCallArgsRequest::InternalSwap(GetCallArgsRequest* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  swap(header_, other->header_);
+}
+
+::std::string GetCallArgsRequest::GetTypeName() const {
+  return "contract.GetCallArgsRequest";
+}
+
+
+// ===================================================================
+
+void Environment::InitAsDefaultInstance() {
+}
+class Environment::HasBitSetters {
+ public:
+};
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int Environment::kHeightFieldNumber;
+const int Environment::kTimestampFieldNumber;
+const int Environment::kTxidFieldNumber;
+const int Environment::kInitiatorFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+Environment::Environment()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.Environment)
+}
+Environment::Environment(const Environment& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  txid_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.txid().size() > 0) {
+    txid_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.txid_);
+  }
+  initiator_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.initiator().size() > 0) {
+    initiator_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.initiator_);
+  }
+  ::memcpy(&height_, &from.height_,
+    static_cast<size_t>(reinterpret_cast<char*>(&timestamp_) -
+    reinterpret_cast<char*>(&height_)) + sizeof(timestamp_));
+  // @@protoc_insertion_point(copy_constructor:contract.Environment)
+}
+
+void Environment::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_Environment_contract_2eproto.base);
+  txid_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  initiator_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(&height_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&timestamp_) -
+      reinterpret_cast<char*>(&height_)) + sizeof(timestamp_));
+}
+
+Environment::~Environment() {
+  // @@protoc_insertion_point(destructor:contract.Environment)
+  SharedDtor();
+}
+
+void Environment::SharedDtor() {
+  txid_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  initiator_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+void Environment::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const Environment& Environment::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_Environment_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void Environment::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.Environment)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  txid_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  initiator_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(&height_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&timestamp_) -
+      reinterpret_cast<char*>(&height_)) + sizeof(timestamp_));
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* Environment::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<Environment*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // int64 height = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 8) goto handle_unusual;
+        msg->set_height(::google::protobuf::internal::ReadVarint(&ptr));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        break;
+      }
+      // int64 timestamp = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 16) goto handle_unusual;
+        msg->set_timestamp(::google::protobuf::internal::ReadVarint(&ptr));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        break;
+      }
+      // string txid = 3;
+      case 3: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_txid();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // string initiator = 4;
+      case 4: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 34) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_initiator();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool Environment::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.Environment)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // int64 height = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (8 & 0xFF)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &height_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int64 timestamp = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (16 & 0xFF)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &timestamp_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string txid = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_txid()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->txid().data(), static_cast<int>(this->txid().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.Environment.txid"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string initiator = 4;
+      case 4: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (34 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_initiator()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->initiator().data(), static_cast<int>(this->initiator().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.Environment.initiator"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.Environment)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.Environment)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void Environment::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.Environment)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int64 height = 1;
+  if (this->height() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->height(), output);
+  }
+
+  // int64 timestamp = 2;
+  if (this->timestamp() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->timestamp(), output);
+  }
+
+  // string txid = 3;
+  if (this->txid().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->txid().data(), static_cast<int>(this->txid().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.Environment.txid");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      3, this->txid(), output);
+  }
+
+  // string initiator = 4;
+  if (this->initiator().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->initiator().data(), static_cast<int>(this->initiator().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.Environment.initiator");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      4, this->initiator(), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.Environment)
+}
+
+size_t Environment::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.Environment)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string txid = 3;
+  if (this->txid().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->txid());
+  }
+
+  // string initiator = 4;
+  if (this->initiator().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->initiator());
+  }
+
+  // int64 height = 1;
+  if (this->height() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->height());
+  }
+
+  // int64 timestamp = 2;
+  if (this->timestamp() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->timestamp());
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void Environment::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const Environment*>(&from));
+}
+
+void Environment::MergeFrom(const Environment& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.Environment)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.txid().size() > 0) {
+
+    txid_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.txid_);
+  }
+  if (from.initiator().size() > 0) {
+
+    initiator_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.initiator_);
+  }
+  if (from.height() != 0) {
+    set_height(from.height());
+  }
+  if (from.timestamp() != 0) {
+    set_timestamp(from.timestamp());
+  }
+}
+
+void Environment::CopyFrom(const Environment& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.Environment)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Environment::IsInitialized() const {
+  return true;
+}
+
+void Environment::Swap(Environment* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void Environment::InternalSwap(Environment* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  txid_.Swap(&other->txid_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  initiator_.Swap(&other->initiator_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(height_, other->height_);
+  swap(timestamp_, other->timestamp_);
+}
+
+::std::string Environment::GetTypeName() const {
+  return "contract.Environment";
+}
+
+
+// ===================================================================
+
+void GetEnvironmentRequest::InitAsDefaultInstance() {
+  ::contract::_GetEnvironmentRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
+      ::contract::SyscallHeader::internal_default_instance());
+}
+class GetEnvironmentRequest::HasBitSetters {
+ public:
+  static const ::contract::SyscallHeader& header(const GetEnvironmentRequest* msg);
+};
+
+const ::contract::SyscallHeader&
+GetEnvironmentRequest::HasBitSetters::header(const GetEnvironmentRequest* msg) {
+  return *msg->header_;
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int GetEnvironmentRequest::kHeaderFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+GetEnvironmentRequest::GetEnvironmentRequest()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.GetEnvironmentRequest)
+}
+GetEnvironmentRequest::GetEnvironmentRequest(const GetEnvironmentRequest& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  if (from.has_header()) {
+    header_ = new ::contract::SyscallHeader(*from.header_);
+  } else {
+    header_ = nullptr;
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.GetEnvironmentRequest)
+}
+
+void GetEnvironmentRequest::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_GetEnvironmentRequest_contract_2eproto.base);
+  header_ = nullptr;
+}
+
+GetEnvironmentRequest::~GetEnvironmentRequest() {
+  // @@protoc_insertion_point(destructor:contract.GetEnvironmentRequest)
+  SharedDtor();
+}
+
+void GetEnvironmentRequest::SharedDtor() {
+  if (this != internal_default_instance()) delete header_;
+}
+
+void GetEnvironmentRequest::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const GetEnvironmentRequest& GetEnvironmentRequest::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_GetEnvironmentRequest_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void GetEnvironmentRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.GetEnvironmentRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* GetEnvironmentRequest::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<GetEnvironmentRequest*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        parser_till_end = ::contract::SyscallHeader::_InternalParse;
+        object = msg->mutable_header();
+        if (size > end - ptr) goto len_delim_till_end;
+        ptr += size;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool GetEnvironmentRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.GetEnvironmentRequest)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.GetEnvironmentRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.GetEnvironmentRequest)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void GetEnvironmentRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.GetEnvironmentRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      1, HasBitSetters::header(this), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.GetEnvironmentRequest)
+}
+
+size_t GetEnvironmentRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.GetEnvironmentRequest)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *header_);
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void GetEnvironmentRequest::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const GetEnvironmentRequest*>(&from));
+}
+
+void GetEnvironmentRequest::MergeFrom(const GetEnvironmentRequest& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.GetEnvironmentRequest)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.has_header()) {
+    mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
+  }
+}
+
+void GetEnvironmentRequest::CopyFrom(const GetEnvironmentRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.GetEnvironmentRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetEnvironmentRequest::IsInitialized() const {
+  return true;
+}
+
+void GetEnvironmentRequest::Swap(GetEnvironmentRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void GetEnvironmentRequest::InternalSwap(GetEnvironmentRequest* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  swap(header_, other->header_);
+}
+
+::std::string GetEnvironmentRequest::GetTypeName() const {
+  return "contract.GetEnvironmentRequest";
+}
+
+
+// ===================================================================
+
+void HashRequest::InitAsDefaultInstance() {
+  ::contract::_HashRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
+      ::contract::SyscallHeader::internal_default_instance());
+}
+class HashRequest::HasBitSetters {
+ public:
+  static const ::contract::SyscallHeader& header(const HashRequest* msg);
+};
+
+const ::contract::SyscallHeader&
+HashRequest::HasBitSetters::header(const HashRequest* msg) {
+  return *msg->header_;
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int HashRequest::kHeaderFieldNumber;
+const int HashRequest::kAlgorithmFieldNumber;
+const int HashRequest::kDataFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+HashRequest::HashRequest()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.HashRequest)
+}
+HashRequest::HashRequest(const HashRequest& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  algorithm_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.algorithm().size() > 0) {
+    algorithm_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.algorithm_);
+  }
+  data_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.data().size() > 0) {
+    data_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.data_);
+  }
+  if (from.has_header()) {
+    header_ = new ::contract::SyscallHeader(*from.header_);
+  } else {
+    header_ = nullptr;
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.HashRequest)
+}
+
+void HashRequest::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_HashRequest_contract_2eproto.base);
+  algorithm_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  data_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  header_ = nullptr;
+}
+
+HashRequest::~HashRequest() {
+  // @@protoc_insertion_point(destructor:contract.HashRequest)
+  SharedDtor();
+}
+
+void HashRequest::SharedDtor() {
+  algorithm_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  data_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != internal_default_instance()) delete header_;
+}
+
+void HashRequest::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const HashRequest& HashRequest::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_HashRequest_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void HashRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.HashRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  algorithm_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  data_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* HashRequest::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<HashRequest*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        parser_till_end = ::contract::SyscallHeader::_InternalParse;
+        object = msg->mutable_header();
+        if (size > end - ptr) goto len_delim_till_end;
+        ptr += size;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      // string algorithm = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_algorithm();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // bytes data = 3;
+      case 3: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        object = msg->mutable_data();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool HashRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.HashRequest)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .contract.SyscallHeader header = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string algorithm = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_algorithm()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->algorithm().data(), static_cast<int>(this->algorithm().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.HashRequest.algorithm"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bytes data = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_data()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.HashRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.HashRequest)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void HashRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.HashRequest)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      1, HasBitSetters::header(this), output);
+  }
+
+  // string algorithm = 2;
+  if (this->algorithm().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->algorithm().data(), static_cast<int>(this->algorithm().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.HashRequest.algorithm");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->algorithm(), output);
+  }
+
+  // bytes data = 3;
+  if (this->data().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->data(), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.HashRequest)
+}
+
+size_t HashRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.HashRequest)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string algorithm = 2;
+  if (this->algorithm().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->algorithm());
+  }
+
+  // bytes data = 3;
+  if (this->data().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->data());
+  }
+
+  // .contract.SyscallHeader header = 1;
+  if (this->has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *header_);
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void HashRequest::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const HashRequest*>(&from));
+}
+
+void HashRequest::MergeFrom(const HashRequest& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.HashRequest)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.algorithm().size() > 0) {
+
+    algorithm_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.algorithm_);
+  }
+  if (from.data().size() > 0) {
+
+    data_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.data_);
+  }
+  if (from.has_header()) {
+    mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
+  }
+}
+
+void HashRequest::CopyFrom(const HashRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.HashRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool HashRequest::IsInitialized() const {
+  return true;
+}
+
+void HashRequest::Swap(HashRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void HashRequest::InternalSwap(HashRequest* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  algorithm_.Swap(&other->algorithm_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  data_.Swap(&other->data_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(header_, other->header_);
+}
+
+::std::string HashRequest::GetTypeName() const {
+  return "contract.HashRequest";
+}
+
+
+// ===================================================================
+
+void HashResponse::InitAsDefaultInstance() {
+}
+class HashResponse::HasBitSetters {
+ public:
+};
+
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int HashResponse::kDigestFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+HashResponse::HashResponse()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.HashResponse)
+}
+HashResponse::HashResponse(const HashResponse& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  digest_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.digest().size() > 0) {
+    digest_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.digest_);
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.HashResponse)
+}
+
+void HashResponse::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_HashResponse_contract_2eproto.base);
+  digest_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+HashResponse::~HashResponse() {
+  // @@protoc_insertion_point(destructor:contract.HashResponse)
+  SharedDtor();
+}
+
+void HashResponse::SharedDtor() {
+  digest_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+void HashResponse::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const HashResponse& HashResponse::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_HashResponse_contract_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void HashResponse::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.HashResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  digest_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* HashResponse::_InternalParse(const char* begin, const char* end, void* object,
+                  ::google::protobuf::internal::ParseContext* ctx) {
+  auto msg = static_cast<HashResponse*>(object);
+  ::google::protobuf::int32 size; (void)size;
+  int depth; (void)depth;
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::ParseFunc parser_till_end; (void)parser_till_end;
+  auto ptr = begin;
+  while (ptr < end) {
+    ptr = ::google::protobuf::io::Parse32(ptr, &tag);
+    GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+    switch (tag >> 3) {
+      // bytes digest = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        object = msg->mutable_digest();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->EndGroup(tag);
+          return ptr;
+        }
+        auto res = UnknownFieldParse(tag, {_InternalParse, msg},
+          ptr, end, msg->_internal_metadata_.mutable_unknown_fields(), ctx);
+        ptr = res.first;
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr != nullptr);
+        if (res.second) return ptr;
+      }
+    }  // switch
+  }  // while
+  return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool HashResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  ::google::protobuf::internal::LiteUnknownFieldSetter unknown_fields_setter(
+      &_internal_metadata_);
+  ::google::protobuf::io::StringOutputStream unknown_fields_output(
+      unknown_fields_setter.buffer());
+  ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
+      &unknown_fields_output, false);
+  // @@protoc_insertion_point(parse_start:contract.HashResponse)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // bytes digest = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_digest()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:contract.HashResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:contract.HashResponse)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void HashResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:contract.HashResponse)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // bytes digest = 1;
+  if (this->digest().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->digest(), output);
+  }
+
+  output->WriteRaw(_internal_metadata_.unknown_fields().data(),
+                   static_cast<int>(_internal_metadata_.unknown_fields().size()));
+  // @@protoc_insertion_point(serialize_end:contract.HashResponse)
+}
+
+size_t HashResponse::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.HashResponse)
+  size_t total_size = 0;
+
+  total_size += _internal_metadata_.unknown_fields().size();
+
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // bytes digest = 1;
+  if (this->digest().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->digest());
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void HashResponse::CheckTypeAndMergeFrom(
+    const ::google::protobuf::MessageLite& from) {
+  MergeFrom(*::google::protobuf::down_cast<const HashResponse*>(&from));
+}
+
+void HashResponse::MergeFrom(const HashResponse& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.HashResponse)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.digest().size() > 0) {
+
+    digest_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.digest_);
+  }
+}
+
+void HashResponse::CopyFrom(const HashResponse& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.HashResponse)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool HashResponse::IsInitialized() const {
+  return true;
+}
+
+void HashResponse::Swap(HashResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void HashResponse::InternalSwap(HashResponse* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  digest_.Swap(&other->digest_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+}
+
+::std::string HashResponse::GetTypeName() const {
+  return "contract.HashResponse";
+}
+
+
+// ===================================================================
+
+void VerifySignatureRequest::InitAsDefaultInstance() {
+  ::contract::_VerifySignatureRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
+      ::contract::SyscallHeader::internal_default_instance());
+}
+class VerifySignatureRequest::HasBitSetters {
+ public:
+  static const ::contract::SyscallHeader& header(const VerifySignatureRequest* msg);
+};
+
+const ::contract::SyscallHeader&
+VerifySignatureRequest::HasBitSetters::header(const VerifySignatureRequest* msg) {
+  return *msg->header_;
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int VerifySignatureRequest::kHeaderFieldNumber;
+const int VerifySignatureRequest::kAlgorithmFieldNumber;
+const int VerifySignatureRequest::kPubkeyFieldNumber;
+const int VerifySignatureRequest::kMsgFieldNumber;
+const int VerifySignatureRequest::kSignFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+VerifySignatureRequest::VerifySignatureRequest()
+  : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:contract.VerifySignatureRequest)
+}
+VerifySignatureRequest::VerifySignatureRequest(const VerifySignatureRequest& from)
+  : ::google::protobuf::MessageLite(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  algorithm_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.algorithm().size() > 0) {
+    algorithm_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.algorithm_);
+  }
+  pubkey_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.pubkey().size() > 0) {
+    pubkey_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.pubkey_);
+  }
+  msg_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.msg().size() > 0) {
+    msg_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.msg_);
+  }
+  sign_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.sign().size() > 0) {
+    sign_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.sign_);
   }
   if (from.has_header()) {
     header_ = new ::contract::SyscallHeader(*from.header_);
   } else {
     header_ = nullptr;
   }
-  // @@protoc_insertion_point(copy_constructor:contract.ContractCallRequest)
+  // @@protoc_insertion_point(copy_constructor:contract.VerifySignatureRequest)
 }
 
-void ContractCallRequest::SharedCtor() {
+void VerifySignatureRequest::SharedCtor() {
   ::google::protobuf::internal::InitSCC(
-      &scc_info_ContractCallRequest_contract_2eproto.base);
-  module_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  contract_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  method_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+      &scc_info_VerifySignatureRequest_contract_2eproto.base);
+  algorithm_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  pubkey_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  msg_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  sign_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   header_ = nullptr;
 }
 
-ContractCallRequest::~ContractCallRequest() {
-  // @@protoc_insertion_point(destructor:contract.ContractCallRequest)
+VerifySignatureRequest::~VerifySignatureRequest() {
+  // @@protoc_insertion_point(destructor:contract.VerifySignatureRequest)
   SharedDtor();
 }
 
-void ContractCallRequest::SharedDtor() {
-  module_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  contract_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  method_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+void VerifySignatureRequest::SharedDtor() {
+  algorithm_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  pubkey_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  msg_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  sign_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (this != internal_default_instance()) delete header_;
 }
 
-void ContractCallRequest::SetCachedSize(int size) const {
+void VerifySignatureRequest::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const ContractCallRequest& ContractCallRequest::default_instance() {
-  ::google::protobuf::internal::InitSCC(&::scc_info_ContractCallRequest_contract_2eproto.base);
+const VerifySignatureRequest& VerifySignatureRequest::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_VerifySignatureRequest_contract_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void ContractCallRequest::Clear() {
-// @@protoc_insertion_point(message_clear_start:contract.ContractCallRequest)
+void VerifySignatureRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.VerifySignatureRequest)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  args_.Clear();
-  module_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  contract_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  method_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  algorithm_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  pubkey_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  msg_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  sign_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
     delete header_;
   }
@@ -3529,9 +8777,9 @@ void ContractCallRequest::Clear() {
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* ContractCallRequest::_InternalParse(const char* begin, const char* end, void* object,
+const char* VerifySignatureRequest::_InternalParse(const char* begin, const char* end, void* object,
                   ::google::protobuf::internal::ParseContext* ctx) {
-  auto msg = static_cast<ContractCallRequest*>(object);
+  auto msg = static_cast<VerifySignatureRequest*>(object);
   ::google::protobuf::int32 size; (void)size;
   int depth; (void)depth;
   ::google::protobuf::uint32 tag;
@@ -3554,13 +8802,13 @@ const char* ContractCallRequest::_InternalParse(const char* begin, const char* e
             {parser_till_end, object}, ptr - size, ptr));
         break;
       }
-      // string module = 2;
+      // string algorithm = 2;
       case 2: {
         if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
         ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
         ctx->extra_parse_data().SetFieldName(nullptr);
-        object = msg->mutable_module();
+        object = msg->mutable_algorithm();
         if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
           parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
           goto string_till_end;
@@ -3570,54 +8818,51 @@ const char* ContractCallRequest::_InternalParse(const char* begin, const char* e
         ptr += size;
         break;
       }
-      // string contract = 3;
+      // bytes pubkey = 3;
       case 3: {
         if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
         ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        ctx->extra_parse_data().SetFieldName(nullptr);
-        object = msg->mutable_contract();
+        object = msg->mutable_pubkey();
         if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
-          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
           goto string_till_end;
         }
-        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
         ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
         ptr += size;
         break;
       }
-      // string method = 4;
+      // bytes msg = 4;
       case 4: {
         if (static_cast<::google::protobuf::uint8>(tag) != 34) goto handle_unusual;
         ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        ctx->extra_parse_data().SetFieldName(nullptr);
-        object = msg->mutable_method();
+        object = msg->mutable_msg();
         if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
-          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
           goto string_till_end;
         }
-        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // bytes sign = 5;
+      case 5: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 42) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        object = msg->mutable_sign();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
         ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
         ptr += size;
         break;
       }
-      // repeated .contract.ArgPair args = 5;
-      case 5: {
-        if (static_cast<::google::protobuf::uint8>(tag) != 42) goto handle_unusual;
-        do {
-          ptr = ::google::protobuf::io::ReadSize(ptr, &size);
-          GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-          parser_till_end = ::contract::ArgPair::_InternalParse;
-          object = msg->add_args();
-          if (size > end - ptr) goto len_delim_till_end;
-          ptr += size;
-          GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
-              {parser_till_end, object}, ptr - size, ptr));
-          if (ptr >= end) break;
-        } while ((::google::protobuf::io::UnalignedLoad<::google::protobuf::uint64>(ptr) & 255) == 42 && (ptr += 1));
-        break;
-      }
       default: {
       handle_unusual:
         if ((tag & 7) == 4 || tag == 0) {
@@ -3642,7 +8887,7 @@ len_delim_till_end:
                                {parser_till_end, object}, size);
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool ContractCallRequest::MergePartialFromCodedStream(
+bool VerifySignatureRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
@@ -3652,7 +8897,7 @@ bool ContractCallRequest::MergePartialFromCodedStream(
       unknown_fields_setter.buffer());
   ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
       &unknown_fields_output, false);
-  // @@protoc_insertion_point(parse_start:contract.ContractCallRequest)
+  // @@protoc_insertion_point(parse_start:contract.VerifySignatureRequest)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
@@ -3669,56 +8914,48 @@ bool ContractCallRequest::MergePartialFromCodedStream(
         break;
       }
 
-      // string module = 2;
+      // string algorithm = 2;
       case 2: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_module()));
+                input, this->mutable_algorithm()));
           DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->module().data(), static_cast<int>(this->module().length()),
+            this->algorithm().data(), static_cast<int>(this->algorithm().length()),
             ::google::protobuf::internal::WireFormatLite::PARSE,
-            "contract.ContractCallRequest.module"));
+            "contract.VerifySignatureRequest.algorithm"));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // string contract = 3;
+      // bytes pubkey = 3;
       case 3: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_contract()));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->contract().data(), static_cast<int>(this->contract().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "contract.ContractCallRequest.contract"));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_pubkey()));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // string method = 4;
+      // bytes msg = 4;
       case 4: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (34 & 0xFF)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_method()));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->method().data(), static_cast<int>(this->method().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "contract.ContractCallRequest.method"));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_msg()));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // repeated .contract.ArgPair args = 5;
+      // bytes sign = 5;
       case 5: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (42 & 0xFF)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
-                input, add_args()));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_sign()));
         } else {
           goto handle_unusual;
         }
@@ -3737,18 +8974,18 @@ bool ContractCallRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:contract.ContractCallRequest)
+  // @@protoc_insertion_point(parse_success:contract.VerifySignatureRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:contract.ContractCallRequest)
+  // @@protoc_insertion_point(parse_failure:contract.VerifySignatureRequest)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void ContractCallRequest::SerializeWithCachedSizes(
+void VerifySignatureRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:contract.ContractCallRequest)
+  // @@protoc_insertion_point(serialize_start:contract.VerifySignatureRequest)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -3758,52 +8995,41 @@ void ContractCallRequest::SerializeWithCachedSizes(
       1, HasBitSetters::header(this), output);
   }
 
-  // string module = 2;
-  if (this->module().size() > 0) {
+  // string algorithm = 2;
+  if (this->algorithm().size() > 0) {
     ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->module().data(), static_cast<int>(this->module().length()),
+      this->algorithm().data(), static_cast<int>(this->algorithm().length()),
       ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "contract.ContractCallRequest.module");
+      "contract.VerifySignatureRequest.algorithm");
     ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      2, this->module(), output);
+      2, this->algorithm(), output);
   }
 
-  // string contract = 3;
-  if (this->contract().size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->contract().data(), static_cast<int>(this->contract().length()),
-      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "contract.ContractCallRequest.contract");
-    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      3, this->contract(), output);
+  // bytes pubkey = 3;
+  if (this->pubkey().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->pubkey(), output);
   }
 
-  // string method = 4;
-  if (this->method().size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->method().data(), static_cast<int>(this->method().length()),
-      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "contract.ContractCallRequest.method");
-    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      4, this->method(), output);
+  // bytes msg = 4;
+  if (this->msg().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->msg(), output);
   }
 
-  // repeated .contract.ArgPair args = 5;
-  for (unsigned int i = 0,
-      n = static_cast<unsigned int>(this->args_size()); i < n; i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessage(
-      5,
-      this->args(static_cast<int>(i)),
-      output);
+  // bytes sign = 5;
+  if (this->sign().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      5, this->sign(), output);
   }
 
   output->WriteRaw(_internal_metadata_.unknown_fields().data(),
                    static_cast<int>(_internal_metadata_.unknown_fields().size()));
-  // @@protoc_insertion_point(serialize_end:contract.ContractCallRequest)
+  // @@protoc_insertion_point(serialize_end:contract.VerifySignatureRequest)
 }
 
-size_t ContractCallRequest::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:contract.ContractCallRequest)
+size_t VerifySignatureRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.VerifySignatureRequest)
   size_t total_size = 0;
 
   total_size += _internal_metadata_.unknown_fields().size();
@@ -3812,36 +9038,32 @@ size_t ContractCallRequest::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // repeated .contract.ArgPair args = 5;
-  {
-    unsigned int count = static_cast<unsigned int>(this->args_size());
-    total_size += 1UL * count;
-    for (unsigned int i = 0; i < count; i++) {
-      total_size +=
-        ::google::protobuf::internal::WireFormatLite::MessageSize(
-          this->args(static_cast<int>(i)));
-    }
+  // string algorithm = 2;
+  if (this->algorithm().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->algorithm());
   }
 
-  // string module = 2;
-  if (this->module().size() > 0) {
+  // bytes pubkey = 3;
+  if (this->pubkey().size() > 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->module());
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->pubkey());
   }
 
-  // string contract = 3;
-  if (this->contract().size() > 0) {
+  // bytes msg = 4;
+  if (this->msg().size() > 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->contract());
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->msg());
   }
 
-  // string method = 4;
-  if (this->method().size() > 0) {
+  // bytes sign = 5;
+  if (this->sign().size() > 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->method());
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->sign());
   }
 
   // .contract.SyscallHeader header = 1;
@@ -3856,146 +9078,133 @@ size_t ContractCallRequest::ByteSizeLong() const {
   return total_size;
 }
 
-void ContractCallRequest::CheckTypeAndMergeFrom(
+void VerifySignatureRequest::CheckTypeAndMergeFrom(
     const ::google::protobuf::MessageLite& from) {
-  MergeFrom(*::google::protobuf::down_cast<const ContractCallRequest*>(&from));
+  MergeFrom(*::google::protobuf::down_cast<const VerifySignatureRequest*>(&from));
 }
 
-void ContractCallRequest::MergeFrom(const ContractCallRequest& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:contract.ContractCallRequest)
+void VerifySignatureRequest::MergeFrom(const VerifySignatureRequest& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.VerifySignatureRequest)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  args_.MergeFrom(from.args_);
-  if (from.module().size() > 0) {
+  if (from.algorithm().size() > 0) {
 
-    module_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.module_);
+    algorithm_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.algorithm_);
   }
-  if (from.contract().size() > 0) {
+  if (from.pubkey().size() > 0) {
 
-    contract_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.contract_);
+    pubkey_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.pubkey_);
   }
-  if (from.method().size() > 0) {
+  if (from.msg().size() > 0) {
 
-    method_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.method_);
+    msg_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.msg_);
+  }
+  if (from.sign().size() > 0) {
+
+    sign_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.sign_);
   }
   if (from.has_header()) {
     mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
   }
 }
 
-void ContractCallRequest::CopyFrom(const ContractCallRequest& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:contract.ContractCallRequest)
+void VerifySignatureRequest::CopyFrom(const VerifySignatureRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.VerifySignatureRequest)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ContractCallRequest::IsInitialized() const {
+bool VerifySignatureRequest::IsInitialized() const {
   return true;
 }
 
-void ContractCallRequest::Swap(ContractCallRequest* other) {
+void VerifySignatureRequest::Swap(VerifySignatureRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ContractCallRequest::InternalSwap(ContractCallRequest* other) {
+void VerifySignatureRequest::InternalSwap(VerifySignatureRequest* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  CastToBase(&args_)->InternalSwap(CastToBase(&other->args_));
-  module_.Swap(&other->module_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  algorithm_.Swap(&other->algorithm_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
-  contract_.Swap(&other->contract_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  pubkey_.Swap(&other->pubkey_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
-  method_.Swap(&other->method_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  msg_.Swap(&other->msg_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  sign_.Swap(&other->sign_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
   swap(header_, other->header_);
 }
 
-::std::string ContractCallRequest::GetTypeName() const {
-  return "contract.ContractCallRequest";
+::std::string VerifySignatureRequest::GetTypeName() const {
+  return "contract.VerifySignatureRequest";
 }
 
 
 // ===================================================================
 
-void ContractCallResponse::InitAsDefaultInstance() {
-  ::contract::_ContractCallResponse_default_instance_._instance.get_mutable()->response_ = const_cast< ::contract::Response*>(
-      ::contract::Response::internal_default_instance());
+void VerifySignatureResponse::InitAsDefaultInstance() {
 }
-class ContractCallResponse::HasBitSetters {
+class VerifySignatureResponse::HasBitSetters {
  public:
-  static const ::contract::Response& response(const ContractCallResponse* msg);
 };
 
-const ::contract::Response&
-ContractCallResponse::HasBitSetters::response(const ContractCallResponse* msg) {
-  return *msg->response_;
-}
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int ContractCallResponse::kResponseFieldNumber;
+const int VerifySignatureResponse::kValidFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-ContractCallResponse::ContractCallResponse()
+VerifySignatureResponse::VerifySignatureResponse()
   : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:contract.ContractCallResponse)
+  // @@protoc_insertion_point(constructor:contract.VerifySignatureResponse)
 }
-ContractCallResponse::ContractCallResponse(const ContractCallResponse& from)
+VerifySignatureResponse::VerifySignatureResponse(const VerifySignatureResponse& from)
   : ::google::protobuf::MessageLite(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  if (from.has_response()) {
-    response_ = new ::contract::Response(*from.response_);
-  } else {
-    response_ = nullptr;
-  }
-  // @@protoc_insertion_point(copy_constructor:contract.ContractCallResponse)
+  valid_ = from.valid_;
+  // @@protoc_insertion_point(copy_constructor:contract.VerifySignatureResponse)
 }
 
-void ContractCallResponse::SharedCtor() {
-  ::google::protobuf::internal::InitSCC(
-      &scc_info_ContractCallResponse_contract_2eproto.base);
-  response_ = nullptr;
+void VerifySignatureResponse::SharedCtor() {
+  valid_ = false;
 }
 
-ContractCallResponse::~ContractCallResponse() {
-  // @@protoc_insertion_point(destructor:contract.ContractCallResponse)
+VerifySignatureResponse::~VerifySignatureResponse() {
+  // @@protoc_insertion_point(destructor:contract.VerifySignatureResponse)
   SharedDtor();
 }
 
-void ContractCallResponse::SharedDtor() {
-  if (this != internal_default_instance()) delete response_;
+void VerifySignatureResponse::SharedDtor() {
 }
 
-void ContractCallResponse::SetCachedSize(int size) const {
+void VerifySignatureResponse::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const ContractCallResponse& ContractCallResponse::default_instance() {
-  ::google::protobuf::internal::InitSCC(&::scc_info_ContractCallResponse_contract_2eproto.base);
+const VerifySignatureResponse& VerifySignatureResponse::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_VerifySignatureResponse_contract_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void ContractCallResponse::Clear() {
-// @@protoc_insertion_point(message_clear_start:contract.ContractCallResponse)
+void VerifySignatureResponse::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.VerifySignatureResponse)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  if (GetArenaNoVirtual() == nullptr && response_ != nullptr) {
-    delete response_;
-  }
-  response_ = nullptr;
+  valid_ = false;
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* ContractCallResponse::_InternalParse(const char* begin, const char* end, void* object,
+const char* VerifySignatureResponse::_InternalParse(const char* begin, const char* end, void* object,
                   ::google::protobuf::internal::ParseContext* ctx) {
-  auto msg = static_cast<ContractCallResponse*>(object);
+  auto msg = static_cast<VerifySignatureResponse*>(object);
   ::google::protobuf::int32 size; (void)size;
   int depth; (void)depth;
   ::google::protobuf::uint32 tag;
@@ -4005,17 +9214,11 @@ const char* ContractCallResponse::_InternalParse(const char* begin, const char*
     ptr = ::google::protobuf::io::Parse32(ptr, &tag);
     GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
     switch (tag >> 3) {
-      // .contract.Response response = 1;
+      // bool valid = 1;
       case 1: {
-        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
-        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        if (static_cast<::google::protobuf::uint8>(tag) != 8) goto handle_unusual;
+        msg->set_valid(::google::protobuf::internal::ReadVarint(&ptr));
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        parser_till_end = ::contract::Response::_InternalParse;
-        object = msg->mutable_response();
-        if (size > end - ptr) goto len_delim_till_end;
-        ptr += size;
-        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
-            {parser_till_end, object}, ptr - size, ptr));
         break;
       }
       default: {
@@ -4033,12 +9236,9 @@ const char* ContractCallResponse::_InternalParse(const char* begin, const char*
     }  // switch
   }  // while
   return ptr;
-len_delim_till_end:
-  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
-                               {parser_till_end, object}, size);
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool ContractCallResponse::MergePartialFromCodedStream(
+bool VerifySignatureResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
@@ -4048,17 +9248,19 @@ bool ContractCallResponse::MergePartialFromCodedStream(
       unknown_fields_setter.buffer());
   ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
       &unknown_fields_output, false);
-  // @@protoc_insertion_point(parse_start:contract.ContractCallResponse)
+  // @@protoc_insertion_point(parse_start:contract.VerifySignatureResponse)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // .contract.Response response = 1;
+      // bool valid = 1;
       case 1: {
-        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
-               input, mutable_response()));
+        if (static_cast< ::google::protobuf::uint8>(tag) == (8 & 0xFF)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &valid_)));
         } else {
           goto handle_unusual;
         }
@@ -4077,34 +9279,33 @@ bool ContractCallResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:contract.ContractCallResponse)
+  // @@protoc_insertion_point(parse_success:contract.VerifySignatureResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:contract.ContractCallResponse)
+  // @@protoc_insertion_point(parse_failure:contract.VerifySignatureResponse)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void ContractCallResponse::SerializeWithCachedSizes(
+void VerifySignatureResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:contract.ContractCallResponse)
+  // @@protoc_insertion_point(serialize_start:contract.VerifySignatureResponse)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .contract.Response response = 1;
-  if (this->has_response()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessage(
-      1, HasBitSetters::response(this), output);
+  // bool valid = 1;
+  if (this->valid() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(1, this->valid(), output);
   }
 
   output->WriteRaw(_internal_metadata_.unknown_fields().data(),
                    static_cast<int>(_internal_metadata_.unknown_fields().size()));
-  // @@protoc_insertion_point(serialize_end:contract.ContractCallResponse)
+  // @@protoc_insertion_point(serialize_end:contract.VerifySignatureResponse)
 }
 
-size_t ContractCallResponse::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:contract.ContractCallResponse)
+size_t VerifySignatureResponse::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.VerifySignatureResponse)
   size_t total_size = 0;
 
   total_size += _internal_metadata_.unknown_fields().size();
@@ -4113,11 +9314,9 @@ size_t ContractCallResponse::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // .contract.Response response = 1;
-  if (this->has_response()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSize(
-        *response_);
+  // bool valid = 1;
+  if (this->valid() != 0) {
+    total_size += 1 + 1;
   }
 
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
@@ -4125,127 +9324,160 @@ size_t ContractCallResponse::ByteSizeLong() const {
   return total_size;
 }
 
-void ContractCallResponse::CheckTypeAndMergeFrom(
+void VerifySignatureResponse::CheckTypeAndMergeFrom(
     const ::google::protobuf::MessageLite& from) {
-  MergeFrom(*::google::protobuf::down_cast<const ContractCallResponse*>(&from));
+  MergeFrom(*::google::protobuf::down_cast<const VerifySignatureResponse*>(&from));
 }
 
-void ContractCallResponse::MergeFrom(const ContractCallResponse& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:contract.ContractCallResponse)
+void VerifySignatureResponse::MergeFrom(const VerifySignatureResponse& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.VerifySignatureResponse)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.has_response()) {
-    mutable_response()->::contract::Response::MergeFrom(from.response());
+  if (from.valid() != 0) {
+    set_valid(from.valid());
   }
 }
 
-void ContractCallResponse::CopyFrom(const ContractCallResponse& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:contract.ContractCallResponse)
+void VerifySignatureResponse::CopyFrom(const VerifySignatureResponse& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.VerifySignatureResponse)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ContractCallResponse::IsInitialized() const {
+bool VerifySignatureResponse::IsInitialized() const {
   return true;
 }
 
-void ContractCallResponse::Swap(ContractCallResponse* other) {
+void VerifySignatureResponse::Swap(VerifySignatureResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ContractCallResponse::InternalSwap(ContractCallResponse* other) {
+void VerifySignatureResponse::InternalSwap(VerifySignatureResponse* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  swap(response_, other->response_);
+  swap(valid_, other->valid_);
 }
 
-::std::string ContractCallResponse::GetTypeName() const {
-  return "contract.ContractCallResponse";
+::std::string VerifySignatureResponse::GetTypeName() const {
+  return "contract.VerifySignatureResponse";
 }
 
 
 // ===================================================================
 
-void Response::InitAsDefaultInstance() {
+void ContractDesc::InitAsDefaultInstance() {
 }
-class Response::HasBitSetters {
+class ContractDesc::HasBitSetters {
  public:
 };
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int Response::kStatusFieldNumber;
-const int Response::kMessageFieldNumber;
-const int Response::kBodyFieldNumber;
+const int ContractDesc::kNameFieldNumber;
+const int ContractDesc::kLanguageFieldNumber;
+const int ContractDesc::kCodeHashFieldNumber;
+const int ContractDesc::kCodeSizeFieldNumber;
+const int ContractDesc::kDeployerFieldNumber;
+const int ContractDesc::kDeployTimeFieldNumber;
+const int ContractDesc::kVersionFieldNumber;
+const int ContractDesc::kAbiFieldNumber;
+const int ContractDesc::kNonReentrantFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-Response::Response()
+ContractDesc::ContractDesc()
   : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:contract.Response)
+  // @@protoc_insertion_point(constructor:contract.ContractDesc)
 }
-Response::Response(const Response& from)
+ContractDesc::ContractDesc(const ContractDesc& from)
   : ::google::protobuf::MessageLite(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  message_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.message().size() > 0) {
-    message_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.message_);
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.name().size() > 0) {
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
   }
-  body_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.body().size() > 0) {
-    body_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.body_);
+  language_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.language().size() > 0) {
+    language_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.language_);
   }
-  status_ = from.status_;
-  // @@protoc_insertion_point(copy_constructor:contract.Response)
+  code_hash_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.code_hash().size() > 0) {
+    code_hash_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.code_hash_);
+  }
+  deployer_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.deployer().size() > 0) {
+    deployer_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.deployer_);
+  }
+  abi_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.abi().size() > 0) {
+    abi_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.abi_);
+  }
+  ::memcpy(&code_size_, &from.code_size_,
+    static_cast<size_t>(reinterpret_cast<char*>(&non_reentrant_) -
+    reinterpret_cast<char*>(&code_size_)) + sizeof(non_reentrant_));
+  // @@protoc_insertion_point(copy_constructor:contract.ContractDesc)
 }
 
-void Response::SharedCtor() {
+void ContractDesc::SharedCtor() {
   ::google::protobuf::internal::InitSCC(
-      &scc_info_Response_contract_2eproto.base);
-  message_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  body_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  status_ = 0;
-}
-
-Response::~Response() {
-  // @@protoc_insertion_point(destructor:contract.Response)
+      &scc_info_ContractDesc_contract_2eproto.base);
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  language_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  code_hash_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  deployer_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  abi_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(&code_size_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&non_reentrant_) -
+      reinterpret_cast<char*>(&code_size_)) + sizeof(non_reentrant_));
+}
+
+ContractDesc::~ContractDesc() {
+  // @@protoc_insertion_point(destructor:contract.ContractDesc)
   SharedDtor();
 }
 
-void Response::SharedDtor() {
-  message_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  body_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+void ContractDesc::SharedDtor() {
+  name_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  language_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  code_hash_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  deployer_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  abi_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-void Response::SetCachedSize(int size) const {
+void ContractDesc::SetCachedSize(int size) const {
   _cached_size_.Set(size);
-}
-const Response& Response::default_instance() {
-  ::google::protobuf::internal::InitSCC(&::scc_info_Response_contract_2eproto.base);
+}
+const ContractDesc& ContractDesc::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_ContractDesc_contract_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void Response::Clear() {
-// @@protoc_insertion_point(message_clear_start:contract.Response)
+void ContractDesc::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.ContractDesc)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  message_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  body_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  status_ = 0;
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  language_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  code_hash_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  deployer_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  abi_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(&code_size_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&non_reentrant_) -
+      reinterpret_cast<char*>(&code_size_)) + sizeof(non_reentrant_));
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* Response::_InternalParse(const char* begin, const char* end, void* object,
+const char* ContractDesc::_InternalParse(const char* begin, const char* end, void* object,
                   ::google::protobuf::internal::ParseContext* ctx) {
-  auto msg = static_cast<Response*>(object);
+  auto msg = static_cast<ContractDesc*>(object);
   ::google::protobuf::int32 size; (void)size;
   int depth; (void)depth;
   ::google::protobuf::uint32 tag;
@@ -4255,20 +9487,29 @@ const char* Response::_InternalParse(const char* begin, const char* end, void* o
     ptr = ::google::protobuf::io::Parse32(ptr, &tag);
     GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
     switch (tag >> 3) {
-      // int32 status = 1;
+      // string name = 1;
       case 1: {
-        if (static_cast<::google::protobuf::uint8>(tag) != 8) goto handle_unusual;
-        msg->set_status(::google::protobuf::internal::ReadVarint(&ptr));
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_name();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
         break;
       }
-      // string message = 2;
+      // string language = 2;
       case 2: {
         if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
         ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
         ctx->extra_parse_data().SetFieldName(nullptr);
-        object = msg->mutable_message();
+        object = msg->mutable_language();
         if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
           parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
           goto string_till_end;
@@ -4278,12 +9519,64 @@ const char* Response::_InternalParse(const char* begin, const char* end, void* o
         ptr += size;
         break;
       }
-      // bytes body = 3;
+      // bytes code_hash = 3;
       case 3: {
         if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
         ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        object = msg->mutable_body();
+        object = msg->mutable_code_hash();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParser;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheck(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // int64 code_size = 4;
+      case 4: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 32) goto handle_unusual;
+        msg->set_code_size(::google::protobuf::internal::ReadVarint(&ptr));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        break;
+      }
+      // string deployer = 5;
+      case 5: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 42) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_deployer();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // int64 deploy_time = 6;
+      case 6: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 48) goto handle_unusual;
+        msg->set_deploy_time(::google::protobuf::internal::ReadVarint(&ptr));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        break;
+      }
+      // int64 version = 7;
+      case 7: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 56) goto handle_unusual;
+        msg->set_version(::google::protobuf::internal::ReadVarint(&ptr));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        break;
+      }
+      // bytes abi = 8;
+      case 8: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 66) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        object = msg->mutable_abi();
         if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
           parser_till_end = ::google::protobuf::internal::GreedyStringParser;
           goto string_till_end;
@@ -4293,6 +9586,13 @@ const char* Response::_InternalParse(const char* begin, const char* end, void* o
         ptr += size;
         break;
       }
+      // bool non_reentrant = 9;
+      case 9: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 72) goto handle_unusual;
+        msg->set_non_reentrant(::google::protobuf::internal::ReadVarint(&ptr));
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        break;
+      }
       default: {
       handle_unusual:
         if ((tag & 7) == 4 || tag == 0) {
@@ -4317,7 +9617,7 @@ len_delim_till_end:
                                {parser_till_end, object}, size);
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool Response::MergePartialFromCodedStream(
+bool ContractDesc::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
@@ -4327,45 +9627,125 @@ bool Response::MergePartialFromCodedStream(
       unknown_fields_setter.buffer());
   ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
       &unknown_fields_output, false);
-  // @@protoc_insertion_point(parse_start:contract.Response)
+  // @@protoc_insertion_point(parse_start:contract.ContractDesc)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // int32 status = 1;
+      // string name = 1;
       case 1: {
-        if (static_cast< ::google::protobuf::uint8>(tag) == (8 & 0xFF)) {
-
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &status_)));
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->name().data(), static_cast<int>(this->name().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ContractDesc.name"));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // string message = 2;
+      // string language = 2;
       case 2: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_message()));
+                input, this->mutable_language()));
           DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->message().data(), static_cast<int>(this->message().length()),
+            this->language().data(), static_cast<int>(this->language().length()),
             ::google::protobuf::internal::WireFormatLite::PARSE,
-            "contract.Response.message"));
+            "contract.ContractDesc.language"));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // bytes body = 3;
+      // bytes code_hash = 3;
       case 3: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->mutable_body()));
+                input, this->mutable_code_hash()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int64 code_size = 4;
+      case 4: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (32 & 0xFF)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &code_size_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string deployer = 5;
+      case 5: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (42 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_deployer()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->deployer().data(), static_cast<int>(this->deployer().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ContractDesc.deployer"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int64 deploy_time = 6;
+      case 6: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (48 & 0xFF)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &deploy_time_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int64 version = 7;
+      case 7: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (56 & 0xFF)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &version_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bytes abi = 8;
+      case 8: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (66 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_abi()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bool non_reentrant = 9;
+      case 9: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (72 & 0xFF)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &non_reentrant_)));
         } else {
           goto handle_unusual;
         }
@@ -4384,49 +9764,90 @@ bool Response::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:contract.Response)
+  // @@protoc_insertion_point(parse_success:contract.ContractDesc)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:contract.Response)
+  // @@protoc_insertion_point(parse_failure:contract.ContractDesc)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void Response::SerializeWithCachedSizes(
+void ContractDesc::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:contract.Response)
+  // @@protoc_insertion_point(serialize_start:contract.ContractDesc)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int32 status = 1;
-  if (this->status() != 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->status(), output);
+  // string name = 1;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ContractDesc.name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->name(), output);
   }
 
-  // string message = 2;
-  if (this->message().size() > 0) {
+  // string language = 2;
+  if (this->language().size() > 0) {
     ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->message().data(), static_cast<int>(this->message().length()),
+      this->language().data(), static_cast<int>(this->language().length()),
       ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "contract.Response.message");
+      "contract.ContractDesc.language");
     ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      2, this->message(), output);
+      2, this->language(), output);
   }
 
-  // bytes body = 3;
-  if (this->body().size() > 0) {
+  // bytes code_hash = 3;
+  if (this->code_hash().size() > 0) {
     ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
-      3, this->body(), output);
+      3, this->code_hash(), output);
+  }
+
+  // int64 code_size = 4;
+  if (this->code_size() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->code_size(), output);
+  }
+
+  // string deployer = 5;
+  if (this->deployer().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->deployer().data(), static_cast<int>(this->deployer().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ContractDesc.deployer");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      5, this->deployer(), output);
+  }
+
+  // int64 deploy_time = 6;
+  if (this->deploy_time() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->deploy_time(), output);
+  }
+
+  // int64 version = 7;
+  if (this->version() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(7, this->version(), output);
+  }
+
+  // bytes abi = 8;
+  if (this->abi().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      8, this->abi(), output);
+  }
+
+  // bool non_reentrant = 9;
+  if (this->non_reentrant() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(9, this->non_reentrant(), output);
   }
 
   output->WriteRaw(_internal_metadata_.unknown_fields().data(),
                    static_cast<int>(_internal_metadata_.unknown_fields().size()));
-  // @@protoc_insertion_point(serialize_end:contract.Response)
+  // @@protoc_insertion_point(serialize_end:contract.ContractDesc)
 }
 
-size_t Response::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:contract.Response)
+size_t ContractDesc::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.ContractDesc)
   size_t total_size = 0;
 
   total_size += _internal_metadata_.unknown_fields().size();
@@ -4435,25 +9856,65 @@ size_t Response::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // string message = 2;
-  if (this->message().size() > 0) {
+  // string name = 1;
+  if (this->name().size() > 0) {
     total_size += 1 +
       ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->message());
+        this->name());
   }
 
-  // bytes body = 3;
-  if (this->body().size() > 0) {
+  // string language = 2;
+  if (this->language().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->language());
+  }
+
+  // bytes code_hash = 3;
+  if (this->code_hash().size() > 0) {
     total_size += 1 +
       ::google::protobuf::internal::WireFormatLite::BytesSize(
-        this->body());
+        this->code_hash());
   }
 
-  // int32 status = 1;
-  if (this->status() != 0) {
+  // string deployer = 5;
+  if (this->deployer().size() > 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::Int32Size(
-        this->status());
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->deployer());
+  }
+
+  // bytes abi = 8;
+  if (this->abi().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->abi());
+  }
+
+  // int64 code_size = 4;
+  if (this->code_size() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->code_size());
+  }
+
+  // int64 deploy_time = 6;
+  if (this->deploy_time() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->deploy_time());
+  }
+
+  // int64 version = 7;
+  if (this->version() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->version());
+  }
+
+  // bool non_reentrant = 9;
+  if (this->non_reentrant() != 0) {
+    total_size += 1 + 1;
   }
 
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
@@ -4461,158 +9922,163 @@ size_t Response::ByteSizeLong() const {
   return total_size;
 }
 
-void Response::CheckTypeAndMergeFrom(
+void ContractDesc::CheckTypeAndMergeFrom(
     const ::google::protobuf::MessageLite& from) {
-  MergeFrom(*::google::protobuf::down_cast<const Response*>(&from));
+  MergeFrom(*::google::protobuf::down_cast<const ContractDesc*>(&from));
 }
 
-void Response::MergeFrom(const Response& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:contract.Response)
+void ContractDesc::MergeFrom(const ContractDesc& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.ContractDesc)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.message().size() > 0) {
+  if (from.name().size() > 0) {
 
-    message_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.message_);
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
   }
-  if (from.body().size() > 0) {
+  if (from.language().size() > 0) {
 
-    body_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.body_);
+    language_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.language_);
   }
-  if (from.status() != 0) {
-    set_status(from.status());
+  if (from.code_hash().size() > 0) {
+
+    code_hash_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.code_hash_);
+  }
+  if (from.deployer().size() > 0) {
+
+    deployer_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.deployer_);
+  }
+  if (from.abi().size() > 0) {
+
+    abi_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.abi_);
+  }
+  if (from.code_size() != 0) {
+    set_code_size(from.code_size());
+  }
+  if (from.deploy_time() != 0) {
+    set_deploy_time(from.deploy_time());
+  }
+  if (from.version() != 0) {
+    set_version(from.version());
+  }
+  if (from.non_reentrant() != 0) {
+    set_non_reentrant(from.non_reentrant());
   }
 }
 
-void Response::CopyFrom(const Response& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:contract.Response)
+void ContractDesc::CopyFrom(const ContractDesc& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.ContractDesc)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool Response::IsInitialized() const {
+bool ContractDesc::IsInitialized() const {
   return true;
 }
 
-void Response::Swap(Response* other) {
+void ContractDesc::Swap(ContractDesc* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void Response::InternalSwap(Response* other) {
+void ContractDesc::InternalSwap(ContractDesc* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  message_.Swap(&other->message_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  name_.Swap(&other->name_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
-  body_.Swap(&other->body_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  language_.Swap(&other->language_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
-  swap(status_, other->status_);
+  code_hash_.Swap(&other->code_hash_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  deployer_.Swap(&other->deployer_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  abi_.Swap(&other->abi_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(code_size_, other->code_size_);
+  swap(deploy_time_, other->deploy_time_);
+  swap(version_, other->version_);
+  swap(non_reentrant_, other->non_reentrant_);
 }
 
-::std::string Response::GetTypeName() const {
-  return "contract.Response";
+::std::string ContractDesc::GetTypeName() const {
+  return "contract.ContractDesc";
 }
 
 
 // ===================================================================
 
-void SetOutputRequest::InitAsDefaultInstance() {
-  ::contract::_SetOutputRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
-      ::contract::SyscallHeader::internal_default_instance());
-  ::contract::_SetOutputRequest_default_instance_._instance.get_mutable()->response_ = const_cast< ::contract::Response*>(
-      ::contract::Response::internal_default_instance());
-}
-class SetOutputRequest::HasBitSetters {
- public:
-  static const ::contract::SyscallHeader& header(const SetOutputRequest* msg);
-  static const ::contract::Response& response(const SetOutputRequest* msg);
-};
-
-const ::contract::SyscallHeader&
-SetOutputRequest::HasBitSetters::header(const SetOutputRequest* msg) {
-  return *msg->header_;
-}
-const ::contract::Response&
-SetOutputRequest::HasBitSetters::response(const SetOutputRequest* msg) {
-  return *msg->response_;
+void MethodACL::InitAsDefaultInstance() {
 }
+class MethodACL::HasBitSetters {
+ public:
+};
+
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int SetOutputRequest::kHeaderFieldNumber;
-const int SetOutputRequest::kResponseFieldNumber;
+const int MethodACL::kMethodFieldNumber;
+const int MethodACL::kCallersFieldNumber;
+const int MethodACL::kRolesFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-SetOutputRequest::SetOutputRequest()
+MethodACL::MethodACL()
   : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:contract.SetOutputRequest)
+  // @@protoc_insertion_point(constructor:contract.MethodACL)
 }
-SetOutputRequest::SetOutputRequest(const SetOutputRequest& from)
+MethodACL::MethodACL(const MethodACL& from)
   : ::google::protobuf::MessageLite(),
-      _internal_metadata_(nullptr) {
+      _internal_metadata_(nullptr),
+      callers_(from.callers_),
+      roles_(from.roles_) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  if (from.has_header()) {
-    header_ = new ::contract::SyscallHeader(*from.header_);
-  } else {
-    header_ = nullptr;
-  }
-  if (from.has_response()) {
-    response_ = new ::contract::Response(*from.response_);
-  } else {
-    response_ = nullptr;
+  method_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.method().size() > 0) {
+    method_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.method_);
   }
-  // @@protoc_insertion_point(copy_constructor:contract.SetOutputRequest)
+  // @@protoc_insertion_point(copy_constructor:contract.MethodACL)
 }
 
-void SetOutputRequest::SharedCtor() {
+void MethodACL::SharedCtor() {
   ::google::protobuf::internal::InitSCC(
-      &scc_info_SetOutputRequest_contract_2eproto.base);
-  ::memset(&header_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&response_) -
-      reinterpret_cast<char*>(&header_)) + sizeof(response_));
+      &scc_info_MethodACL_contract_2eproto.base);
+  method_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-SetOutputRequest::~SetOutputRequest() {
-  // @@protoc_insertion_point(destructor:contract.SetOutputRequest)
+MethodACL::~MethodACL() {
+  // @@protoc_insertion_point(destructor:contract.MethodACL)
   SharedDtor();
 }
 
-void SetOutputRequest::SharedDtor() {
-  if (this != internal_default_instance()) delete header_;
-  if (this != internal_default_instance()) delete response_;
+void MethodACL::SharedDtor() {
+  method_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-void SetOutputRequest::SetCachedSize(int size) const {
+void MethodACL::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const SetOutputRequest& SetOutputRequest::default_instance() {
-  ::google::protobuf::internal::InitSCC(&::scc_info_SetOutputRequest_contract_2eproto.base);
+const MethodACL& MethodACL::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_MethodACL_contract_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void SetOutputRequest::Clear() {
-// @@protoc_insertion_point(message_clear_start:contract.SetOutputRequest)
+void MethodACL::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.MethodACL)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
-    delete header_;
-  }
-  header_ = nullptr;
-  if (GetArenaNoVirtual() == nullptr && response_ != nullptr) {
-    delete response_;
-  }
-  response_ = nullptr;
+  callers_.Clear();
+  roles_.Clear();
+  method_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* SetOutputRequest::_InternalParse(const char* begin, const char* end, void* object,
+const char* MethodACL::_InternalParse(const char* begin, const char* end, void* object,
                   ::google::protobuf::internal::ParseContext* ctx) {
-  auto msg = static_cast<SetOutputRequest*>(object);
+  auto msg = static_cast<MethodACL*>(object);
   ::google::protobuf::int32 size; (void)size;
   int depth; (void)depth;
   ::google::protobuf::uint32 tag;
@@ -4622,30 +10088,58 @@ const char* SetOutputRequest::_InternalParse(const char* begin, const char* end,
     ptr = ::google::protobuf::io::Parse32(ptr, &tag);
     GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
     switch (tag >> 3) {
-      // .contract.SyscallHeader header = 1;
+      // string method = 1;
       case 1: {
         if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
         ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        parser_till_end = ::contract::SyscallHeader::_InternalParse;
-        object = msg->mutable_header();
-        if (size > end - ptr) goto len_delim_till_end;
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_method();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
         ptr += size;
-        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
-            {parser_till_end, object}, ptr - size, ptr));
         break;
       }
-      // .contract.Response response = 2;
+      // repeated string callers = 2;
       case 2: {
         if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
-        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
-        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        parser_till_end = ::contract::Response::_InternalParse;
-        object = msg->mutable_response();
-        if (size > end - ptr) goto len_delim_till_end;
-        ptr += size;
-        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
-            {parser_till_end, object}, ptr - size, ptr));
+        do {
+          ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+          ctx->extra_parse_data().SetFieldName(nullptr);
+          object = msg->add_callers();
+          if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+            parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+            goto string_till_end;
+          }
+          GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+          ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+          ptr += size;
+          if (ptr >= end) break;
+        } while ((::google::protobuf::io::UnalignedLoad<::google::protobuf::uint64>(ptr) & 255) == 18 && (ptr += 1));
+        break;
+      }
+      // repeated string roles = 3;
+      case 3: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
+        do {
+          ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+          ctx->extra_parse_data().SetFieldName(nullptr);
+          object = msg->add_roles();
+          if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+            parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+            goto string_till_end;
+          }
+          GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+          ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+          ptr += size;
+          if (ptr >= end) break;
+        } while ((::google::protobuf::io::UnalignedLoad<::google::protobuf::uint64>(ptr) & 255) == 26 && (ptr += 1));
         break;
       }
       default: {
@@ -4663,12 +10157,16 @@ const char* SetOutputRequest::_InternalParse(const char* begin, const char* end,
     }  // switch
   }  // while
   return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
 len_delim_till_end:
   return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
                                {parser_till_end, object}, size);
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool SetOutputRequest::MergePartialFromCodedStream(
+bool MethodACL::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
@@ -4678,28 +10176,53 @@ bool SetOutputRequest::MergePartialFromCodedStream(
       unknown_fields_setter.buffer());
   ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
       &unknown_fields_output, false);
-  // @@protoc_insertion_point(parse_start:contract.SetOutputRequest)
+  // @@protoc_insertion_point(parse_start:contract.MethodACL)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // .contract.SyscallHeader header = 1;
+      // string method = 1;
       case 1: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
-               input, mutable_header()));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_method()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->method().data(), static_cast<int>(this->method().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.MethodACL.method"));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .contract.Response response = 2;
+      // repeated string callers = 2;
       case 2: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
-               input, mutable_response()));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->add_callers()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->callers(this->callers_size() - 1).data(),
+            static_cast<int>(this->callers(this->callers_size() - 1).length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.MethodACL.callers"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // repeated string roles = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->add_roles()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->roles(this->roles_size() - 1).data(),
+            static_cast<int>(this->roles(this->roles_size() - 1).length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.MethodACL.roles"));
         } else {
           goto handle_unusual;
         }
@@ -4718,40 +10241,58 @@ bool SetOutputRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:contract.SetOutputRequest)
+  // @@protoc_insertion_point(parse_success:contract.MethodACL)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:contract.SetOutputRequest)
+  // @@protoc_insertion_point(parse_failure:contract.MethodACL)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void SetOutputRequest::SerializeWithCachedSizes(
+void MethodACL::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:contract.SetOutputRequest)
+  // @@protoc_insertion_point(serialize_start:contract.MethodACL)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .contract.SyscallHeader header = 1;
-  if (this->has_header()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessage(
-      1, HasBitSetters::header(this), output);
+  // string method = 1;
+  if (this->method().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->method().data(), static_cast<int>(this->method().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.MethodACL.method");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->method(), output);
   }
 
-  // .contract.Response response = 2;
-  if (this->has_response()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessage(
-      2, HasBitSetters::response(this), output);
+  // repeated string callers = 2;
+  for (int i = 0, n = this->callers_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->callers(i).data(), static_cast<int>(this->callers(i).length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.MethodACL.callers");
+    ::google::protobuf::internal::WireFormatLite::WriteString(
+      2, this->callers(i), output);
+  }
+
+  // repeated string roles = 3;
+  for (int i = 0, n = this->roles_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->roles(i).data(), static_cast<int>(this->roles(i).length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.MethodACL.roles");
+    ::google::protobuf::internal::WireFormatLite::WriteString(
+      3, this->roles(i), output);
   }
 
   output->WriteRaw(_internal_metadata_.unknown_fields().data(),
                    static_cast<int>(_internal_metadata_.unknown_fields().size()));
-  // @@protoc_insertion_point(serialize_end:contract.SetOutputRequest)
+  // @@protoc_insertion_point(serialize_end:contract.MethodACL)
 }
 
-size_t SetOutputRequest::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:contract.SetOutputRequest)
+size_t MethodACL::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.MethodACL)
   size_t total_size = 0;
 
   total_size += _internal_metadata_.unknown_fields().size();
@@ -4760,18 +10301,27 @@ size_t SetOutputRequest::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // .contract.SyscallHeader header = 1;
-  if (this->has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSize(
-        *header_);
+  // repeated string callers = 2;
+  total_size += 1 *
+      ::google::protobuf::internal::FromIntSize(this->callers_size());
+  for (int i = 0, n = this->callers_size(); i < n; i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::StringSize(
+      this->callers(i));
   }
 
-  // .contract.Response response = 2;
-  if (this->has_response()) {
+  // repeated string roles = 3;
+  total_size += 1 *
+      ::google::protobuf::internal::FromIntSize(this->roles_size());
+  for (int i = 0, n = this->roles_size(); i < n; i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::StringSize(
+      this->roles(i));
+  }
+
+  // string method = 1;
+  if (this->method().size() > 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSize(
-        *response_);
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->method());
   }
 
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
@@ -4779,109 +10329,124 @@ size_t SetOutputRequest::ByteSizeLong() const {
   return total_size;
 }
 
-void SetOutputRequest::CheckTypeAndMergeFrom(
+void MethodACL::CheckTypeAndMergeFrom(
     const ::google::protobuf::MessageLite& from) {
-  MergeFrom(*::google::protobuf::down_cast<const SetOutputRequest*>(&from));
+  MergeFrom(*::google::protobuf::down_cast<const MethodACL*>(&from));
 }
 
-void SetOutputRequest::MergeFrom(const SetOutputRequest& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:contract.SetOutputRequest)
+void MethodACL::MergeFrom(const MethodACL& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.MethodACL)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.has_header()) {
-    mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
-  }
-  if (from.has_response()) {
-    mutable_response()->::contract::Response::MergeFrom(from.response());
+  callers_.MergeFrom(from.callers_);
+  roles_.MergeFrom(from.roles_);
+  if (from.method().size() > 0) {
+
+    method_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.method_);
   }
 }
 
-void SetOutputRequest::CopyFrom(const SetOutputRequest& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:contract.SetOutputRequest)
+void MethodACL::CopyFrom(const MethodACL& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.MethodACL)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool SetOutputRequest::IsInitialized() const {
+bool MethodACL::IsInitialized() const {
   return true;
 }
 
-void SetOutputRequest::Swap(SetOutputRequest* other) {
+void MethodACL::Swap(MethodACL* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void SetOutputRequest::InternalSwap(SetOutputRequest* other) {
+void MethodACL::InternalSwap(MethodACL* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  swap(header_, other->header_);
-  swap(response_, other->response_);
+  CastToBase(&callers_)->InternalSwap(CastToBase(&other->callers_));
+  CastToBase(&roles_)->InternalSwap(CastToBase(&other->roles_));
+  method_.Swap(&other->method_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
 }
 
-::std::string SetOutputRequest::GetTypeName() const {
-  return "contract.SetOutputRequest";
+::std::string MethodACL::GetTypeName() const {
+  return "contract.MethodACL";
 }
 
 
 // ===================================================================
 
-void SetOutputResponse::InitAsDefaultInstance() {
+void ACLRole::InitAsDefaultInstance() {
 }
-class SetOutputResponse::HasBitSetters {
+class ACLRole::HasBitSetters {
  public:
 };
 
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int ACLRole::kNameFieldNumber;
+const int ACLRole::kMembersFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-SetOutputResponse::SetOutputResponse()
+ACLRole::ACLRole()
   : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:contract.SetOutputResponse)
+  // @@protoc_insertion_point(constructor:contract.ACLRole)
 }
-SetOutputResponse::SetOutputResponse(const SetOutputResponse& from)
+ACLRole::ACLRole(const ACLRole& from)
   : ::google::protobuf::MessageLite(),
-      _internal_metadata_(nullptr) {
+      _internal_metadata_(nullptr),
+      members_(from.members_) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:contract.SetOutputResponse)
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.name().size() > 0) {
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  // @@protoc_insertion_point(copy_constructor:contract.ACLRole)
 }
 
-void SetOutputResponse::SharedCtor() {
+void ACLRole::SharedCtor() {
+  ::google::protobuf::internal::InitSCC(
+      &scc_info_ACLRole_contract_2eproto.base);
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-SetOutputResponse::~SetOutputResponse() {
-  // @@protoc_insertion_point(destructor:contract.SetOutputResponse)
+ACLRole::~ACLRole() {
+  // @@protoc_insertion_point(destructor:contract.ACLRole)
   SharedDtor();
 }
 
-void SetOutputResponse::SharedDtor() {
+void ACLRole::SharedDtor() {
+  name_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-void SetOutputResponse::SetCachedSize(int size) const {
+void ACLRole::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const SetOutputResponse& SetOutputResponse::default_instance() {
-  ::google::protobuf::internal::InitSCC(&::scc_info_SetOutputResponse_contract_2eproto.base);
+const ACLRole& ACLRole::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_ACLRole_contract_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void SetOutputResponse::Clear() {
-// @@protoc_insertion_point(message_clear_start:contract.SetOutputResponse)
+void ACLRole::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.ACLRole)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
+  members_.Clear();
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* SetOutputResponse::_InternalParse(const char* begin, const char* end, void* object,
+const char* ACLRole::_InternalParse(const char* begin, const char* end, void* object,
                   ::google::protobuf::internal::ParseContext* ctx) {
-  auto msg = static_cast<SetOutputResponse*>(object);
+  auto msg = static_cast<ACLRole*>(object);
   ::google::protobuf::int32 size; (void)size;
   int depth; (void)depth;
   ::google::protobuf::uint32 tag;
@@ -4891,7 +10456,43 @@ const char* SetOutputResponse::_InternalParse(const char* begin, const char* end
     ptr = ::google::protobuf::io::Parse32(ptr, &tag);
     GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
     switch (tag >> 3) {
+      // string name = 1;
+      case 1: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
+        ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+        GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_name();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+        ptr += size;
+        break;
+      }
+      // repeated string members = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        do {
+          ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+          ctx->extra_parse_data().SetFieldName(nullptr);
+          object = msg->add_members();
+          if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+            parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+            goto string_till_end;
+          }
+          GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+          ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
+          ptr += size;
+          if (ptr >= end) break;
+        } while ((::google::protobuf::io::UnalignedLoad<::google::protobuf::uint64>(ptr) & 255) == 18 && (ptr += 1));
+        break;
+      }
       default: {
+      handle_unusual:
         if ((tag & 7) == 4 || tag == 0) {
           ctx->EndGroup(tag);
           return ptr;
@@ -4905,9 +10506,16 @@ const char* SetOutputResponse::_InternalParse(const char* begin, const char* end
     }  // switch
   }  // while
   return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
+len_delim_till_end:
+  return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
+                               {parser_till_end, object}, size);
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool SetOutputResponse::MergePartialFromCodedStream(
+bool ACLRole::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
@@ -4917,41 +10525,97 @@ bool SetOutputResponse::MergePartialFromCodedStream(
       unknown_fields_setter.buffer());
   ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
       &unknown_fields_output, false);
-  // @@protoc_insertion_point(parse_start:contract.SetOutputResponse)
+  // @@protoc_insertion_point(parse_start:contract.ACLRole)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
-  handle_unusual:
-    if (tag == 0) {
-      goto success;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // string name = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->name().data(), static_cast<int>(this->name().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ACLRole.name"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // repeated string members = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->add_members()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->members(this->members_size() - 1).data(),
+            static_cast<int>(this->members(this->members_size() - 1).length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ACLRole.members"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormatLite::SkipField(
+            input, tag, &unknown_fields_stream));
+        break;
+      }
     }
-    DO_(::google::protobuf::internal::WireFormatLite::SkipField(
-        input, tag, &unknown_fields_stream));
   }
 success:
-  // @@protoc_insertion_point(parse_success:contract.SetOutputResponse)
+  // @@protoc_insertion_point(parse_success:contract.ACLRole)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:contract.SetOutputResponse)
+  // @@protoc_insertion_point(parse_failure:contract.ACLRole)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void SetOutputResponse::SerializeWithCachedSizes(
+void ACLRole::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:contract.SetOutputResponse)
+  // @@protoc_insertion_point(serialize_start:contract.ACLRole)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
+  // string name = 1;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ACLRole.name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->name(), output);
+  }
+
+  // repeated string members = 2;
+  for (int i = 0, n = this->members_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->members(i).data(), static_cast<int>(this->members(i).length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ACLRole.members");
+    ::google::protobuf::internal::WireFormatLite::WriteString(
+      2, this->members(i), output);
+  }
+
   output->WriteRaw(_internal_metadata_.unknown_fields().data(),
                    static_cast<int>(_internal_metadata_.unknown_fields().size()));
-  // @@protoc_insertion_point(serialize_end:contract.SetOutputResponse)
+  // @@protoc_insertion_point(serialize_end:contract.ACLRole)
 }
 
-size_t SetOutputResponse::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:contract.SetOutputResponse)
+size_t ACLRole::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.ACLRole)
   size_t total_size = 0;
 
   total_size += _internal_metadata_.unknown_fields().size();
@@ -4960,127 +10624,145 @@ size_t SetOutputResponse::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
+  // repeated string members = 2;
+  total_size += 1 *
+      ::google::protobuf::internal::FromIntSize(this->members_size());
+  for (int i = 0, n = this->members_size(); i < n; i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::StringSize(
+      this->members(i));
+  }
+
+  // string name = 1;
+  if (this->name().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->name());
+  }
+
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
   SetCachedSize(cached_size);
   return total_size;
 }
 
-void SetOutputResponse::CheckTypeAndMergeFrom(
+void ACLRole::CheckTypeAndMergeFrom(
     const ::google::protobuf::MessageLite& from) {
-  MergeFrom(*::google::protobuf::down_cast<const SetOutputResponse*>(&from));
+  MergeFrom(*::google::protobuf::down_cast<const ACLRole*>(&from));
 }
 
-void SetOutputResponse::MergeFrom(const SetOutputResponse& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:contract.SetOutputResponse)
+void ACLRole::MergeFrom(const ACLRole& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.ACLRole)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
+  members_.MergeFrom(from.members_);
+  if (from.name().size() > 0) {
+
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
 }
 
-void SetOutputResponse::CopyFrom(const SetOutputResponse& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:contract.SetOutputResponse)
+void ACLRole::CopyFrom(const ACLRole& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.ACLRole)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool SetOutputResponse::IsInitialized() const {
+bool ACLRole::IsInitialized() const {
   return true;
 }
 
-void SetOutputResponse::Swap(SetOutputResponse* other) {
+void ACLRole::Swap(ACLRole* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void SetOutputResponse::InternalSwap(SetOutputResponse* other) {
+void ACLRole::InternalSwap(ACLRole* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
+  CastToBase(&members_)->InternalSwap(CastToBase(&other->members_));
+  name_.Swap(&other->name_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
 }
 
-::std::string SetOutputResponse::GetTypeName() const {
-  return "contract.SetOutputResponse";
+::std::string ACLRole::GetTypeName() const {
+  return "contract.ACLRole";
 }
 
 
 // ===================================================================
 
-void GetCallArgsRequest::InitAsDefaultInstance() {
-  ::contract::_GetCallArgsRequest_default_instance_._instance.get_mutable()->header_ = const_cast< ::contract::SyscallHeader*>(
-      ::contract::SyscallHeader::internal_default_instance());
+void ContractACL::InitAsDefaultInstance() {
 }
-class GetCallArgsRequest::HasBitSetters {
+class ContractACL::HasBitSetters {
  public:
-  static const ::contract::SyscallHeader& header(const GetCallArgsRequest* msg);
 };
 
-const ::contract::SyscallHeader&
-GetCallArgsRequest::HasBitSetters::header(const GetCallArgsRequest* msg) {
-  return *msg->header_;
-}
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int GetCallArgsRequest::kHeaderFieldNumber;
+const int ContractACL::kContractFieldNumber;
+const int ContractACL::kMethodsFieldNumber;
+const int ContractACL::kRolesFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-GetCallArgsRequest::GetCallArgsRequest()
+ContractACL::ContractACL()
   : ::google::protobuf::MessageLite(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:contract.GetCallArgsRequest)
+  // @@protoc_insertion_point(constructor:contract.ContractACL)
 }
-GetCallArgsRequest::GetCallArgsRequest(const GetCallArgsRequest& from)
+ContractACL::ContractACL(const ContractACL& from)
   : ::google::protobuf::MessageLite(),
-      _internal_metadata_(nullptr) {
+      _internal_metadata_(nullptr),
+      methods_(from.methods_),
+      roles_(from.roles_) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  if (from.has_header()) {
-    header_ = new ::contract::SyscallHeader(*from.header_);
-  } else {
-    header_ = nullptr;
+  contract_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.contract().size() > 0) {
+    contract_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.contract_);
   }
-  // @@protoc_insertion_point(copy_constructor:contract.GetCallArgsRequest)
+  // @@protoc_insertion_point(copy_constructor:contract.ContractACL)
 }
 
-void GetCallArgsRequest::SharedCtor() {
+void ContractACL::SharedCtor() {
   ::google::protobuf::internal::InitSCC(
-      &scc_info_GetCallArgsRequest_contract_2eproto.base);
-  header_ = nullptr;
+      &scc_info_ContractACL_contract_2eproto.base);
+  contract_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-GetCallArgsRequest::~GetCallArgsRequest() {
-  // @@protoc_insertion_point(destructor:contract.GetCallArgsRequest)
+ContractACL::~ContractACL() {
+  // @@protoc_insertion_point(destructor:contract.ContractACL)
   SharedDtor();
 }
 
-void GetCallArgsRequest::SharedDtor() {
-  if (this != internal_default_instance()) delete header_;
+void ContractACL::SharedDtor() {
+  contract_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-void GetCallArgsRequest::SetCachedSize(int size) const {
+void ContractACL::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const GetCallArgsRequest& GetCallArgsRequest::default_instance() {
-  ::google::protobuf::internal::InitSCC(&::scc_info_GetCallArgsRequest_contract_2eproto.base);
+const ContractACL& ContractACL::default_instance() {
+  ::google::protobuf::internal::InitSCC(&::scc_info_ContractACL_contract_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void GetCallArgsRequest::Clear() {
-// @@protoc_insertion_point(message_clear_start:contract.GetCallArgsRequest)
+void ContractACL::Clear() {
+// @@protoc_insertion_point(message_clear_start:contract.ContractACL)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
-    delete header_;
-  }
-  header_ = nullptr;
+  methods_.Clear();
+  roles_.Clear();
+  contract_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* GetCallArgsRequest::_InternalParse(const char* begin, const char* end, void* object,
+const char* ContractACL::_InternalParse(const char* begin, const char* end, void* object,
                   ::google::protobuf::internal::ParseContext* ctx) {
-  auto msg = static_cast<GetCallArgsRequest*>(object);
+  auto msg = static_cast<ContractACL*>(object);
   ::google::protobuf::int32 size; (void)size;
   int depth; (void)depth;
   ::google::protobuf::uint32 tag;
@@ -5090,17 +10772,52 @@ const char* GetCallArgsRequest::_InternalParse(const char* begin, const char* en
     ptr = ::google::protobuf::io::Parse32(ptr, &tag);
     GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
     switch (tag >> 3) {
-      // .contract.SyscallHeader header = 1;
+      // string contract = 1;
       case 1: {
         if (static_cast<::google::protobuf::uint8>(tag) != 10) goto handle_unusual;
         ptr = ::google::protobuf::io::ReadSize(ptr, &size);
         GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
-        parser_till_end = ::contract::SyscallHeader::_InternalParse;
-        object = msg->mutable_header();
-        if (size > end - ptr) goto len_delim_till_end;
+        ctx->extra_parse_data().SetFieldName(nullptr);
+        object = msg->mutable_contract();
+        if (size > end - ptr + ::google::protobuf::internal::ParseContext::kSlopBytes) {
+          parser_till_end = ::google::protobuf::internal::GreedyStringParserUTF8;
+          goto string_till_end;
+        }
+        GOOGLE_PROTOBUF_PARSER_ASSERT(::google::protobuf::internal::StringCheckUTF8(ptr, size, ctx));
+        ::google::protobuf::internal::InlineGreedyStringParser(object, ptr, size, ctx);
         ptr += size;
-        GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
-            {parser_till_end, object}, ptr - size, ptr));
+        break;
+      }
+      // repeated .contract.MethodACL methods = 2;
+      case 2: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 18) goto handle_unusual;
+        do {
+          ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+          parser_till_end = ::contract::MethodACL::_InternalParse;
+          object = msg->add_methods();
+          if (size > end - ptr) goto len_delim_till_end;
+          ptr += size;
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+              {parser_till_end, object}, ptr - size, ptr));
+          if (ptr >= end) break;
+        } while ((::google::protobuf::io::UnalignedLoad<::google::protobuf::uint64>(ptr) & 255) == 18 && (ptr += 1));
+        break;
+      }
+      // repeated .contract.ACLRole roles = 3;
+      case 3: {
+        if (static_cast<::google::protobuf::uint8>(tag) != 26) goto handle_unusual;
+        do {
+          ptr = ::google::protobuf::io::ReadSize(ptr, &size);
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ptr);
+          parser_till_end = ::contract::ACLRole::_InternalParse;
+          object = msg->add_roles();
+          if (size > end - ptr) goto len_delim_till_end;
+          ptr += size;
+          GOOGLE_PROTOBUF_PARSER_ASSERT(ctx->ParseExactRange(
+              {parser_till_end, object}, ptr - size, ptr));
+          if (ptr >= end) break;
+        } while ((::google::protobuf::io::UnalignedLoad<::google::protobuf::uint64>(ptr) & 255) == 26 && (ptr += 1));
         break;
       }
       default: {
@@ -5118,12 +10835,16 @@ const char* GetCallArgsRequest::_InternalParse(const char* begin, const char* en
     }  // switch
   }  // while
   return ptr;
+string_till_end:
+  static_cast<::std::string*>(object)->clear();
+  static_cast<::std::string*>(object)->reserve(size);
+  goto len_delim_till_end;
 len_delim_till_end:
   return ctx->StoreAndTailCall(ptr, end, {_InternalParse, msg},
                                {parser_till_end, object}, size);
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool GetCallArgsRequest::MergePartialFromCodedStream(
+bool ContractACL::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
@@ -5133,17 +10854,43 @@ bool GetCallArgsRequest::MergePartialFromCodedStream(
       unknown_fields_setter.buffer());
   ::google::protobuf::io::CodedOutputStream unknown_fields_stream(
       &unknown_fields_output, false);
-  // @@protoc_insertion_point(parse_start:contract.GetCallArgsRequest)
+  // @@protoc_insertion_point(parse_start:contract.ContractACL)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // .contract.SyscallHeader header = 1;
+      // string contract = 1;
       case 1: {
         if (static_cast< ::google::protobuf::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_contract()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->contract().data(), static_cast<int>(this->contract().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "contract.ContractACL.contract"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // repeated .contract.MethodACL methods = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (18 & 0xFF)) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
-               input, mutable_header()));
+                input, add_methods()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // repeated .contract.ACLRole roles = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+                input, add_roles()));
         } else {
           goto handle_unusual;
         }
@@ -5162,34 +10909,56 @@ bool GetCallArgsRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:contract.GetCallArgsRequest)
+  // @@protoc_insertion_point(parse_success:contract.ContractACL)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:contract.GetCallArgsRequest)
+  // @@protoc_insertion_point(parse_failure:contract.ContractACL)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void GetCallArgsRequest::SerializeWithCachedSizes(
+void ContractACL::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:contract.GetCallArgsRequest)
+  // @@protoc_insertion_point(serialize_start:contract.ContractACL)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .contract.SyscallHeader header = 1;
-  if (this->has_header()) {
+  // string contract = 1;
+  if (this->contract().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->contract().data(), static_cast<int>(this->contract().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "contract.ContractACL.contract");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->contract(), output);
+  }
+
+  // repeated .contract.MethodACL methods = 2;
+  for (unsigned int i = 0,
+      n = static_cast<unsigned int>(this->methods_size()); i < n; i++) {
     ::google::protobuf::internal::WireFormatLite::WriteMessage(
-      1, HasBitSetters::header(this), output);
+      2,
+      this->methods(static_cast<int>(i)),
+      output);
+  }
+
+  // repeated .contract.ACLRole roles = 3;
+  for (unsigned int i = 0,
+      n = static_cast<unsigned int>(this->roles_size()); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessage(
+      3,
+      this->roles(static_cast<int>(i)),
+      output);
   }
 
   output->WriteRaw(_internal_metadata_.unknown_fields().data(),
                    static_cast<int>(_internal_metadata_.unknown_fields().size()));
-  // @@protoc_insertion_point(serialize_end:contract.GetCallArgsRequest)
+  // @@protoc_insertion_point(serialize_end:contract.ContractACL)
 }
 
-size_t GetCallArgsRequest::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:contract.GetCallArgsRequest)
+size_t ContractACL::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:contract.ContractACL)
   size_t total_size = 0;
 
   total_size += _internal_metadata_.unknown_fields().size();
@@ -5198,11 +10967,33 @@ size_t GetCallArgsRequest::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // .contract.SyscallHeader header = 1;
-  if (this->has_header()) {
+  // repeated .contract.MethodACL methods = 2;
+  {
+    unsigned int count = static_cast<unsigned int>(this->methods_size());
+    total_size += 1UL * count;
+    for (unsigned int i = 0; i < count; i++) {
+      total_size +=
+        ::google::protobuf::internal::WireFormatLite::MessageSize(
+          this->methods(static_cast<int>(i)));
+    }
+  }
+
+  // repeated .contract.ACLRole roles = 3;
+  {
+    unsigned int count = static_cast<unsigned int>(this->roles_size());
+    total_size += 1UL * count;
+    for (unsigned int i = 0; i < count; i++) {
+      total_size +=
+        ::google::protobuf::internal::WireFormatLite::MessageSize(
+          this->roles(static_cast<int>(i)));
+    }
+  }
+
+  // string contract = 1;
+  if (this->contract().size() > 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSize(
-        *header_);
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->contract());
   }
 
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
@@ -5210,46 +11001,52 @@ size_t GetCallArgsRequest::ByteSizeLong() const {
   return total_size;
 }
 
-void GetCallArgsRequest::CheckTypeAndMergeFrom(
+void ContractACL::CheckTypeAndMergeFrom(
     const ::google::protobuf::MessageLite& from) {
-  MergeFrom(*::google::protobuf::down_cast<const GetCallArgsRequest*>(&from));
+  MergeFrom(*::google::protobuf::down_cast<const ContractACL*>(&from));
 }
 
-void GetCallArgsRequest::MergeFrom(const GetCallArgsRequest& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:contract.GetCallArgsRequest)
+void ContractACL::MergeFrom(const ContractACL& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:contract.ContractACL)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.has_header()) {
-    mutable_header()->::contract::SyscallHeader::MergeFrom(from.header());
+  methods_.MergeFrom(from.methods_);
+  roles_.MergeFrom(from.roles_);
+  if (from.contract().size() > 0) {
+
+    contract_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.contract_);
   }
 }
 
-void GetCallArgsRequest::CopyFrom(const GetCallArgsRequest& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:contract.GetCallArgsRequest)
+void ContractACL::CopyFrom(const ContractACL& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:contract.ContractACL)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GetCallArgsRequest::IsInitialized() const {
+bool ContractACL::IsInitialized() const {
   return true;
 }
 
-void GetCallArgsRequest::Swap(GetCallArgsRequest* other) {
+void ContractACL::Swap(ContractACL* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void GetCallArgsRequest::InternalSwap(GetCallArgsRequest* other) {
+void ContractACL::InternalSwap(ContractACL* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  swap(header_, other->header_);
+  CastToBase(&methods_)->InternalSwap(CastToBase(&other->methods_));
+  CastToBase(&roles_)->InternalSwap(CastToBase(&other->roles_));
+  contract_.Swap(&other->contract_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
 }
 
-::std::string GetCallArgsRequest::GetTypeName() const {
-  return "contract.GetCallArgsRequest";
+::std::string ContractACL::GetTypeName() const {
+  return "contract.ContractACL";
 }
 
 
@@ -5284,12 +11081,30 @@ template<> PROTOBUF_NOINLINE ::contract::DeleteRequest* Arena::CreateMaybeMessag
 template<> PROTOBUF_NOINLINE ::contract::DeleteResponse* Arena::CreateMaybeMessage< ::contract::DeleteResponse >(Arena* arena) {
   return Arena::CreateInternal< ::contract::DeleteResponse >(arena);
 }
+template<> PROTOBUF_NOINLINE ::contract::IteratorRequest* Arena::CreateMaybeMessage< ::contract::IteratorRequest >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::IteratorRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::IteratorItem* Arena::CreateMaybeMessage< ::contract::IteratorItem >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::IteratorItem >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::IteratorResponse* Arena::CreateMaybeMessage< ::contract::IteratorResponse >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::IteratorResponse >(arena);
+}
 template<> PROTOBUF_NOINLINE ::contract::TransferRequest* Arena::CreateMaybeMessage< ::contract::TransferRequest >(Arena* arena) {
   return Arena::CreateInternal< ::contract::TransferRequest >(arena);
 }
 template<> PROTOBUF_NOINLINE ::contract::TransferResponse* Arena::CreateMaybeMessage< ::contract::TransferResponse >(Arena* arena) {
   return Arena::CreateInternal< ::contract::TransferResponse >(arena);
 }
+template<> PROTOBUF_NOINLINE ::contract::ContractEvent* Arena::CreateMaybeMessage< ::contract::ContractEvent >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::ContractEvent >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::EmitEventRequest* Arena::CreateMaybeMessage< ::contract::EmitEventRequest >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::EmitEventRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::EmitEventResponse* Arena::CreateMaybeMessage< ::contract::EmitEventResponse >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::EmitEventResponse >(arena);
+}
 template<> PROTOBUF_NOINLINE ::contract::ContractCallRequest* Arena::CreateMaybeMessage< ::contract::ContractCallRequest >(Arena* arena) {
   return Arena::CreateInternal< ::contract::ContractCallRequest >(arena);
 }
@@ -5308,6 +11123,36 @@ template<> PROTOBUF_NOINLINE ::contract::SetOutputResponse* Arena::CreateMaybeMe
 template<> PROTOBUF_NOINLINE ::contract::GetCallArgsRequest* Arena::CreateMaybeMessage< ::contract::GetCallArgsRequest >(Arena* arena) {
   return Arena::CreateInternal< ::contract::GetCallArgsRequest >(arena);
 }
+template<> PROTOBUF_NOINLINE ::contract::Environment* Arena::CreateMaybeMessage< ::contract::Environment >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::Environment >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::GetEnvironmentRequest* Arena::CreateMaybeMessage< ::contract::GetEnvironmentRequest >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::GetEnvironmentRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::HashRequest* Arena::CreateMaybeMessage< ::contract::HashRequest >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::HashRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::HashResponse* Arena::CreateMaybeMessage< ::contract::HashResponse >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::HashResponse >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::VerifySignatureRequest* Arena::CreateMaybeMessage< ::contract::VerifySignatureRequest >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::VerifySignatureRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::VerifySignatureResponse* Arena::CreateMaybeMessage< ::contract::VerifySignatureResponse >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::VerifySignatureResponse >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::ContractDesc* Arena::CreateMaybeMessage< ::contract::ContractDesc >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::ContractDesc >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::MethodACL* Arena::CreateMaybeMessage< ::contract::MethodACL >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::MethodACL >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::ACLRole* Arena::CreateMaybeMessage< ::contract::ACLRole >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::ACLRole >(arena);
+}
+template<> PROTOBUF_NOINLINE ::contract::ContractACL* Arena::CreateMaybeMessage< ::contract::ContractACL >(Arena* arena) {
+  return Arena::CreateInternal< ::contract::ContractACL >(arena);
+}
 }  // namespace protobuf
 }  // namespace google
 
@@ -40,40 +40,82 @@ struct TableStruct_contract_2eproto {
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
   static const ::google::protobuf::internal::AuxillaryParseTableField aux[]
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
-  static const ::google::protobuf::internal::ParseTable schema[17]
+  static const ::google::protobuf::internal::ParseTable schema[33]
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
   static const ::google::protobuf::internal::FieldMetadata field_metadata[];
   static const ::google::protobuf::internal::SerializationTable serialization_table[];
   static const ::google::protobuf::uint32 offsets[];
 };
 namespace contract {
+class ACLRole;
+class ACLRoleDefaultTypeInternal;
+extern ACLRoleDefaultTypeInternal _ACLRole_default_instance_;
 class ArgPair;
 class ArgPairDefaultTypeInternal;
 extern ArgPairDefaultTypeInternal _ArgPair_default_instance_;
 class CallArgs;
 class CallArgsDefaultTypeInternal;
 extern CallArgsDefaultTypeInternal _CallArgs_default_instance_;
+class ContractACL;
+class ContractACLDefaultTypeInternal;
+extern ContractACLDefaultTypeInternal _ContractACL_default_instance_;
 class ContractCallRequest;
 class ContractCallRequestDefaultTypeInternal;
 extern ContractCallRequestDefaultTypeInternal _ContractCallRequest_default_instance_;
 class ContractCallResponse;
 class ContractCallResponseDefaultTypeInternal;
 extern ContractCallResponseDefaultTypeInternal _ContractCallResponse_default_instance_;
+class ContractDesc;
+class ContractDescDefaultTypeInternal;
+extern ContractDescDefaultTypeInternal _ContractDesc_default_instance_;
+class ContractEvent;
+class ContractEventDefaultTypeInternal;
+extern ContractEventDefaultTypeInternal _ContractEvent_default_instance_;
 class DeleteRequest;
 class DeleteRequestDefaultTypeInternal;
 extern DeleteRequestDefaultTypeInternal _DeleteRequest_default_instance_;
 class DeleteResponse;
 class DeleteResponseDefaultTypeInternal;
 extern DeleteResponseDefaultTypeInternal _DeleteResponse_default_instance_;
+class EmitEventRequest;
+class EmitEventRequestDefaultTypeInternal;
+extern EmitEventRequestDefaultTypeInternal _EmitEventRequest_default_instance_;
+class EmitEventResponse;
+class EmitEventResponseDefaultTypeInternal;
+extern EmitEventResponseDefaultTypeInternal _EmitEventResponse_default_instance_;
+class Environment;
+class EnvironmentDefaultTypeInternal;
+extern EnvironmentDefaultTypeInternal _Environment_default_instance_;
 class GetCallArgsRequest;
 class GetCallArgsRequestDefaultTypeInternal;
 extern GetCallArgsRequestDefaultTypeInternal _GetCallArgsRequest_default_instance_;
+class GetEnvironmentRequest;
+class GetEnvironmentRequestDefaultTypeInternal;
+extern GetEnvironmentRequestDefaultTypeInternal _GetEnvironmentRequest_default_instance_;
 class GetRequest;
 class GetRequestDefaultTypeInternal;
 extern GetRequestDefaultTypeInternal _GetRequest_default_instance_;
 class GetResponse;
 class GetResponseDefaultTypeInternal;
 extern GetResponseDefaultTypeInternal _GetResponse_default_instance_;
+class HashRequest;
+class HashRequestDefaultTypeInternal;
+extern HashRequestDefaultTypeInternal _HashRequest_default_instance_;
+class HashResponse;
+class HashResponseDefaultTypeInternal;
+extern HashResponseDefaultTypeInternal _HashResponse_default_instance_;
+class IteratorItem;
+class IteratorItemDefaultTypeInternal;
+extern IteratorItemDefaultTypeInternal _IteratorItem_default_instance_;
+class IteratorRequest;
+class IteratorRequestDefaultTypeInternal;
+extern IteratorRequestDefaultTypeInternal _IteratorRequest_default_instance_;
+class IteratorResponse;
+class IteratorResponseDefaultTypeInternal;
+extern IteratorResponseDefaultTypeInternal _IteratorResponse_default_instance_;
+class MethodACL;
+class MethodACLDefaultTypeInternal;
+extern MethodACLDefaultTypeInternal _MethodACL_default_instance_;
 class PutRequest;
 class PutRequestDefaultTypeInternal;
 extern PutRequestDefaultTypeInternal _PutRequest_default_instance_;
@@ -98,18 +140,38 @@ extern TransferRequestDefaultTypeInternal _TransferRequest_default_instance_;
 class TransferResponse;
 class TransferResponseDefaultTypeInternal;
 extern TransferResponseDefaultTypeInternal _TransferResponse_default_instance_;
+class VerifySignatureRequest;
+class VerifySignatureRequestDefaultTypeInternal;
+extern VerifySignatureRequestDefaultTypeInternal _VerifySignatureRequest_default_instance_;
+class VerifySignatureResponse;
+class VerifySignatureResponseDefaultTypeInternal;
+extern VerifySignatureResponseDefaultTypeInternal _VerifySignatureResponse_default_instance_;
 }  // namespace contract
 namespace google {
 namespace protobuf {
+template<> ::contract::ACLRole* Arena::CreateMaybeMessage<::contract::ACLRole>(Arena*);
 template<> ::contract::ArgPair* Arena::CreateMaybeMessage<::contract::ArgPair>(Arena*);
 template<> ::contract::CallArgs* Arena::CreateMaybeMessage<::contract::CallArgs>(Arena*);
+template<> ::contract::ContractACL* Arena::CreateMaybeMessage<::contract::ContractACL>(Arena*);
 template<> ::contract::ContractCallRequest* Arena::CreateMaybeMessage<::contract::ContractCallRequest>(Arena*);
 template<> ::contract::ContractCallResponse* Arena::CreateMaybeMessage<::contract::ContractCallResponse>(Arena*);
+template<> ::contract::ContractDesc* Arena::CreateMaybeMessage<::contract::ContractDesc>(Arena*);
+template<> ::contract::ContractEvent* Arena::CreateMaybeMessage<::contract::ContractEvent>(Arena*);
 template<> ::contract::DeleteRequest* Arena::CreateMaybeMessage<::contract::DeleteRequest>(Arena*);
 template<> ::contract::DeleteResponse* Arena::CreateMaybeMessage<::contract::DeleteResponse>(Arena*);
+template<> ::contract::EmitEventRequest* Arena::CreateMaybeMessage<::contract::EmitEventRequest>(Arena*);
+template<> ::contract::EmitEventResponse* Arena::CreateMaybeMessage<::contract::EmitEventResponse>(Arena*);
+template<> ::contract::Environment* Arena::CreateMaybeMessage<::contract::Environment>(Arena*);
 template<> ::contract::GetCallArgsRequest* Arena::CreateMaybeMessage<::contract::GetCallArgsRequest>(Arena*);
+template<> ::contract::GetEnvironmentRequest* Arena::CreateMaybeMessage<::contract::GetEnvironmentRequest>(Arena*);
 template<> ::contract::GetRequest* Arena::CreateMaybeMessage<::contract::GetRequest>(Arena*);
 template<> ::contract::GetResponse* Arena::CreateMaybeMessage<::contract::GetResponse>(Arena*);
+template<> ::contract::HashRequest* Arena::CreateMaybeMessage<::contract::HashRequest>(Arena*);
+template<> ::contract::HashResponse* Arena::CreateMaybeMessage<::contract::HashResponse>(Arena*);
+template<> ::contract::IteratorItem* Arena::CreateMaybeMessage<::contract::IteratorItem>(Arena*);
+template<> ::contract::IteratorRequest* Arena::CreateMaybeMessage<::contract::IteratorRequest>(Arena*);
+template<> ::contract::IteratorResponse* Arena::CreateMaybeMessage<::contract::IteratorResponse>(Arena*);
+template<> ::contract::MethodACL* Arena::CreateMaybeMessage<::contract::MethodACL>(Arena*);
 template<> ::contract::PutRequest* Arena::CreateMaybeMessage<::contract::PutRequest>(Arena*);
 template<> ::contract::PutResponse* Arena::CreateMaybeMessage<::contract::PutResponse>(Arena*);
 template<> ::contract::Response* Arena::CreateMaybeMessage<::contract::Response>(Arena*);
@@ -118,6 +180,8 @@ template<> ::contract::SetOutputResponse* Arena::CreateMaybeMessage<::contract::
 template<> ::contract::SyscallHeader* Arena::CreateMaybeMessage<::contract::SyscallHeader>(Arena*);
 template<> ::contract::TransferRequest* Arena::CreateMaybeMessage<::contract::TransferRequest>(Arena*);
 template<> ::contract::TransferResponse* Arena::CreateMaybeMessage<::contract::TransferResponse>(Arena*);
+template<> ::contract::VerifySignatureRequest* Arena::CreateMaybeMessage<::contract::VerifySignatureRequest>(Arena*);
+template<> ::contract::VerifySignatureResponse* Arena::CreateMaybeMessage<::contract::VerifySignatureResponse>(Arena*);
 }  // namespace protobuf
 }  // namespace google
 namespace contract {
@@ -1218,25 +1282,25 @@ class DeleteResponse :
 };
 // -------------------------------------------------------------------
 
-class TransferRequest :
-    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.TransferRequest) */ {
+class IteratorRequest :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.IteratorRequest) */ {
  public:
-  TransferRequest();
-  virtual ~TransferRequest();
+  IteratorRequest();
+  virtual ~IteratorRequest();
 
-  TransferRequest(const TransferRequest& from);
+  IteratorRequest(const IteratorRequest& from);
 
-  inline TransferRequest& operator=(const TransferRequest& from) {
+  inline IteratorRequest& operator=(const IteratorRequest& from) {
     CopyFrom(from);
     return *this;
   }
   #if LANG_CXX11
-  TransferRequest(TransferRequest&& from) noexcept
-    : TransferRequest() {
+  IteratorRequest(IteratorRequest&& from) noexcept
+    : IteratorRequest() {
     *this = ::std::move(from);
   }
 
-  inline TransferRequest& operator=(TransferRequest&& from) noexcept {
+  inline IteratorRequest& operator=(IteratorRequest&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -1245,34 +1309,34 @@ class TransferRequest :
     return *this;
   }
   #endif
-  static const TransferRequest& default_instance();
+  static const IteratorRequest& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const TransferRequest* internal_default_instance() {
-    return reinterpret_cast<const TransferRequest*>(
-               &_TransferRequest_default_instance_);
+  static inline const IteratorRequest* internal_default_instance() {
+    return reinterpret_cast<const IteratorRequest*>(
+               &_IteratorRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     9;
 
-  void Swap(TransferRequest* other);
-  friend void swap(TransferRequest& a, TransferRequest& b) {
+  void Swap(IteratorRequest* other);
+  friend void swap(IteratorRequest& a, IteratorRequest& b) {
     a.Swap(&b);
   }
 
   // implements Message ----------------------------------------------
 
-  inline TransferRequest* New() const final {
-    return CreateMaybeMessage<TransferRequest>(nullptr);
+  inline IteratorRequest* New() const final {
+    return CreateMaybeMessage<IteratorRequest>(nullptr);
   }
 
-  TransferRequest* New(::google::protobuf::Arena* arena) const final {
-    return CreateMaybeMessage<TransferRequest>(arena);
+  IteratorRequest* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<IteratorRequest>(arena);
   }
   void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
     final;
-  void CopyFrom(const TransferRequest& from);
-  void MergeFrom(const TransferRequest& from);
+  void CopyFrom(const IteratorRequest& from);
+  void MergeFrom(const IteratorRequest& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -1293,7 +1357,7 @@ class TransferRequest :
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(TransferRequest* other);
+  void InternalSwap(IteratorRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return nullptr;
@@ -1309,47 +1373,33 @@ class TransferRequest :
 
   // accessors -------------------------------------------------------
 
-  // string from = 2;
-  void clear_from();
-  static const int kFromFieldNumber = 2;
-  const ::std::string& from() const;
-  void set_from(const ::std::string& value);
-  #if LANG_CXX11
-  void set_from(::std::string&& value);
-  #endif
-  void set_from(const char* value);
-  void set_from(const char* value, size_t size);
-  ::std::string* mutable_from();
-  ::std::string* release_from();
-  void set_allocated_from(::std::string* from);
-
-  // string to = 3;
-  void clear_to();
-  static const int kToFieldNumber = 3;
-  const ::std::string& to() const;
-  void set_to(const ::std::string& value);
+  // bytes start = 2;
+  void clear_start();
+  static const int kStartFieldNumber = 2;
+  const ::std::string& start() const;
+  void set_start(const ::std::string& value);
   #if LANG_CXX11
-  void set_to(::std::string&& value);
+  void set_start(::std::string&& value);
   #endif
-  void set_to(const char* value);
-  void set_to(const char* value, size_t size);
-  ::std::string* mutable_to();
-  ::std::string* release_to();
-  void set_allocated_to(::std::string* to);
-
-  // string amount = 4;
-  void clear_amount();
-  static const int kAmountFieldNumber = 4;
-  const ::std::string& amount() const;
-  void set_amount(const ::std::string& value);
+  void set_start(const char* value);
+  void set_start(const void* value, size_t size);
+  ::std::string* mutable_start();
+  ::std::string* release_start();
+  void set_allocated_start(::std::string* start);
+
+  // bytes limit = 3;
+  void clear_limit();
+  static const int kLimitFieldNumber = 3;
+  const ::std::string& limit() const;
+  void set_limit(const ::std::string& value);
   #if LANG_CXX11
-  void set_amount(::std::string&& value);
+  void set_limit(::std::string&& value);
   #endif
-  void set_amount(const char* value);
-  void set_amount(const char* value, size_t size);
-  ::std::string* mutable_amount();
-  ::std::string* release_amount();
-  void set_allocated_amount(::std::string* amount);
+  void set_limit(const char* value);
+  void set_limit(const void* value, size_t size);
+  ::std::string* mutable_limit();
+  ::std::string* release_limit();
+  void set_allocated_limit(::std::string* limit);
 
   // .contract.SyscallHeader header = 1;
   bool has_header() const;
@@ -1360,39 +1410,45 @@ class TransferRequest :
   ::contract::SyscallHeader* mutable_header();
   void set_allocated_header(::contract::SyscallHeader* header);
 
-  // @@protoc_insertion_point(class_scope:contract.TransferRequest)
+  // int32 cap = 4;
+  void clear_cap();
+  static const int kCapFieldNumber = 4;
+  ::google::protobuf::int32 cap() const;
+  void set_cap(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:contract.IteratorRequest)
  private:
   class HasBitSetters;
 
   ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
-  ::google::protobuf::internal::ArenaStringPtr from_;
-  ::google::protobuf::internal::ArenaStringPtr to_;
-  ::google::protobuf::internal::ArenaStringPtr amount_;
+  ::google::protobuf::internal::ArenaStringPtr start_;
+  ::google::protobuf::internal::ArenaStringPtr limit_;
   ::contract::SyscallHeader* header_;
+  ::google::protobuf::int32 cap_;
   mutable ::google::protobuf::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_contract_2eproto;
 };
 // -------------------------------------------------------------------
 
-class TransferResponse :
-    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.TransferResponse) */ {
+class IteratorItem :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.IteratorItem) */ {
  public:
-  TransferResponse();
-  virtual ~TransferResponse();
+  IteratorItem();
+  virtual ~IteratorItem();
 
-  TransferResponse(const TransferResponse& from);
+  IteratorItem(const IteratorItem& from);
 
-  inline TransferResponse& operator=(const TransferResponse& from) {
+  inline IteratorItem& operator=(const IteratorItem& from) {
     CopyFrom(from);
     return *this;
   }
   #if LANG_CXX11
-  TransferResponse(TransferResponse&& from) noexcept
-    : TransferResponse() {
+  IteratorItem(IteratorItem&& from) noexcept
+    : IteratorItem() {
     *this = ::std::move(from);
   }
 
-  inline TransferResponse& operator=(TransferResponse&& from) noexcept {
+  inline IteratorItem& operator=(IteratorItem&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -1401,34 +1457,34 @@ class TransferResponse :
     return *this;
   }
   #endif
-  static const TransferResponse& default_instance();
+  static const IteratorItem& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const TransferResponse* internal_default_instance() {
-    return reinterpret_cast<const TransferResponse*>(
-               &_TransferResponse_default_instance_);
+  static inline const IteratorItem* internal_default_instance() {
+    return reinterpret_cast<const IteratorItem*>(
+               &_IteratorItem_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     10;
 
-  void Swap(TransferResponse* other);
-  friend void swap(TransferResponse& a, TransferResponse& b) {
+  void Swap(IteratorItem* other);
+  friend void swap(IteratorItem& a, IteratorItem& b) {
     a.Swap(&b);
   }
 
   // implements Message ----------------------------------------------
 
-  inline TransferResponse* New() const final {
-    return CreateMaybeMessage<TransferResponse>(nullptr);
+  inline IteratorItem* New() const final {
+    return CreateMaybeMessage<IteratorItem>(nullptr);
   }
 
-  TransferResponse* New(::google::protobuf::Arena* arena) const final {
-    return CreateMaybeMessage<TransferResponse>(arena);
+  IteratorItem* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<IteratorItem>(arena);
   }
   void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
     final;
-  void CopyFrom(const TransferResponse& from);
-  void MergeFrom(const TransferResponse& from);
+  void CopyFrom(const IteratorItem& from);
+  void MergeFrom(const IteratorItem& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -1449,7 +1505,7 @@ class TransferResponse :
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(TransferResponse* other);
+  void InternalSwap(IteratorItem* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return nullptr;
@@ -1465,35 +1521,65 @@ class TransferResponse :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:contract.TransferResponse)
+  // bytes key = 1;
+  void clear_key();
+  static const int kKeyFieldNumber = 1;
+  const ::std::string& key() const;
+  void set_key(const ::std::string& value);
+  #if LANG_CXX11
+  void set_key(::std::string&& value);
+  #endif
+  void set_key(const char* value);
+  void set_key(const void* value, size_t size);
+  ::std::string* mutable_key();
+  ::std::string* release_key();
+  void set_allocated_key(::std::string* key);
+
+  // bytes value = 2;
+  void clear_value();
+  static const int kValueFieldNumber = 2;
+  const ::std::string& value() const;
+  void set_value(const ::std::string& value);
+  #if LANG_CXX11
+  void set_value(::std::string&& value);
+  #endif
+  void set_value(const char* value);
+  void set_value(const void* value, size_t size);
+  ::std::string* mutable_value();
+  ::std::string* release_value();
+  void set_allocated_value(::std::string* value);
+
+  // @@protoc_insertion_point(class_scope:contract.IteratorItem)
  private:
   class HasBitSetters;
 
   ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr key_;
+  ::google::protobuf::internal::ArenaStringPtr value_;
   mutable ::google::protobuf::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_contract_2eproto;
 };
 // -------------------------------------------------------------------
 
-class ContractCallRequest :
-    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.ContractCallRequest) */ {
+class IteratorResponse :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.IteratorResponse) */ {
  public:
-  ContractCallRequest();
-  virtual ~ContractCallRequest();
+  IteratorResponse();
+  virtual ~IteratorResponse();
 
-  ContractCallRequest(const ContractCallRequest& from);
+  IteratorResponse(const IteratorResponse& from);
 
-  inline ContractCallRequest& operator=(const ContractCallRequest& from) {
+  inline IteratorResponse& operator=(const IteratorResponse& from) {
     CopyFrom(from);
     return *this;
   }
   #if LANG_CXX11
-  ContractCallRequest(ContractCallRequest&& from) noexcept
-    : ContractCallRequest() {
+  IteratorResponse(IteratorResponse&& from) noexcept
+    : IteratorResponse() {
     *this = ::std::move(from);
   }
 
-  inline ContractCallRequest& operator=(ContractCallRequest&& from) noexcept {
+  inline IteratorResponse& operator=(IteratorResponse&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -1502,34 +1588,34 @@ class ContractCallRequest :
     return *this;
   }
   #endif
-  static const ContractCallRequest& default_instance();
+  static const IteratorResponse& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const ContractCallRequest* internal_default_instance() {
-    return reinterpret_cast<const ContractCallRequest*>(
-               &_ContractCallRequest_default_instance_);
+  static inline const IteratorResponse* internal_default_instance() {
+    return reinterpret_cast<const IteratorResponse*>(
+               &_IteratorResponse_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     11;
 
-  void Swap(ContractCallRequest* other);
-  friend void swap(ContractCallRequest& a, ContractCallRequest& b) {
+  void Swap(IteratorResponse* other);
+  friend void swap(IteratorResponse& a, IteratorResponse& b) {
     a.Swap(&b);
   }
 
   // implements Message ----------------------------------------------
 
-  inline ContractCallRequest* New() const final {
-    return CreateMaybeMessage<ContractCallRequest>(nullptr);
+  inline IteratorResponse* New() const final {
+    return CreateMaybeMessage<IteratorResponse>(nullptr);
   }
 
-  ContractCallRequest* New(::google::protobuf::Arena* arena) const final {
-    return CreateMaybeMessage<ContractCallRequest>(arena);
+  IteratorResponse* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<IteratorResponse>(arena);
   }
   void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
     final;
-  void CopyFrom(const ContractCallRequest& from);
-  void MergeFrom(const ContractCallRequest& from);
+  void CopyFrom(const IteratorResponse& from);
+  void MergeFrom(const IteratorResponse& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -1550,7 +1636,7 @@ class ContractCallRequest :
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(ContractCallRequest* other);
+  void InternalSwap(IteratorResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return nullptr;
@@ -1566,103 +1652,48 @@ class ContractCallRequest :
 
   // accessors -------------------------------------------------------
 
-  // repeated .contract.ArgPair args = 5;
-  int args_size() const;
-  void clear_args();
-  static const int kArgsFieldNumber = 5;
-  ::contract::ArgPair* mutable_args(int index);
-  ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >*
-      mutable_args();
-  const ::contract::ArgPair& args(int index) const;
-  ::contract::ArgPair* add_args();
-  const ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >&
-      args() const;
-
-  // string module = 2;
-  void clear_module();
-  static const int kModuleFieldNumber = 2;
-  const ::std::string& module() const;
-  void set_module(const ::std::string& value);
-  #if LANG_CXX11
-  void set_module(::std::string&& value);
-  #endif
-  void set_module(const char* value);
-  void set_module(const char* value, size_t size);
-  ::std::string* mutable_module();
-  ::std::string* release_module();
-  void set_allocated_module(::std::string* module);
-
-  // string contract = 3;
-  void clear_contract();
-  static const int kContractFieldNumber = 3;
-  const ::std::string& contract() const;
-  void set_contract(const ::std::string& value);
-  #if LANG_CXX11
-  void set_contract(::std::string&& value);
-  #endif
-  void set_contract(const char* value);
-  void set_contract(const char* value, size_t size);
-  ::std::string* mutable_contract();
-  ::std::string* release_contract();
-  void set_allocated_contract(::std::string* contract);
-
-  // string method = 4;
-  void clear_method();
-  static const int kMethodFieldNumber = 4;
-  const ::std::string& method() const;
-  void set_method(const ::std::string& value);
-  #if LANG_CXX11
-  void set_method(::std::string&& value);
-  #endif
-  void set_method(const char* value);
-  void set_method(const char* value, size_t size);
-  ::std::string* mutable_method();
-  ::std::string* release_method();
-  void set_allocated_method(::std::string* method);
-
-  // .contract.SyscallHeader header = 1;
-  bool has_header() const;
-  void clear_header();
-  static const int kHeaderFieldNumber = 1;
-  const ::contract::SyscallHeader& header() const;
-  ::contract::SyscallHeader* release_header();
-  ::contract::SyscallHeader* mutable_header();
-  void set_allocated_header(::contract::SyscallHeader* header);
-
-  // @@protoc_insertion_point(class_scope:contract.ContractCallRequest)
+  // repeated .contract.IteratorItem items = 1;
+  int items_size() const;
+  void clear_items();
+  static const int kItemsFieldNumber = 1;
+  ::contract::IteratorItem* mutable_items(int index);
+  ::google::protobuf::RepeatedPtrField< ::contract::IteratorItem >*
+      mutable_items();
+  const ::contract::IteratorItem& items(int index) const;
+  ::contract::IteratorItem* add_items();
+  const ::google::protobuf::RepeatedPtrField< ::contract::IteratorItem >&
+      items() const;
+
+  // @@protoc_insertion_point(class_scope:contract.IteratorResponse)
  private:
   class HasBitSetters;
 
   ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
-  ::google::protobuf::RepeatedPtrField< ::contract::ArgPair > args_;
-  ::google::protobuf::internal::ArenaStringPtr module_;
-  ::google::protobuf::internal::ArenaStringPtr contract_;
-  ::google::protobuf::internal::ArenaStringPtr method_;
-  ::contract::SyscallHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::contract::IteratorItem > items_;
   mutable ::google::protobuf::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_contract_2eproto;
 };
 // -------------------------------------------------------------------
 
-class ContractCallResponse :
-    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.ContractCallResponse) */ {
+class TransferRequest :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.TransferRequest) */ {
  public:
-  ContractCallResponse();
-  virtual ~ContractCallResponse();
+  TransferRequest();
+  virtual ~TransferRequest();
 
-  ContractCallResponse(const ContractCallResponse& from);
+  TransferRequest(const TransferRequest& from);
 
-  inline ContractCallResponse& operator=(const ContractCallResponse& from) {
+  inline TransferRequest& operator=(const TransferRequest& from) {
     CopyFrom(from);
     return *this;
   }
   #if LANG_CXX11
-  ContractCallResponse(ContractCallResponse&& from) noexcept
-    : ContractCallResponse() {
+  TransferRequest(TransferRequest&& from) noexcept
+    : TransferRequest() {
     *this = ::std::move(from);
   }
 
-  inline ContractCallResponse& operator=(ContractCallResponse&& from) noexcept {
+  inline TransferRequest& operator=(TransferRequest&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -1671,34 +1702,34 @@ class ContractCallResponse :
     return *this;
   }
   #endif
-  static const ContractCallResponse& default_instance();
+  static const TransferRequest& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const ContractCallResponse* internal_default_instance() {
-    return reinterpret_cast<const ContractCallResponse*>(
-               &_ContractCallResponse_default_instance_);
+  static inline const TransferRequest* internal_default_instance() {
+    return reinterpret_cast<const TransferRequest*>(
+               &_TransferRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     12;
 
-  void Swap(ContractCallResponse* other);
-  friend void swap(ContractCallResponse& a, ContractCallResponse& b) {
+  void Swap(TransferRequest* other);
+  friend void swap(TransferRequest& a, TransferRequest& b) {
     a.Swap(&b);
   }
 
   // implements Message ----------------------------------------------
 
-  inline ContractCallResponse* New() const final {
-    return CreateMaybeMessage<ContractCallResponse>(nullptr);
+  inline TransferRequest* New() const final {
+    return CreateMaybeMessage<TransferRequest>(nullptr);
   }
 
-  ContractCallResponse* New(::google::protobuf::Arena* arena) const final {
-    return CreateMaybeMessage<ContractCallResponse>(arena);
+  TransferRequest* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<TransferRequest>(arena);
   }
   void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
     final;
-  void CopyFrom(const ContractCallResponse& from);
-  void MergeFrom(const ContractCallResponse& from);
+  void CopyFrom(const TransferRequest& from);
+  void MergeFrom(const TransferRequest& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -1719,7 +1750,7 @@ class ContractCallResponse :
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(ContractCallResponse* other);
+  void InternalSwap(TransferRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return nullptr;
@@ -1735,45 +1766,90 @@ class ContractCallResponse :
 
   // accessors -------------------------------------------------------
 
-  // .contract.Response response = 1;
-  bool has_response() const;
-  void clear_response();
-  static const int kResponseFieldNumber = 1;
-  const ::contract::Response& response() const;
-  ::contract::Response* release_response();
-  ::contract::Response* mutable_response();
-  void set_allocated_response(::contract::Response* response);
+  // string from = 2;
+  void clear_from();
+  static const int kFromFieldNumber = 2;
+  const ::std::string& from() const;
+  void set_from(const ::std::string& value);
+  #if LANG_CXX11
+  void set_from(::std::string&& value);
+  #endif
+  void set_from(const char* value);
+  void set_from(const char* value, size_t size);
+  ::std::string* mutable_from();
+  ::std::string* release_from();
+  void set_allocated_from(::std::string* from);
 
-  // @@protoc_insertion_point(class_scope:contract.ContractCallResponse)
+  // string to = 3;
+  void clear_to();
+  static const int kToFieldNumber = 3;
+  const ::std::string& to() const;
+  void set_to(const ::std::string& value);
+  #if LANG_CXX11
+  void set_to(::std::string&& value);
+  #endif
+  void set_to(const char* value);
+  void set_to(const char* value, size_t size);
+  ::std::string* mutable_to();
+  ::std::string* release_to();
+  void set_allocated_to(::std::string* to);
+
+  // string amount = 4;
+  void clear_amount();
+  static const int kAmountFieldNumber = 4;
+  const ::std::string& amount() const;
+  void set_amount(const ::std::string& value);
+  #if LANG_CXX11
+  void set_amount(::std::string&& value);
+  #endif
+  void set_amount(const char* value);
+  void set_amount(const char* value, size_t size);
+  ::std::string* mutable_amount();
+  ::std::string* release_amount();
+  void set_allocated_amount(::std::string* amount);
+
+  // .contract.SyscallHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::contract::SyscallHeader& header() const;
+  ::contract::SyscallHeader* release_header();
+  ::contract::SyscallHeader* mutable_header();
+  void set_allocated_header(::contract::SyscallHeader* header);
+
+  // @@protoc_insertion_point(class_scope:contract.TransferRequest)
  private:
   class HasBitSetters;
 
   ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
-  ::contract::Response* response_;
+  ::google::protobuf::internal::ArenaStringPtr from_;
+  ::google::protobuf::internal::ArenaStringPtr to_;
+  ::google::protobuf::internal::ArenaStringPtr amount_;
+  ::contract::SyscallHeader* header_;
   mutable ::google::protobuf::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_contract_2eproto;
 };
 // -------------------------------------------------------------------
 
-class Response :
-    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.Response) */ {
+class TransferResponse :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.TransferResponse) */ {
  public:
-  Response();
-  virtual ~Response();
+  TransferResponse();
+  virtual ~TransferResponse();
 
-  Response(const Response& from);
+  TransferResponse(const TransferResponse& from);
 
-  inline Response& operator=(const Response& from) {
+  inline TransferResponse& operator=(const TransferResponse& from) {
     CopyFrom(from);
     return *this;
   }
   #if LANG_CXX11
-  Response(Response&& from) noexcept
-    : Response() {
+  TransferResponse(TransferResponse&& from) noexcept
+    : TransferResponse() {
     *this = ::std::move(from);
   }
 
-  inline Response& operator=(Response&& from) noexcept {
+  inline TransferResponse& operator=(TransferResponse&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -1782,34 +1858,34 @@ class Response :
     return *this;
   }
   #endif
-  static const Response& default_instance();
+  static const TransferResponse& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const Response* internal_default_instance() {
-    return reinterpret_cast<const Response*>(
-               &_Response_default_instance_);
+  static inline const TransferResponse* internal_default_instance() {
+    return reinterpret_cast<const TransferResponse*>(
+               &_TransferResponse_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     13;
 
-  void Swap(Response* other);
-  friend void swap(Response& a, Response& b) {
+  void Swap(TransferResponse* other);
+  friend void swap(TransferResponse& a, TransferResponse& b) {
     a.Swap(&b);
   }
 
   // implements Message ----------------------------------------------
 
-  inline Response* New() const final {
-    return CreateMaybeMessage<Response>(nullptr);
+  inline TransferResponse* New() const final {
+    return CreateMaybeMessage<TransferResponse>(nullptr);
   }
 
-  Response* New(::google::protobuf::Arena* arena) const final {
-    return CreateMaybeMessage<Response>(arena);
+  TransferResponse* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<TransferResponse>(arena);
   }
   void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
     final;
-  void CopyFrom(const Response& from);
-  void MergeFrom(const Response& from);
+  void CopyFrom(const TransferResponse& from);
+  void MergeFrom(const TransferResponse& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -1830,7 +1906,7 @@ class Response :
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(Response* other);
+  void InternalSwap(TransferResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return nullptr;
@@ -1846,72 +1922,35 @@ class Response :
 
   // accessors -------------------------------------------------------
 
-  // string message = 2;
-  void clear_message();
-  static const int kMessageFieldNumber = 2;
-  const ::std::string& message() const;
-  void set_message(const ::std::string& value);
-  #if LANG_CXX11
-  void set_message(::std::string&& value);
-  #endif
-  void set_message(const char* value);
-  void set_message(const char* value, size_t size);
-  ::std::string* mutable_message();
-  ::std::string* release_message();
-  void set_allocated_message(::std::string* message);
-
-  // bytes body = 3;
-  void clear_body();
-  static const int kBodyFieldNumber = 3;
-  const ::std::string& body() const;
-  void set_body(const ::std::string& value);
-  #if LANG_CXX11
-  void set_body(::std::string&& value);
-  #endif
-  void set_body(const char* value);
-  void set_body(const void* value, size_t size);
-  ::std::string* mutable_body();
-  ::std::string* release_body();
-  void set_allocated_body(::std::string* body);
-
-  // int32 status = 1;
-  void clear_status();
-  static const int kStatusFieldNumber = 1;
-  ::google::protobuf::int32 status() const;
-  void set_status(::google::protobuf::int32 value);
-
-  // @@protoc_insertion_point(class_scope:contract.Response)
+  // @@protoc_insertion_point(class_scope:contract.TransferResponse)
  private:
   class HasBitSetters;
 
   ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
-  ::google::protobuf::internal::ArenaStringPtr message_;
-  ::google::protobuf::internal::ArenaStringPtr body_;
-  ::google::protobuf::int32 status_;
   mutable ::google::protobuf::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_contract_2eproto;
 };
 // -------------------------------------------------------------------
 
-class SetOutputRequest :
-    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.SetOutputRequest) */ {
+class ContractEvent :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.ContractEvent) */ {
  public:
-  SetOutputRequest();
-  virtual ~SetOutputRequest();
+  ContractEvent();
+  virtual ~ContractEvent();
 
-  SetOutputRequest(const SetOutputRequest& from);
+  ContractEvent(const ContractEvent& from);
 
-  inline SetOutputRequest& operator=(const SetOutputRequest& from) {
+  inline ContractEvent& operator=(const ContractEvent& from) {
     CopyFrom(from);
     return *this;
   }
   #if LANG_CXX11
-  SetOutputRequest(SetOutputRequest&& from) noexcept
-    : SetOutputRequest() {
+  ContractEvent(ContractEvent&& from) noexcept
+    : ContractEvent() {
     *this = ::std::move(from);
   }
 
-  inline SetOutputRequest& operator=(SetOutputRequest&& from) noexcept {
+  inline ContractEvent& operator=(ContractEvent&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -1920,34 +1959,34 @@ class SetOutputRequest :
     return *this;
   }
   #endif
-  static const SetOutputRequest& default_instance();
+  static const ContractEvent& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const SetOutputRequest* internal_default_instance() {
-    return reinterpret_cast<const SetOutputRequest*>(
-               &_SetOutputRequest_default_instance_);
+  static inline const ContractEvent* internal_default_instance() {
+    return reinterpret_cast<const ContractEvent*>(
+               &_ContractEvent_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     14;
 
-  void Swap(SetOutputRequest* other);
-  friend void swap(SetOutputRequest& a, SetOutputRequest& b) {
+  void Swap(ContractEvent* other);
+  friend void swap(ContractEvent& a, ContractEvent& b) {
     a.Swap(&b);
   }
 
   // implements Message ----------------------------------------------
 
-  inline SetOutputRequest* New() const final {
-    return CreateMaybeMessage<SetOutputRequest>(nullptr);
+  inline ContractEvent* New() const final {
+    return CreateMaybeMessage<ContractEvent>(nullptr);
   }
 
-  SetOutputRequest* New(::google::protobuf::Arena* arena) const final {
-    return CreateMaybeMessage<SetOutputRequest>(arena);
+  ContractEvent* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<ContractEvent>(arena);
   }
   void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
     final;
-  void CopyFrom(const SetOutputRequest& from);
-  void MergeFrom(const SetOutputRequest& from);
+  void CopyFrom(const ContractEvent& from);
+  void MergeFrom(const ContractEvent& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -1968,7 +2007,7 @@ class SetOutputRequest :
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(SetOutputRequest* other);
+  void InternalSwap(ContractEvent* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return nullptr;
@@ -1984,55 +2023,80 @@ class SetOutputRequest :
 
   // accessors -------------------------------------------------------
 
-  // .contract.SyscallHeader header = 1;
-  bool has_header() const;
-  void clear_header();
-  static const int kHeaderFieldNumber = 1;
-  const ::contract::SyscallHeader& header() const;
-  ::contract::SyscallHeader* release_header();
-  ::contract::SyscallHeader* mutable_header();
-  void set_allocated_header(::contract::SyscallHeader* header);
+  // string contract = 1;
+  void clear_contract();
+  static const int kContractFieldNumber = 1;
+  const ::std::string& contract() const;
+  void set_contract(const ::std::string& value);
+  #if LANG_CXX11
+  void set_contract(::std::string&& value);
+  #endif
+  void set_contract(const char* value);
+  void set_contract(const char* value, size_t size);
+  ::std::string* mutable_contract();
+  ::std::string* release_contract();
+  void set_allocated_contract(::std::string* contract);
 
-  // .contract.Response response = 2;
-  bool has_response() const;
-  void clear_response();
-  static const int kResponseFieldNumber = 2;
-  const ::contract::Response& response() const;
-  ::contract::Response* release_response();
-  ::contract::Response* mutable_response();
-  void set_allocated_response(::contract::Response* response);
+  // string name = 2;
+  void clear_name();
+  static const int kNameFieldNumber = 2;
+  const ::std::string& name() const;
+  void set_name(const ::std::string& value);
+  #if LANG_CXX11
+  void set_name(::std::string&& value);
+  #endif
+  void set_name(const char* value);
+  void set_name(const char* value, size_t size);
+  ::std::string* mutable_name();
+  ::std::string* release_name();
+  void set_allocated_name(::std::string* name);
 
-  // @@protoc_insertion_point(class_scope:contract.SetOutputRequest)
+  // bytes body = 3;
+  void clear_body();
+  static const int kBodyFieldNumber = 3;
+  const ::std::string& body() const;
+  void set_body(const ::std::string& value);
+  #if LANG_CXX11
+  void set_body(::std::string&& value);
+  #endif
+  void set_body(const char* value);
+  void set_body(const void* value, size_t size);
+  ::std::string* mutable_body();
+  ::std::string* release_body();
+  void set_allocated_body(::std::string* body);
+
+  // @@protoc_insertion_point(class_scope:contract.ContractEvent)
  private:
   class HasBitSetters;
 
   ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
-  ::contract::SyscallHeader* header_;
-  ::contract::Response* response_;
+  ::google::protobuf::internal::ArenaStringPtr contract_;
+  ::google::protobuf::internal::ArenaStringPtr name_;
+  ::google::protobuf::internal::ArenaStringPtr body_;
   mutable ::google::protobuf::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_contract_2eproto;
 };
 // -------------------------------------------------------------------
 
-class SetOutputResponse :
-    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.SetOutputResponse) */ {
+class EmitEventRequest :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.EmitEventRequest) */ {
  public:
-  SetOutputResponse();
-  virtual ~SetOutputResponse();
+  EmitEventRequest();
+  virtual ~EmitEventRequest();
 
-  SetOutputResponse(const SetOutputResponse& from);
+  EmitEventRequest(const EmitEventRequest& from);
 
-  inline SetOutputResponse& operator=(const SetOutputResponse& from) {
+  inline EmitEventRequest& operator=(const EmitEventRequest& from) {
     CopyFrom(from);
     return *this;
   }
   #if LANG_CXX11
-  SetOutputResponse(SetOutputResponse&& from) noexcept
-    : SetOutputResponse() {
+  EmitEventRequest(EmitEventRequest&& from) noexcept
+    : EmitEventRequest() {
     *this = ::std::move(from);
   }
 
-  inline SetOutputResponse& operator=(SetOutputResponse&& from) noexcept {
+  inline EmitEventRequest& operator=(EmitEventRequest&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -2041,34 +2105,34 @@ class SetOutputResponse :
     return *this;
   }
   #endif
-  static const SetOutputResponse& default_instance();
+  static const EmitEventRequest& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const SetOutputResponse* internal_default_instance() {
-    return reinterpret_cast<const SetOutputResponse*>(
-               &_SetOutputResponse_default_instance_);
+  static inline const EmitEventRequest* internal_default_instance() {
+    return reinterpret_cast<const EmitEventRequest*>(
+               &_EmitEventRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     15;
 
-  void Swap(SetOutputResponse* other);
-  friend void swap(SetOutputResponse& a, SetOutputResponse& b) {
+  void Swap(EmitEventRequest* other);
+  friend void swap(EmitEventRequest& a, EmitEventRequest& b) {
     a.Swap(&b);
   }
 
   // implements Message ----------------------------------------------
 
-  inline SetOutputResponse* New() const final {
-    return CreateMaybeMessage<SetOutputResponse>(nullptr);
+  inline EmitEventRequest* New() const final {
+    return CreateMaybeMessage<EmitEventRequest>(nullptr);
   }
 
-  SetOutputResponse* New(::google::protobuf::Arena* arena) const final {
-    return CreateMaybeMessage<SetOutputResponse>(arena);
+  EmitEventRequest* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<EmitEventRequest>(arena);
   }
   void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
     final;
-  void CopyFrom(const SetOutputResponse& from);
-  void MergeFrom(const SetOutputResponse& from);
+  void CopyFrom(const EmitEventRequest& from);
+  void MergeFrom(const EmitEventRequest& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -2089,7 +2153,7 @@ class SetOutputResponse :
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(SetOutputResponse* other);
+  void InternalSwap(EmitEventRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return nullptr;
@@ -2105,35 +2169,75 @@ class SetOutputResponse :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:contract.SetOutputResponse)
- private:
-  class HasBitSetters;
-
-  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
-  mutable ::google::protobuf::internal::CachedSize _cached_size_;
-  friend struct ::TableStruct_contract_2eproto;
-};
-// -------------------------------------------------------------------
-
-class GetCallArgsRequest :
-    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.GetCallArgsRequest) */ {
- public:
-  GetCallArgsRequest();
-  virtual ~GetCallArgsRequest();
-
-  GetCallArgsRequest(const GetCallArgsRequest& from);
+  // string name = 2;
+  void clear_name();
+  static const int kNameFieldNumber = 2;
+  const ::std::string& name() const;
+  void set_name(const ::std::string& value);
+  #if LANG_CXX11
+  void set_name(::std::string&& value);
+  #endif
+  void set_name(const char* value);
+  void set_name(const char* value, size_t size);
+  ::std::string* mutable_name();
+  ::std::string* release_name();
+  void set_allocated_name(::std::string* name);
 
-  inline GetCallArgsRequest& operator=(const GetCallArgsRequest& from) {
-    CopyFrom(from);
+  // bytes body = 3;
+  void clear_body();
+  static const int kBodyFieldNumber = 3;
+  const ::std::string& body() const;
+  void set_body(const ::std::string& value);
+  #if LANG_CXX11
+  void set_body(::std::string&& value);
+  #endif
+  void set_body(const char* value);
+  void set_body(const void* value, size_t size);
+  ::std::string* mutable_body();
+  ::std::string* release_body();
+  void set_allocated_body(::std::string* body);
+
+  // .contract.SyscallHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::contract::SyscallHeader& header() const;
+  ::contract::SyscallHeader* release_header();
+  ::contract::SyscallHeader* mutable_header();
+  void set_allocated_header(::contract::SyscallHeader* header);
+
+  // @@protoc_insertion_point(class_scope:contract.EmitEventRequest)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr name_;
+  ::google::protobuf::internal::ArenaStringPtr body_;
+  ::contract::SyscallHeader* header_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class EmitEventResponse :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.EmitEventResponse) */ {
+ public:
+  EmitEventResponse();
+  virtual ~EmitEventResponse();
+
+  EmitEventResponse(const EmitEventResponse& from);
+
+  inline EmitEventResponse& operator=(const EmitEventResponse& from) {
+    CopyFrom(from);
     return *this;
   }
   #if LANG_CXX11
-  GetCallArgsRequest(GetCallArgsRequest&& from) noexcept
-    : GetCallArgsRequest() {
+  EmitEventResponse(EmitEventResponse&& from) noexcept
+    : EmitEventResponse() {
     *this = ::std::move(from);
   }
 
-  inline GetCallArgsRequest& operator=(GetCallArgsRequest&& from) noexcept {
+  inline EmitEventResponse& operator=(EmitEventResponse&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -2142,34 +2246,34 @@ class GetCallArgsRequest :
     return *this;
   }
   #endif
-  static const GetCallArgsRequest& default_instance();
+  static const EmitEventResponse& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const GetCallArgsRequest* internal_default_instance() {
-    return reinterpret_cast<const GetCallArgsRequest*>(
-               &_GetCallArgsRequest_default_instance_);
+  static inline const EmitEventResponse* internal_default_instance() {
+    return reinterpret_cast<const EmitEventResponse*>(
+               &_EmitEventResponse_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     16;
 
-  void Swap(GetCallArgsRequest* other);
-  friend void swap(GetCallArgsRequest& a, GetCallArgsRequest& b) {
+  void Swap(EmitEventResponse* other);
+  friend void swap(EmitEventResponse& a, EmitEventResponse& b) {
     a.Swap(&b);
   }
 
   // implements Message ----------------------------------------------
 
-  inline GetCallArgsRequest* New() const final {
-    return CreateMaybeMessage<GetCallArgsRequest>(nullptr);
+  inline EmitEventResponse* New() const final {
+    return CreateMaybeMessage<EmitEventResponse>(nullptr);
   }
 
-  GetCallArgsRequest* New(::google::protobuf::Arena* arena) const final {
-    return CreateMaybeMessage<GetCallArgsRequest>(arena);
+  EmitEventResponse* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<EmitEventResponse>(arena);
   }
   void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
     final;
-  void CopyFrom(const GetCallArgsRequest& from);
-  void MergeFrom(const GetCallArgsRequest& from);
+  void CopyFrom(const EmitEventResponse& from);
+  void MergeFrom(const EmitEventResponse& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -2190,7 +2294,108 @@ class GetCallArgsRequest :
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(GetCallArgsRequest* other);
+  void InternalSwap(EmitEventResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:contract.EmitEventResponse)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class ContractCallRequest :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.ContractCallRequest) */ {
+ public:
+  ContractCallRequest();
+  virtual ~ContractCallRequest();
+
+  ContractCallRequest(const ContractCallRequest& from);
+
+  inline ContractCallRequest& operator=(const ContractCallRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  ContractCallRequest(ContractCallRequest&& from) noexcept
+    : ContractCallRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline ContractCallRequest& operator=(ContractCallRequest&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ContractCallRequest& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const ContractCallRequest* internal_default_instance() {
+    return reinterpret_cast<const ContractCallRequest*>(
+               &_ContractCallRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    17;
+
+  void Swap(ContractCallRequest* other);
+  friend void swap(ContractCallRequest& a, ContractCallRequest& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline ContractCallRequest* New() const final {
+    return CreateMaybeMessage<ContractCallRequest>(nullptr);
+  }
+
+  ContractCallRequest* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<ContractCallRequest>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const ContractCallRequest& from);
+  void MergeFrom(const ContractCallRequest& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ContractCallRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return nullptr;
@@ -2206,6 +2411,60 @@ class GetCallArgsRequest :
 
   // accessors -------------------------------------------------------
 
+  // repeated .contract.ArgPair args = 5;
+  int args_size() const;
+  void clear_args();
+  static const int kArgsFieldNumber = 5;
+  ::contract::ArgPair* mutable_args(int index);
+  ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >*
+      mutable_args();
+  const ::contract::ArgPair& args(int index) const;
+  ::contract::ArgPair* add_args();
+  const ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >&
+      args() const;
+
+  // string module = 2;
+  void clear_module();
+  static const int kModuleFieldNumber = 2;
+  const ::std::string& module() const;
+  void set_module(const ::std::string& value);
+  #if LANG_CXX11
+  void set_module(::std::string&& value);
+  #endif
+  void set_module(const char* value);
+  void set_module(const char* value, size_t size);
+  ::std::string* mutable_module();
+  ::std::string* release_module();
+  void set_allocated_module(::std::string* module);
+
+  // string contract = 3;
+  void clear_contract();
+  static const int kContractFieldNumber = 3;
+  const ::std::string& contract() const;
+  void set_contract(const ::std::string& value);
+  #if LANG_CXX11
+  void set_contract(::std::string&& value);
+  #endif
+  void set_contract(const char* value);
+  void set_contract(const char* value, size_t size);
+  ::std::string* mutable_contract();
+  ::std::string* release_contract();
+  void set_allocated_contract(::std::string* contract);
+
+  // string method = 4;
+  void clear_method();
+  static const int kMethodFieldNumber = 4;
+  const ::std::string& method() const;
+  void set_method(const ::std::string& value);
+  #if LANG_CXX11
+  void set_method(::std::string&& value);
+  #endif
+  void set_method(const char* value);
+  void set_method(const char* value, size_t size);
+  ::std::string* mutable_method();
+  ::std::string* release_method();
+  void set_allocated_method(::std::string* method);
+
   // .contract.SyscallHeader header = 1;
   bool has_header() const;
   void clear_header();
@@ -2215,327 +2474,4192 @@ class GetCallArgsRequest :
   ::contract::SyscallHeader* mutable_header();
   void set_allocated_header(::contract::SyscallHeader* header);
 
-  // @@protoc_insertion_point(class_scope:contract.GetCallArgsRequest)
+  // @@protoc_insertion_point(class_scope:contract.ContractCallRequest)
  private:
   class HasBitSetters;
 
   ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::RepeatedPtrField< ::contract::ArgPair > args_;
+  ::google::protobuf::internal::ArenaStringPtr module_;
+  ::google::protobuf::internal::ArenaStringPtr contract_;
+  ::google::protobuf::internal::ArenaStringPtr method_;
   ::contract::SyscallHeader* header_;
   mutable ::google::protobuf::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_contract_2eproto;
 };
-// ===================================================================
+// -------------------------------------------------------------------
 
+class ContractCallResponse :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.ContractCallResponse) */ {
+ public:
+  ContractCallResponse();
+  virtual ~ContractCallResponse();
 
-// ===================================================================
+  ContractCallResponse(const ContractCallResponse& from);
 
-#ifdef __GNUC__
-  #pragma GCC diagnostic push
-  #pragma GCC diagnostic ignored "-Wstrict-aliasing"
-#endif  // __GNUC__
-// ArgPair
+  inline ContractCallResponse& operator=(const ContractCallResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  ContractCallResponse(ContractCallResponse&& from) noexcept
+    : ContractCallResponse() {
+    *this = ::std::move(from);
+  }
 
-// string key = 1;
-inline void ArgPair::clear_key() {
-  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-}
-inline const ::std::string& ArgPair::key() const {
-  // @@protoc_insertion_point(field_get:contract.ArgPair.key)
-  return key_.GetNoArena();
-}
-inline void ArgPair::set_key(const ::std::string& value) {
-  
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.ArgPair.key)
-}
-#if LANG_CXX11
-inline void ArgPair::set_key(::std::string&& value) {
-  
-  key_.SetNoArena(
-    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.ArgPair.key)
-}
+  inline ContractCallResponse& operator=(ContractCallResponse&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ContractCallResponse& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const ContractCallResponse* internal_default_instance() {
+    return reinterpret_cast<const ContractCallResponse*>(
+               &_ContractCallResponse_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    18;
+
+  void Swap(ContractCallResponse* other);
+  friend void swap(ContractCallResponse& a, ContractCallResponse& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline ContractCallResponse* New() const final {
+    return CreateMaybeMessage<ContractCallResponse>(nullptr);
+  }
+
+  ContractCallResponse* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<ContractCallResponse>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const ContractCallResponse& from);
+  void MergeFrom(const ContractCallResponse& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ContractCallResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // .contract.Response response = 1;
+  bool has_response() const;
+  void clear_response();
+  static const int kResponseFieldNumber = 1;
+  const ::contract::Response& response() const;
+  ::contract::Response* release_response();
+  ::contract::Response* mutable_response();
+  void set_allocated_response(::contract::Response* response);
+
+  // @@protoc_insertion_point(class_scope:contract.ContractCallResponse)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::contract::Response* response_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class Response :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.Response) */ {
+ public:
+  Response();
+  virtual ~Response();
+
+  Response(const Response& from);
+
+  inline Response& operator=(const Response& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  Response(Response&& from) noexcept
+    : Response() {
+    *this = ::std::move(from);
+  }
+
+  inline Response& operator=(Response&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const Response& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const Response* internal_default_instance() {
+    return reinterpret_cast<const Response*>(
+               &_Response_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    19;
+
+  void Swap(Response* other);
+  friend void swap(Response& a, Response& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline Response* New() const final {
+    return CreateMaybeMessage<Response>(nullptr);
+  }
+
+  Response* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<Response>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const Response& from);
+  void MergeFrom(const Response& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(Response* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // string message = 2;
+  void clear_message();
+  static const int kMessageFieldNumber = 2;
+  const ::std::string& message() const;
+  void set_message(const ::std::string& value);
+  #if LANG_CXX11
+  void set_message(::std::string&& value);
+  #endif
+  void set_message(const char* value);
+  void set_message(const char* value, size_t size);
+  ::std::string* mutable_message();
+  ::std::string* release_message();
+  void set_allocated_message(::std::string* message);
+
+  // bytes body = 3;
+  void clear_body();
+  static const int kBodyFieldNumber = 3;
+  const ::std::string& body() const;
+  void set_body(const ::std::string& value);
+  #if LANG_CXX11
+  void set_body(::std::string&& value);
+  #endif
+  void set_body(const char* value);
+  void set_body(const void* value, size_t size);
+  ::std::string* mutable_body();
+  ::std::string* release_body();
+  void set_allocated_body(::std::string* body);
+
+  // int32 status = 1;
+  void clear_status();
+  static const int kStatusFieldNumber = 1;
+  ::google::protobuf::int32 status() const;
+  void set_status(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:contract.Response)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr message_;
+  ::google::protobuf::internal::ArenaStringPtr body_;
+  ::google::protobuf::int32 status_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class SetOutputRequest :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.SetOutputRequest) */ {
+ public:
+  SetOutputRequest();
+  virtual ~SetOutputRequest();
+
+  SetOutputRequest(const SetOutputRequest& from);
+
+  inline SetOutputRequest& operator=(const SetOutputRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  SetOutputRequest(SetOutputRequest&& from) noexcept
+    : SetOutputRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline SetOutputRequest& operator=(SetOutputRequest&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const SetOutputRequest& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const SetOutputRequest* internal_default_instance() {
+    return reinterpret_cast<const SetOutputRequest*>(
+               &_SetOutputRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    20;
+
+  void Swap(SetOutputRequest* other);
+  friend void swap(SetOutputRequest& a, SetOutputRequest& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline SetOutputRequest* New() const final {
+    return CreateMaybeMessage<SetOutputRequest>(nullptr);
+  }
+
+  SetOutputRequest* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<SetOutputRequest>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const SetOutputRequest& from);
+  void MergeFrom(const SetOutputRequest& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(SetOutputRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // .contract.SyscallHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::contract::SyscallHeader& header() const;
+  ::contract::SyscallHeader* release_header();
+  ::contract::SyscallHeader* mutable_header();
+  void set_allocated_header(::contract::SyscallHeader* header);
+
+  // .contract.Response response = 2;
+  bool has_response() const;
+  void clear_response();
+  static const int kResponseFieldNumber = 2;
+  const ::contract::Response& response() const;
+  ::contract::Response* release_response();
+  ::contract::Response* mutable_response();
+  void set_allocated_response(::contract::Response* response);
+
+  // @@protoc_insertion_point(class_scope:contract.SetOutputRequest)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::contract::SyscallHeader* header_;
+  ::contract::Response* response_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class SetOutputResponse :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.SetOutputResponse) */ {
+ public:
+  SetOutputResponse();
+  virtual ~SetOutputResponse();
+
+  SetOutputResponse(const SetOutputResponse& from);
+
+  inline SetOutputResponse& operator=(const SetOutputResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  SetOutputResponse(SetOutputResponse&& from) noexcept
+    : SetOutputResponse() {
+    *this = ::std::move(from);
+  }
+
+  inline SetOutputResponse& operator=(SetOutputResponse&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const SetOutputResponse& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const SetOutputResponse* internal_default_instance() {
+    return reinterpret_cast<const SetOutputResponse*>(
+               &_SetOutputResponse_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    21;
+
+  void Swap(SetOutputResponse* other);
+  friend void swap(SetOutputResponse& a, SetOutputResponse& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline SetOutputResponse* New() const final {
+    return CreateMaybeMessage<SetOutputResponse>(nullptr);
+  }
+
+  SetOutputResponse* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<SetOutputResponse>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const SetOutputResponse& from);
+  void MergeFrom(const SetOutputResponse& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(SetOutputResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:contract.SetOutputResponse)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetCallArgsRequest :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.GetCallArgsRequest) */ {
+ public:
+  GetCallArgsRequest();
+  virtual ~GetCallArgsRequest();
+
+  GetCallArgsRequest(const GetCallArgsRequest& from);
+
+  inline GetCallArgsRequest& operator=(const GetCallArgsRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  GetCallArgsRequest(GetCallArgsRequest&& from) noexcept
+    : GetCallArgsRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline GetCallArgsRequest& operator=(GetCallArgsRequest&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const GetCallArgsRequest& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const GetCallArgsRequest* internal_default_instance() {
+    return reinterpret_cast<const GetCallArgsRequest*>(
+               &_GetCallArgsRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    22;
+
+  void Swap(GetCallArgsRequest* other);
+  friend void swap(GetCallArgsRequest& a, GetCallArgsRequest& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline GetCallArgsRequest* New() const final {
+    return CreateMaybeMessage<GetCallArgsRequest>(nullptr);
+  }
+
+  GetCallArgsRequest* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<GetCallArgsRequest>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const GetCallArgsRequest& from);
+  void MergeFrom(const GetCallArgsRequest& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(GetCallArgsRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // .contract.SyscallHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::contract::SyscallHeader& header() const;
+  ::contract::SyscallHeader* release_header();
+  ::contract::SyscallHeader* mutable_header();
+  void set_allocated_header(::contract::SyscallHeader* header);
+
+  // @@protoc_insertion_point(class_scope:contract.GetCallArgsRequest)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::contract::SyscallHeader* header_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class Environment :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.Environment) */ {
+ public:
+  Environment();
+  virtual ~Environment();
+
+  Environment(const Environment& from);
+
+  inline Environment& operator=(const Environment& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  Environment(Environment&& from) noexcept
+    : Environment() {
+    *this = ::std::move(from);
+  }
+
+  inline Environment& operator=(Environment&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const Environment& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const Environment* internal_default_instance() {
+    return reinterpret_cast<const Environment*>(
+               &_Environment_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    23;
+
+  void Swap(Environment* other);
+  friend void swap(Environment& a, Environment& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline Environment* New() const final {
+    return CreateMaybeMessage<Environment>(nullptr);
+  }
+
+  Environment* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<Environment>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const Environment& from);
+  void MergeFrom(const Environment& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(Environment* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // string txid = 3;
+  void clear_txid();
+  static const int kTxidFieldNumber = 3;
+  const ::std::string& txid() const;
+  void set_txid(const ::std::string& value);
+  #if LANG_CXX11
+  void set_txid(::std::string&& value);
+  #endif
+  void set_txid(const char* value);
+  void set_txid(const char* value, size_t size);
+  ::std::string* mutable_txid();
+  ::std::string* release_txid();
+  void set_allocated_txid(::std::string* txid);
+
+  // string initiator = 4;
+  void clear_initiator();
+  static const int kInitiatorFieldNumber = 4;
+  const ::std::string& initiator() const;
+  void set_initiator(const ::std::string& value);
+  #if LANG_CXX11
+  void set_initiator(::std::string&& value);
+  #endif
+  void set_initiator(const char* value);
+  void set_initiator(const char* value, size_t size);
+  ::std::string* mutable_initiator();
+  ::std::string* release_initiator();
+  void set_allocated_initiator(::std::string* initiator);
+
+  // int64 height = 1;
+  void clear_height();
+  static const int kHeightFieldNumber = 1;
+  ::google::protobuf::int64 height() const;
+  void set_height(::google::protobuf::int64 value);
+
+  // int64 timestamp = 2;
+  void clear_timestamp();
+  static const int kTimestampFieldNumber = 2;
+  ::google::protobuf::int64 timestamp() const;
+  void set_timestamp(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:contract.Environment)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr txid_;
+  ::google::protobuf::internal::ArenaStringPtr initiator_;
+  ::google::protobuf::int64 height_;
+  ::google::protobuf::int64 timestamp_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetEnvironmentRequest :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.GetEnvironmentRequest) */ {
+ public:
+  GetEnvironmentRequest();
+  virtual ~GetEnvironmentRequest();
+
+  GetEnvironmentRequest(const GetEnvironmentRequest& from);
+
+  inline GetEnvironmentRequest& operator=(const GetEnvironmentRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  GetEnvironmentRequest(GetEnvironmentRequest&& from) noexcept
+    : GetEnvironmentRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline GetEnvironmentRequest& operator=(GetEnvironmentRequest&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const GetEnvironmentRequest& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const GetEnvironmentRequest* internal_default_instance() {
+    return reinterpret_cast<const GetEnvironmentRequest*>(
+               &_GetEnvironmentRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    24;
+
+  void Swap(GetEnvironmentRequest* other);
+  friend void swap(GetEnvironmentRequest& a, GetEnvironmentRequest& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline GetEnvironmentRequest* New() const final {
+    return CreateMaybeMessage<GetEnvironmentRequest>(nullptr);
+  }
+
+  GetEnvironmentRequest* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<GetEnvironmentRequest>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const GetEnvironmentRequest& from);
+  void MergeFrom(const GetEnvironmentRequest& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(GetEnvironmentRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // .contract.SyscallHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::contract::SyscallHeader& header() const;
+  ::contract::SyscallHeader* release_header();
+  ::contract::SyscallHeader* mutable_header();
+  void set_allocated_header(::contract::SyscallHeader* header);
+
+  // @@protoc_insertion_point(class_scope:contract.GetEnvironmentRequest)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::contract::SyscallHeader* header_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class HashRequest :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.HashRequest) */ {
+ public:
+  HashRequest();
+  virtual ~HashRequest();
+
+  HashRequest(const HashRequest& from);
+
+  inline HashRequest& operator=(const HashRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  HashRequest(HashRequest&& from) noexcept
+    : HashRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline HashRequest& operator=(HashRequest&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const HashRequest& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const HashRequest* internal_default_instance() {
+    return reinterpret_cast<const HashRequest*>(
+               &_HashRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    25;
+
+  void Swap(HashRequest* other);
+  friend void swap(HashRequest& a, HashRequest& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline HashRequest* New() const final {
+    return CreateMaybeMessage<HashRequest>(nullptr);
+  }
+
+  HashRequest* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<HashRequest>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const HashRequest& from);
+  void MergeFrom(const HashRequest& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(HashRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // string algorithm = 2;
+  void clear_algorithm();
+  static const int kAlgorithmFieldNumber = 2;
+  const ::std::string& algorithm() const;
+  void set_algorithm(const ::std::string& value);
+  #if LANG_CXX11
+  void set_algorithm(::std::string&& value);
+  #endif
+  void set_algorithm(const char* value);
+  void set_algorithm(const char* value, size_t size);
+  ::std::string* mutable_algorithm();
+  ::std::string* release_algorithm();
+  void set_allocated_algorithm(::std::string* algorithm);
+
+  // bytes data = 3;
+  void clear_data();
+  static const int kDataFieldNumber = 3;
+  const ::std::string& data() const;
+  void set_data(const ::std::string& value);
+  #if LANG_CXX11
+  void set_data(::std::string&& value);
+  #endif
+  void set_data(const char* value);
+  void set_data(const void* value, size_t size);
+  ::std::string* mutable_data();
+  ::std::string* release_data();
+  void set_allocated_data(::std::string* data);
+
+  // .contract.SyscallHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::contract::SyscallHeader& header() const;
+  ::contract::SyscallHeader* release_header();
+  ::contract::SyscallHeader* mutable_header();
+  void set_allocated_header(::contract::SyscallHeader* header);
+
+  // @@protoc_insertion_point(class_scope:contract.HashRequest)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr algorithm_;
+  ::google::protobuf::internal::ArenaStringPtr data_;
+  ::contract::SyscallHeader* header_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class HashResponse :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.HashResponse) */ {
+ public:
+  HashResponse();
+  virtual ~HashResponse();
+
+  HashResponse(const HashResponse& from);
+
+  inline HashResponse& operator=(const HashResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  HashResponse(HashResponse&& from) noexcept
+    : HashResponse() {
+    *this = ::std::move(from);
+  }
+
+  inline HashResponse& operator=(HashResponse&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const HashResponse& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const HashResponse* internal_default_instance() {
+    return reinterpret_cast<const HashResponse*>(
+               &_HashResponse_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    26;
+
+  void Swap(HashResponse* other);
+  friend void swap(HashResponse& a, HashResponse& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline HashResponse* New() const final {
+    return CreateMaybeMessage<HashResponse>(nullptr);
+  }
+
+  HashResponse* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<HashResponse>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const HashResponse& from);
+  void MergeFrom(const HashResponse& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(HashResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // bytes digest = 1;
+  void clear_digest();
+  static const int kDigestFieldNumber = 1;
+  const ::std::string& digest() const;
+  void set_digest(const ::std::string& value);
+  #if LANG_CXX11
+  void set_digest(::std::string&& value);
+  #endif
+  void set_digest(const char* value);
+  void set_digest(const void* value, size_t size);
+  ::std::string* mutable_digest();
+  ::std::string* release_digest();
+  void set_allocated_digest(::std::string* digest);
+
+  // @@protoc_insertion_point(class_scope:contract.HashResponse)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr digest_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class VerifySignatureRequest :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.VerifySignatureRequest) */ {
+ public:
+  VerifySignatureRequest();
+  virtual ~VerifySignatureRequest();
+
+  VerifySignatureRequest(const VerifySignatureRequest& from);
+
+  inline VerifySignatureRequest& operator=(const VerifySignatureRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  VerifySignatureRequest(VerifySignatureRequest&& from) noexcept
+    : VerifySignatureRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline VerifySignatureRequest& operator=(VerifySignatureRequest&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const VerifySignatureRequest& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const VerifySignatureRequest* internal_default_instance() {
+    return reinterpret_cast<const VerifySignatureRequest*>(
+               &_VerifySignatureRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    27;
+
+  void Swap(VerifySignatureRequest* other);
+  friend void swap(VerifySignatureRequest& a, VerifySignatureRequest& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline VerifySignatureRequest* New() const final {
+    return CreateMaybeMessage<VerifySignatureRequest>(nullptr);
+  }
+
+  VerifySignatureRequest* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<VerifySignatureRequest>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const VerifySignatureRequest& from);
+  void MergeFrom(const VerifySignatureRequest& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(VerifySignatureRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // string algorithm = 2;
+  void clear_algorithm();
+  static const int kAlgorithmFieldNumber = 2;
+  const ::std::string& algorithm() const;
+  void set_algorithm(const ::std::string& value);
+  #if LANG_CXX11
+  void set_algorithm(::std::string&& value);
+  #endif
+  void set_algorithm(const char* value);
+  void set_algorithm(const char* value, size_t size);
+  ::std::string* mutable_algorithm();
+  ::std::string* release_algorithm();
+  void set_allocated_algorithm(::std::string* algorithm);
+
+  // bytes pubkey = 3;
+  void clear_pubkey();
+  static const int kPubkeyFieldNumber = 3;
+  const ::std::string& pubkey() const;
+  void set_pubkey(const ::std::string& value);
+  #if LANG_CXX11
+  void set_pubkey(::std::string&& value);
+  #endif
+  void set_pubkey(const char* value);
+  void set_pubkey(const void* value, size_t size);
+  ::std::string* mutable_pubkey();
+  ::std::string* release_pubkey();
+  void set_allocated_pubkey(::std::string* pubkey);
+
+  // bytes msg = 4;
+  void clear_msg();
+  static const int kMsgFieldNumber = 4;
+  const ::std::string& msg() const;
+  void set_msg(const ::std::string& value);
+  #if LANG_CXX11
+  void set_msg(::std::string&& value);
+  #endif
+  void set_msg(const char* value);
+  void set_msg(const void* value, size_t size);
+  ::std::string* mutable_msg();
+  ::std::string* release_msg();
+  void set_allocated_msg(::std::string* msg);
+
+  // bytes sign = 5;
+  void clear_sign();
+  static const int kSignFieldNumber = 5;
+  const ::std::string& sign() const;
+  void set_sign(const ::std::string& value);
+  #if LANG_CXX11
+  void set_sign(::std::string&& value);
+  #endif
+  void set_sign(const char* value);
+  void set_sign(const void* value, size_t size);
+  ::std::string* mutable_sign();
+  ::std::string* release_sign();
+  void set_allocated_sign(::std::string* sign);
+
+  // .contract.SyscallHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::contract::SyscallHeader& header() const;
+  ::contract::SyscallHeader* release_header();
+  ::contract::SyscallHeader* mutable_header();
+  void set_allocated_header(::contract::SyscallHeader* header);
+
+  // @@protoc_insertion_point(class_scope:contract.VerifySignatureRequest)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr algorithm_;
+  ::google::protobuf::internal::ArenaStringPtr pubkey_;
+  ::google::protobuf::internal::ArenaStringPtr msg_;
+  ::google::protobuf::internal::ArenaStringPtr sign_;
+  ::contract::SyscallHeader* header_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class VerifySignatureResponse :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.VerifySignatureResponse) */ {
+ public:
+  VerifySignatureResponse();
+  virtual ~VerifySignatureResponse();
+
+  VerifySignatureResponse(const VerifySignatureResponse& from);
+
+  inline VerifySignatureResponse& operator=(const VerifySignatureResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  VerifySignatureResponse(VerifySignatureResponse&& from) noexcept
+    : VerifySignatureResponse() {
+    *this = ::std::move(from);
+  }
+
+  inline VerifySignatureResponse& operator=(VerifySignatureResponse&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const VerifySignatureResponse& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const VerifySignatureResponse* internal_default_instance() {
+    return reinterpret_cast<const VerifySignatureResponse*>(
+               &_VerifySignatureResponse_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    28;
+
+  void Swap(VerifySignatureResponse* other);
+  friend void swap(VerifySignatureResponse& a, VerifySignatureResponse& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline VerifySignatureResponse* New() const final {
+    return CreateMaybeMessage<VerifySignatureResponse>(nullptr);
+  }
+
+  VerifySignatureResponse* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<VerifySignatureResponse>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const VerifySignatureResponse& from);
+  void MergeFrom(const VerifySignatureResponse& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(VerifySignatureResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // bool valid = 1;
+  void clear_valid();
+  static const int kValidFieldNumber = 1;
+  bool valid() const;
+  void set_valid(bool value);
+
+  // @@protoc_insertion_point(class_scope:contract.VerifySignatureResponse)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  bool valid_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class ContractDesc :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.ContractDesc) */ {
+ public:
+  ContractDesc();
+  virtual ~ContractDesc();
+
+  ContractDesc(const ContractDesc& from);
+
+  inline ContractDesc& operator=(const ContractDesc& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  ContractDesc(ContractDesc&& from) noexcept
+    : ContractDesc() {
+    *this = ::std::move(from);
+  }
+
+  inline ContractDesc& operator=(ContractDesc&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ContractDesc& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const ContractDesc* internal_default_instance() {
+    return reinterpret_cast<const ContractDesc*>(
+               &_ContractDesc_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    29;
+
+  void Swap(ContractDesc* other);
+  friend void swap(ContractDesc& a, ContractDesc& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline ContractDesc* New() const final {
+    return CreateMaybeMessage<ContractDesc>(nullptr);
+  }
+
+  ContractDesc* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<ContractDesc>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const ContractDesc& from);
+  void MergeFrom(const ContractDesc& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ContractDesc* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // string name = 1;
+  void clear_name();
+  static const int kNameFieldNumber = 1;
+  const ::std::string& name() const;
+  void set_name(const ::std::string& value);
+  #if LANG_CXX11
+  void set_name(::std::string&& value);
+  #endif
+  void set_name(const char* value);
+  void set_name(const char* value, size_t size);
+  ::std::string* mutable_name();
+  ::std::string* release_name();
+  void set_allocated_name(::std::string* name);
+
+  // string language = 2;
+  void clear_language();
+  static const int kLanguageFieldNumber = 2;
+  const ::std::string& language() const;
+  void set_language(const ::std::string& value);
+  #if LANG_CXX11
+  void set_language(::std::string&& value);
+  #endif
+  void set_language(const char* value);
+  void set_language(const char* value, size_t size);
+  ::std::string* mutable_language();
+  ::std::string* release_language();
+  void set_allocated_language(::std::string* language);
+
+  // bytes code_hash = 3;
+  void clear_code_hash();
+  static const int kCodeHashFieldNumber = 3;
+  const ::std::string& code_hash() const;
+  void set_code_hash(const ::std::string& value);
+  #if LANG_CXX11
+  void set_code_hash(::std::string&& value);
+  #endif
+  void set_code_hash(const char* value);
+  void set_code_hash(const void* value, size_t size);
+  ::std::string* mutable_code_hash();
+  ::std::string* release_code_hash();
+  void set_allocated_code_hash(::std::string* code_hash);
+
+  // string deployer = 5;
+  void clear_deployer();
+  static const int kDeployerFieldNumber = 5;
+  const ::std::string& deployer() const;
+  void set_deployer(const ::std::string& value);
+  #if LANG_CXX11
+  void set_deployer(::std::string&& value);
+  #endif
+  void set_deployer(const char* value);
+  void set_deployer(const char* value, size_t size);
+  ::std::string* mutable_deployer();
+  ::std::string* release_deployer();
+  void set_allocated_deployer(::std::string* deployer);
+
+  // bytes abi = 8;
+  void clear_abi();
+  static const int kAbiFieldNumber = 8;
+  const ::std::string& abi() const;
+  void set_abi(const ::std::string& value);
+  #if LANG_CXX11
+  void set_abi(::std::string&& value);
+  #endif
+  void set_abi(const char* value);
+  void set_abi(const void* value, size_t size);
+  ::std::string* mutable_abi();
+  ::std::string* release_abi();
+  void set_allocated_abi(::std::string* abi);
+
+  // int64 code_size = 4;
+  void clear_code_size();
+  static const int kCodeSizeFieldNumber = 4;
+  ::google::protobuf::int64 code_size() const;
+  void set_code_size(::google::protobuf::int64 value);
+
+  // int64 deploy_time = 6;
+  void clear_deploy_time();
+  static const int kDeployTimeFieldNumber = 6;
+  ::google::protobuf::int64 deploy_time() const;
+  void set_deploy_time(::google::protobuf::int64 value);
+
+  // int64 version = 7;
+  void clear_version();
+  static const int kVersionFieldNumber = 7;
+  ::google::protobuf::int64 version() const;
+  void set_version(::google::protobuf::int64 value);
+
+  // bool non_reentrant = 9;
+  void clear_non_reentrant();
+  static const int kNonReentrantFieldNumber = 9;
+  bool non_reentrant() const;
+  void set_non_reentrant(bool value);
+
+  // @@protoc_insertion_point(class_scope:contract.ContractDesc)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr name_;
+  ::google::protobuf::internal::ArenaStringPtr language_;
+  ::google::protobuf::internal::ArenaStringPtr code_hash_;
+  ::google::protobuf::internal::ArenaStringPtr deployer_;
+  ::google::protobuf::internal::ArenaStringPtr abi_;
+  ::google::protobuf::int64 code_size_;
+  ::google::protobuf::int64 deploy_time_;
+  ::google::protobuf::int64 version_;
+  bool non_reentrant_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class MethodACL :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.MethodACL) */ {
+ public:
+  MethodACL();
+  virtual ~MethodACL();
+
+  MethodACL(const MethodACL& from);
+
+  inline MethodACL& operator=(const MethodACL& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  MethodACL(MethodACL&& from) noexcept
+    : MethodACL() {
+    *this = ::std::move(from);
+  }
+
+  inline MethodACL& operator=(MethodACL&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const MethodACL& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const MethodACL* internal_default_instance() {
+    return reinterpret_cast<const MethodACL*>(
+               &_MethodACL_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    30;
+
+  void Swap(MethodACL* other);
+  friend void swap(MethodACL& a, MethodACL& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline MethodACL* New() const final {
+    return CreateMaybeMessage<MethodACL>(nullptr);
+  }
+
+  MethodACL* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<MethodACL>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const MethodACL& from);
+  void MergeFrom(const MethodACL& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(MethodACL* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // repeated string callers = 2;
+  int callers_size() const;
+  void clear_callers();
+  static const int kCallersFieldNumber = 2;
+  const ::std::string& callers(int index) const;
+  ::std::string* mutable_callers(int index);
+  void set_callers(int index, const ::std::string& value);
+  #if LANG_CXX11
+  void set_callers(int index, ::std::string&& value);
+  #endif
+  void set_callers(int index, const char* value);
+  void set_callers(int index, const char* value, size_t size);
+  ::std::string* add_callers();
+  void add_callers(const ::std::string& value);
+  #if LANG_CXX11
+  void add_callers(::std::string&& value);
+  #endif
+  void add_callers(const char* value);
+  void add_callers(const char* value, size_t size);
+  const ::google::protobuf::RepeatedPtrField<::std::string>& callers() const;
+  ::google::protobuf::RepeatedPtrField<::std::string>* mutable_callers();
+
+  // repeated string roles = 3;
+  int roles_size() const;
+  void clear_roles();
+  static const int kRolesFieldNumber = 3;
+  const ::std::string& roles(int index) const;
+  ::std::string* mutable_roles(int index);
+  void set_roles(int index, const ::std::string& value);
+  #if LANG_CXX11
+  void set_roles(int index, ::std::string&& value);
+  #endif
+  void set_roles(int index, const char* value);
+  void set_roles(int index, const char* value, size_t size);
+  ::std::string* add_roles();
+  void add_roles(const ::std::string& value);
+  #if LANG_CXX11
+  void add_roles(::std::string&& value);
+  #endif
+  void add_roles(const char* value);
+  void add_roles(const char* value, size_t size);
+  const ::google::protobuf::RepeatedPtrField<::std::string>& roles() const;
+  ::google::protobuf::RepeatedPtrField<::std::string>* mutable_roles();
+
+  // string method = 1;
+  void clear_method();
+  static const int kMethodFieldNumber = 1;
+  const ::std::string& method() const;
+  void set_method(const ::std::string& value);
+  #if LANG_CXX11
+  void set_method(::std::string&& value);
+  #endif
+  void set_method(const char* value);
+  void set_method(const char* value, size_t size);
+  ::std::string* mutable_method();
+  ::std::string* release_method();
+  void set_allocated_method(::std::string* method);
+
+  // @@protoc_insertion_point(class_scope:contract.MethodACL)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::RepeatedPtrField<::std::string> callers_;
+  ::google::protobuf::RepeatedPtrField<::std::string> roles_;
+  ::google::protobuf::internal::ArenaStringPtr method_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class ACLRole :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.ACLRole) */ {
+ public:
+  ACLRole();
+  virtual ~ACLRole();
+
+  ACLRole(const ACLRole& from);
+
+  inline ACLRole& operator=(const ACLRole& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  ACLRole(ACLRole&& from) noexcept
+    : ACLRole() {
+    *this = ::std::move(from);
+  }
+
+  inline ACLRole& operator=(ACLRole&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ACLRole& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const ACLRole* internal_default_instance() {
+    return reinterpret_cast<const ACLRole*>(
+               &_ACLRole_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    31;
+
+  void Swap(ACLRole* other);
+  friend void swap(ACLRole& a, ACLRole& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline ACLRole* New() const final {
+    return CreateMaybeMessage<ACLRole>(nullptr);
+  }
+
+  ACLRole* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<ACLRole>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const ACLRole& from);
+  void MergeFrom(const ACLRole& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ACLRole* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // repeated string members = 2;
+  int members_size() const;
+  void clear_members();
+  static const int kMembersFieldNumber = 2;
+  const ::std::string& members(int index) const;
+  ::std::string* mutable_members(int index);
+  void set_members(int index, const ::std::string& value);
+  #if LANG_CXX11
+  void set_members(int index, ::std::string&& value);
+  #endif
+  void set_members(int index, const char* value);
+  void set_members(int index, const char* value, size_t size);
+  ::std::string* add_members();
+  void add_members(const ::std::string& value);
+  #if LANG_CXX11
+  void add_members(::std::string&& value);
+  #endif
+  void add_members(const char* value);
+  void add_members(const char* value, size_t size);
+  const ::google::protobuf::RepeatedPtrField<::std::string>& members() const;
+  ::google::protobuf::RepeatedPtrField<::std::string>* mutable_members();
+
+  // string name = 1;
+  void clear_name();
+  static const int kNameFieldNumber = 1;
+  const ::std::string& name() const;
+  void set_name(const ::std::string& value);
+  #if LANG_CXX11
+  void set_name(::std::string&& value);
+  #endif
+  void set_name(const char* value);
+  void set_name(const char* value, size_t size);
+  ::std::string* mutable_name();
+  ::std::string* release_name();
+  void set_allocated_name(::std::string* name);
+
+  // @@protoc_insertion_point(class_scope:contract.ACLRole)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::RepeatedPtrField<::std::string> members_;
+  ::google::protobuf::internal::ArenaStringPtr name_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// -------------------------------------------------------------------
+
+class ContractACL :
+    public ::google::protobuf::MessageLite /* @@protoc_insertion_point(class_definition:contract.ContractACL) */ {
+ public:
+  ContractACL();
+  virtual ~ContractACL();
+
+  ContractACL(const ContractACL& from);
+
+  inline ContractACL& operator=(const ContractACL& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  ContractACL(ContractACL&& from) noexcept
+    : ContractACL() {
+    *this = ::std::move(from);
+  }
+
+  inline ContractACL& operator=(ContractACL&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ContractACL& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const ContractACL* internal_default_instance() {
+    return reinterpret_cast<const ContractACL*>(
+               &_ContractACL_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    32;
+
+  void Swap(ContractACL* other);
+  friend void swap(ContractACL& a, ContractACL& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline ContractACL* New() const final {
+    return CreateMaybeMessage<ContractACL>(nullptr);
+  }
+
+  ContractACL* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<ContractACL>(arena);
+  }
+  void CheckTypeAndMergeFrom(const ::google::protobuf::MessageLite& from)
+    final;
+  void CopyFrom(const ContractACL& from);
+  void MergeFrom(const ContractACL& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  static const char* _InternalParse(const char* begin, const char* end, void* object, ::google::protobuf::internal::ParseContext* ctx);
+  ::google::protobuf::internal::ParseFunc _ParseFunc() const final { return _InternalParse; }
+  #else
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  void DiscardUnknownFields();
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ContractACL* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::std::string GetTypeName() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // repeated .contract.MethodACL methods = 2;
+  int methods_size() const;
+  void clear_methods();
+  static const int kMethodsFieldNumber = 2;
+  ::contract::MethodACL* mutable_methods(int index);
+  ::google::protobuf::RepeatedPtrField< ::contract::MethodACL >*
+      mutable_methods();
+  const ::contract::MethodACL& methods(int index) const;
+  ::contract::MethodACL* add_methods();
+  const ::google::protobuf::RepeatedPtrField< ::contract::MethodACL >&
+      methods() const;
+
+  // repeated .contract.ACLRole roles = 3;
+  int roles_size() const;
+  void clear_roles();
+  static const int kRolesFieldNumber = 3;
+  ::contract::ACLRole* mutable_roles(int index);
+  ::google::protobuf::RepeatedPtrField< ::contract::ACLRole >*
+      mutable_roles();
+  const ::contract::ACLRole& roles(int index) const;
+  ::contract::ACLRole* add_roles();
+  const ::google::protobuf::RepeatedPtrField< ::contract::ACLRole >&
+      roles() const;
+
+  // string contract = 1;
+  void clear_contract();
+  static const int kContractFieldNumber = 1;
+  const ::std::string& contract() const;
+  void set_contract(const ::std::string& value);
+  #if LANG_CXX11
+  void set_contract(::std::string&& value);
+  #endif
+  void set_contract(const char* value);
+  void set_contract(const char* value, size_t size);
+  ::std::string* mutable_contract();
+  ::std::string* release_contract();
+  void set_allocated_contract(::std::string* contract);
+
+  // @@protoc_insertion_point(class_scope:contract.ContractACL)
+ private:
+  class HasBitSetters;
+
+  ::google::protobuf::internal::InternalMetadataWithArenaLite _internal_metadata_;
+  ::google::protobuf::RepeatedPtrField< ::contract::MethodACL > methods_;
+  ::google::protobuf::RepeatedPtrField< ::contract::ACLRole > roles_;
+  ::google::protobuf::internal::ArenaStringPtr contract_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_contract_2eproto;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+#ifdef __GNUC__
+  #pragma GCC diagnostic push
+  #pragma GCC diagnostic ignored "-Wstrict-aliasing"
+#endif  // __GNUC__
+// ArgPair
+
+// string key = 1;
+inline void ArgPair::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ArgPair::key() const {
+  // @@protoc_insertion_point(field_get:contract.ArgPair.key)
+  return key_.GetNoArena();
+}
+inline void ArgPair::set_key(const ::std::string& value) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ArgPair.key)
+}
+#if LANG_CXX11
+inline void ArgPair::set_key(::std::string&& value) {
+  
+  key_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ArgPair.key)
+}
+#endif
+inline void ArgPair::set_key(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ArgPair.key)
+}
+inline void ArgPair::set_key(const char* value, size_t size) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ArgPair.key)
+}
+inline ::std::string* ArgPair::mutable_key() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.ArgPair.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ArgPair::release_key() {
+  // @@protoc_insertion_point(field_release:contract.ArgPair.key)
+  
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ArgPair::set_allocated_key(::std::string* key) {
+  if (key != nullptr) {
+    
+  } else {
+    
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:contract.ArgPair.key)
+}
+
+// bytes value = 2;
+inline void ArgPair::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ArgPair::value() const {
+  // @@protoc_insertion_point(field_get:contract.ArgPair.value)
+  return value_.GetNoArena();
+}
+inline void ArgPair::set_value(const ::std::string& value) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ArgPair.value)
+}
+#if LANG_CXX11
+inline void ArgPair::set_value(::std::string&& value) {
+  
+  value_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ArgPair.value)
+}
+#endif
+inline void ArgPair::set_value(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ArgPair.value)
+}
+inline void ArgPair::set_value(const void* value, size_t size) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ArgPair.value)
+}
+inline ::std::string* ArgPair::mutable_value() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.ArgPair.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ArgPair::release_value() {
+  // @@protoc_insertion_point(field_release:contract.ArgPair.value)
+  
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ArgPair::set_allocated_value(::std::string* value) {
+  if (value != nullptr) {
+    
+  } else {
+    
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:contract.ArgPair.value)
+}
+
+// -------------------------------------------------------------------
+
+// CallArgs
+
+// string method = 1;
+inline void CallArgs::clear_method() {
+  method_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& CallArgs::method() const {
+  // @@protoc_insertion_point(field_get:contract.CallArgs.method)
+  return method_.GetNoArena();
+}
+inline void CallArgs::set_method(const ::std::string& value) {
+  
+  method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.CallArgs.method)
+}
+#if LANG_CXX11
+inline void CallArgs::set_method(::std::string&& value) {
+  
+  method_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.CallArgs.method)
+}
+#endif
+inline void CallArgs::set_method(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.CallArgs.method)
+}
+inline void CallArgs::set_method(const char* value, size_t size) {
+  
+  method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.CallArgs.method)
+}
+inline ::std::string* CallArgs::mutable_method() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.CallArgs.method)
+  return method_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* CallArgs::release_method() {
+  // @@protoc_insertion_point(field_release:contract.CallArgs.method)
+  
+  return method_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void CallArgs::set_allocated_method(::std::string* method) {
+  if (method != nullptr) {
+    
+  } else {
+    
+  }
+  method_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), method);
+  // @@protoc_insertion_point(field_set_allocated:contract.CallArgs.method)
+}
+
+// repeated .contract.ArgPair args = 2;
+inline int CallArgs::args_size() const {
+  return args_.size();
+}
+inline void CallArgs::clear_args() {
+  args_.Clear();
+}
+inline ::contract::ArgPair* CallArgs::mutable_args(int index) {
+  // @@protoc_insertion_point(field_mutable:contract.CallArgs.args)
+  return args_.Mutable(index);
+}
+inline ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >*
+CallArgs::mutable_args() {
+  // @@protoc_insertion_point(field_mutable_list:contract.CallArgs.args)
+  return &args_;
+}
+inline const ::contract::ArgPair& CallArgs::args(int index) const {
+  // @@protoc_insertion_point(field_get:contract.CallArgs.args)
+  return args_.Get(index);
+}
+inline ::contract::ArgPair* CallArgs::add_args() {
+  // @@protoc_insertion_point(field_add:contract.CallArgs.args)
+  return args_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >&
+CallArgs::args() const {
+  // @@protoc_insertion_point(field_list:contract.CallArgs.args)
+  return args_;
+}
+
+// string caller = 3;
+inline void CallArgs::clear_caller() {
+  caller_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& CallArgs::caller() const {
+  // @@protoc_insertion_point(field_get:contract.CallArgs.caller)
+  return caller_.GetNoArena();
+}
+inline void CallArgs::set_caller(const ::std::string& value) {
+  
+  caller_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.CallArgs.caller)
+}
+#if LANG_CXX11
+inline void CallArgs::set_caller(::std::string&& value) {
+  
+  caller_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.CallArgs.caller)
+}
+#endif
+inline void CallArgs::set_caller(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  caller_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.CallArgs.caller)
+}
+inline void CallArgs::set_caller(const char* value, size_t size) {
+  
+  caller_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.CallArgs.caller)
+}
+inline ::std::string* CallArgs::mutable_caller() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.CallArgs.caller)
+  return caller_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* CallArgs::release_caller() {
+  // @@protoc_insertion_point(field_release:contract.CallArgs.caller)
+  
+  return caller_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void CallArgs::set_allocated_caller(::std::string* caller) {
+  if (caller != nullptr) {
+    
+  } else {
+    
+  }
+  caller_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), caller);
+  // @@protoc_insertion_point(field_set_allocated:contract.CallArgs.caller)
+}
+
+// -------------------------------------------------------------------
+
+// SyscallHeader
+
+// int64 ctxid = 1;
+inline void SyscallHeader::clear_ctxid() {
+  ctxid_ = PROTOBUF_LONGLONG(0);
+}
+inline ::google::protobuf::int64 SyscallHeader::ctxid() const {
+  // @@protoc_insertion_point(field_get:contract.SyscallHeader.ctxid)
+  return ctxid_;
+}
+inline void SyscallHeader::set_ctxid(::google::protobuf::int64 value) {
+  
+  ctxid_ = value;
+  // @@protoc_insertion_point(field_set:contract.SyscallHeader.ctxid)
+}
+
+// -------------------------------------------------------------------
+
+// PutRequest
+
+// .contract.SyscallHeader header = 1;
+inline bool PutRequest::has_header() const {
+  return this != internal_default_instance() && header_ != nullptr;
+}
+inline void PutRequest::clear_header() {
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+}
+inline const ::contract::SyscallHeader& PutRequest::header() const {
+  const ::contract::SyscallHeader* p = header_;
+  // @@protoc_insertion_point(field_get:contract.PutRequest.header)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
+      &::contract::_SyscallHeader_default_instance_);
+}
+inline ::contract::SyscallHeader* PutRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.PutRequest.header)
+  
+  ::contract::SyscallHeader* temp = header_;
+  header_ = nullptr;
+  return temp;
+}
+inline ::contract::SyscallHeader* PutRequest::mutable_header() {
+  
+  if (header_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
+    header_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.PutRequest.header)
+  return header_;
+}
+inline void PutRequest::set_allocated_header(::contract::SyscallHeader* header) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete header_;
+  }
+  if (header) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      header = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, header, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  header_ = header;
+  // @@protoc_insertion_point(field_set_allocated:contract.PutRequest.header)
+}
+
+// bytes key = 2;
+inline void PutRequest::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& PutRequest::key() const {
+  // @@protoc_insertion_point(field_get:contract.PutRequest.key)
+  return key_.GetNoArena();
+}
+inline void PutRequest::set_key(const ::std::string& value) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.PutRequest.key)
+}
+#if LANG_CXX11
+inline void PutRequest::set_key(::std::string&& value) {
+  
+  key_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.PutRequest.key)
+}
+#endif
+inline void PutRequest::set_key(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.PutRequest.key)
+}
+inline void PutRequest::set_key(const void* value, size_t size) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.PutRequest.key)
+}
+inline ::std::string* PutRequest::mutable_key() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.PutRequest.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* PutRequest::release_key() {
+  // @@protoc_insertion_point(field_release:contract.PutRequest.key)
+  
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void PutRequest::set_allocated_key(::std::string* key) {
+  if (key != nullptr) {
+    
+  } else {
+    
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:contract.PutRequest.key)
+}
+
+// bytes value = 3;
+inline void PutRequest::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& PutRequest::value() const {
+  // @@protoc_insertion_point(field_get:contract.PutRequest.value)
+  return value_.GetNoArena();
+}
+inline void PutRequest::set_value(const ::std::string& value) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.PutRequest.value)
+}
+#if LANG_CXX11
+inline void PutRequest::set_value(::std::string&& value) {
+  
+  value_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.PutRequest.value)
+}
+#endif
+inline void PutRequest::set_value(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.PutRequest.value)
+}
+inline void PutRequest::set_value(const void* value, size_t size) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.PutRequest.value)
+}
+inline ::std::string* PutRequest::mutable_value() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.PutRequest.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* PutRequest::release_value() {
+  // @@protoc_insertion_point(field_release:contract.PutRequest.value)
+  
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void PutRequest::set_allocated_value(::std::string* value) {
+  if (value != nullptr) {
+    
+  } else {
+    
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:contract.PutRequest.value)
+}
+
+// -------------------------------------------------------------------
+
+// PutResponse
+
+// -------------------------------------------------------------------
+
+// GetRequest
+
+// .contract.SyscallHeader header = 1;
+inline bool GetRequest::has_header() const {
+  return this != internal_default_instance() && header_ != nullptr;
+}
+inline void GetRequest::clear_header() {
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+}
+inline const ::contract::SyscallHeader& GetRequest::header() const {
+  const ::contract::SyscallHeader* p = header_;
+  // @@protoc_insertion_point(field_get:contract.GetRequest.header)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
+      &::contract::_SyscallHeader_default_instance_);
+}
+inline ::contract::SyscallHeader* GetRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.GetRequest.header)
+  
+  ::contract::SyscallHeader* temp = header_;
+  header_ = nullptr;
+  return temp;
+}
+inline ::contract::SyscallHeader* GetRequest::mutable_header() {
+  
+  if (header_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
+    header_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.GetRequest.header)
+  return header_;
+}
+inline void GetRequest::set_allocated_header(::contract::SyscallHeader* header) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete header_;
+  }
+  if (header) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      header = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, header, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  header_ = header;
+  // @@protoc_insertion_point(field_set_allocated:contract.GetRequest.header)
+}
+
+// bytes key = 2;
+inline void GetRequest::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& GetRequest::key() const {
+  // @@protoc_insertion_point(field_get:contract.GetRequest.key)
+  return key_.GetNoArena();
+}
+inline void GetRequest::set_key(const ::std::string& value) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.GetRequest.key)
+}
+#if LANG_CXX11
+inline void GetRequest::set_key(::std::string&& value) {
+  
+  key_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.GetRequest.key)
+}
+#endif
+inline void GetRequest::set_key(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.GetRequest.key)
+}
+inline void GetRequest::set_key(const void* value, size_t size) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.GetRequest.key)
+}
+inline ::std::string* GetRequest::mutable_key() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.GetRequest.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* GetRequest::release_key() {
+  // @@protoc_insertion_point(field_release:contract.GetRequest.key)
+  
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void GetRequest::set_allocated_key(::std::string* key) {
+  if (key != nullptr) {
+    
+  } else {
+    
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:contract.GetRequest.key)
+}
+
+// -------------------------------------------------------------------
+
+// GetResponse
+
+// bytes value = 1;
+inline void GetResponse::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& GetResponse::value() const {
+  // @@protoc_insertion_point(field_get:contract.GetResponse.value)
+  return value_.GetNoArena();
+}
+inline void GetResponse::set_value(const ::std::string& value) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.GetResponse.value)
+}
+#if LANG_CXX11
+inline void GetResponse::set_value(::std::string&& value) {
+  
+  value_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.GetResponse.value)
+}
+#endif
+inline void GetResponse::set_value(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.GetResponse.value)
+}
+inline void GetResponse::set_value(const void* value, size_t size) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.GetResponse.value)
+}
+inline ::std::string* GetResponse::mutable_value() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.GetResponse.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* GetResponse::release_value() {
+  // @@protoc_insertion_point(field_release:contract.GetResponse.value)
+  
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void GetResponse::set_allocated_value(::std::string* value) {
+  if (value != nullptr) {
+    
+  } else {
+    
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:contract.GetResponse.value)
+}
+
+// -------------------------------------------------------------------
+
+// DeleteRequest
+
+// .contract.SyscallHeader header = 1;
+inline bool DeleteRequest::has_header() const {
+  return this != internal_default_instance() && header_ != nullptr;
+}
+inline void DeleteRequest::clear_header() {
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+}
+inline const ::contract::SyscallHeader& DeleteRequest::header() const {
+  const ::contract::SyscallHeader* p = header_;
+  // @@protoc_insertion_point(field_get:contract.DeleteRequest.header)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
+      &::contract::_SyscallHeader_default_instance_);
+}
+inline ::contract::SyscallHeader* DeleteRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.DeleteRequest.header)
+  
+  ::contract::SyscallHeader* temp = header_;
+  header_ = nullptr;
+  return temp;
+}
+inline ::contract::SyscallHeader* DeleteRequest::mutable_header() {
+  
+  if (header_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
+    header_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.DeleteRequest.header)
+  return header_;
+}
+inline void DeleteRequest::set_allocated_header(::contract::SyscallHeader* header) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete header_;
+  }
+  if (header) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      header = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, header, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  header_ = header;
+  // @@protoc_insertion_point(field_set_allocated:contract.DeleteRequest.header)
+}
+
+// bytes key = 2;
+inline void DeleteRequest::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& DeleteRequest::key() const {
+  // @@protoc_insertion_point(field_get:contract.DeleteRequest.key)
+  return key_.GetNoArena();
+}
+inline void DeleteRequest::set_key(const ::std::string& value) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.DeleteRequest.key)
+}
+#if LANG_CXX11
+inline void DeleteRequest::set_key(::std::string&& value) {
+  
+  key_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.DeleteRequest.key)
+}
+#endif
+inline void DeleteRequest::set_key(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.DeleteRequest.key)
+}
+inline void DeleteRequest::set_key(const void* value, size_t size) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.DeleteRequest.key)
+}
+inline ::std::string* DeleteRequest::mutable_key() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.DeleteRequest.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* DeleteRequest::release_key() {
+  // @@protoc_insertion_point(field_release:contract.DeleteRequest.key)
+  
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void DeleteRequest::set_allocated_key(::std::string* key) {
+  if (key != nullptr) {
+    
+  } else {
+    
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:contract.DeleteRequest.key)
+}
+
+// -------------------------------------------------------------------
+
+// DeleteResponse
+
+// -------------------------------------------------------------------
+
+// IteratorRequest
+
+// .contract.SyscallHeader header = 1;
+inline bool IteratorRequest::has_header() const {
+  return this != internal_default_instance() && header_ != nullptr;
+}
+inline void IteratorRequest::clear_header() {
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+}
+inline const ::contract::SyscallHeader& IteratorRequest::header() const {
+  const ::contract::SyscallHeader* p = header_;
+  // @@protoc_insertion_point(field_get:contract.IteratorRequest.header)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
+      &::contract::_SyscallHeader_default_instance_);
+}
+inline ::contract::SyscallHeader* IteratorRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.IteratorRequest.header)
+  
+  ::contract::SyscallHeader* temp = header_;
+  header_ = nullptr;
+  return temp;
+}
+inline ::contract::SyscallHeader* IteratorRequest::mutable_header() {
+  
+  if (header_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
+    header_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.IteratorRequest.header)
+  return header_;
+}
+inline void IteratorRequest::set_allocated_header(::contract::SyscallHeader* header) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete header_;
+  }
+  if (header) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      header = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, header, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  header_ = header;
+  // @@protoc_insertion_point(field_set_allocated:contract.IteratorRequest.header)
+}
+
+// bytes start = 2;
+inline void IteratorRequest::clear_start() {
+  start_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& IteratorRequest::start() const {
+  // @@protoc_insertion_point(field_get:contract.IteratorRequest.start)
+  return start_.GetNoArena();
+}
+inline void IteratorRequest::set_start(const ::std::string& value) {
+  
+  start_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.IteratorRequest.start)
+}
+#if LANG_CXX11
+inline void IteratorRequest::set_start(::std::string&& value) {
+  
+  start_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.IteratorRequest.start)
+}
+#endif
+inline void IteratorRequest::set_start(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  start_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.IteratorRequest.start)
+}
+inline void IteratorRequest::set_start(const void* value, size_t size) {
+  
+  start_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.IteratorRequest.start)
+}
+inline ::std::string* IteratorRequest::mutable_start() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.IteratorRequest.start)
+  return start_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* IteratorRequest::release_start() {
+  // @@protoc_insertion_point(field_release:contract.IteratorRequest.start)
+  
+  return start_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void IteratorRequest::set_allocated_start(::std::string* start) {
+  if (start != nullptr) {
+    
+  } else {
+    
+  }
+  start_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), start);
+  // @@protoc_insertion_point(field_set_allocated:contract.IteratorRequest.start)
+}
+
+// bytes limit = 3;
+inline void IteratorRequest::clear_limit() {
+  limit_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& IteratorRequest::limit() const {
+  // @@protoc_insertion_point(field_get:contract.IteratorRequest.limit)
+  return limit_.GetNoArena();
+}
+inline void IteratorRequest::set_limit(const ::std::string& value) {
+  
+  limit_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.IteratorRequest.limit)
+}
+#if LANG_CXX11
+inline void IteratorRequest::set_limit(::std::string&& value) {
+  
+  limit_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.IteratorRequest.limit)
+}
+#endif
+inline void IteratorRequest::set_limit(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  limit_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.IteratorRequest.limit)
+}
+inline void IteratorRequest::set_limit(const void* value, size_t size) {
+  
+  limit_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.IteratorRequest.limit)
+}
+inline ::std::string* IteratorRequest::mutable_limit() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.IteratorRequest.limit)
+  return limit_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* IteratorRequest::release_limit() {
+  // @@protoc_insertion_point(field_release:contract.IteratorRequest.limit)
+  
+  return limit_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void IteratorRequest::set_allocated_limit(::std::string* limit) {
+  if (limit != nullptr) {
+    
+  } else {
+    
+  }
+  limit_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), limit);
+  // @@protoc_insertion_point(field_set_allocated:contract.IteratorRequest.limit)
+}
+
+// int32 cap = 4;
+inline void IteratorRequest::clear_cap() {
+  cap_ = 0;
+}
+inline ::google::protobuf::int32 IteratorRequest::cap() const {
+  // @@protoc_insertion_point(field_get:contract.IteratorRequest.cap)
+  return cap_;
+}
+inline void IteratorRequest::set_cap(::google::protobuf::int32 value) {
+  
+  cap_ = value;
+  // @@protoc_insertion_point(field_set:contract.IteratorRequest.cap)
+}
+
+// -------------------------------------------------------------------
+
+// IteratorItem
+
+// bytes key = 1;
+inline void IteratorItem::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& IteratorItem::key() const {
+  // @@protoc_insertion_point(field_get:contract.IteratorItem.key)
+  return key_.GetNoArena();
+}
+inline void IteratorItem::set_key(const ::std::string& value) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.IteratorItem.key)
+}
+#if LANG_CXX11
+inline void IteratorItem::set_key(::std::string&& value) {
+  
+  key_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.IteratorItem.key)
+}
+#endif
+inline void IteratorItem::set_key(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.IteratorItem.key)
+}
+inline void IteratorItem::set_key(const void* value, size_t size) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.IteratorItem.key)
+}
+inline ::std::string* IteratorItem::mutable_key() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.IteratorItem.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* IteratorItem::release_key() {
+  // @@protoc_insertion_point(field_release:contract.IteratorItem.key)
+  
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void IteratorItem::set_allocated_key(::std::string* key) {
+  if (key != nullptr) {
+    
+  } else {
+    
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:contract.IteratorItem.key)
+}
+
+// bytes value = 2;
+inline void IteratorItem::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& IteratorItem::value() const {
+  // @@protoc_insertion_point(field_get:contract.IteratorItem.value)
+  return value_.GetNoArena();
+}
+inline void IteratorItem::set_value(const ::std::string& value) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.IteratorItem.value)
+}
+#if LANG_CXX11
+inline void IteratorItem::set_value(::std::string&& value) {
+  
+  value_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.IteratorItem.value)
+}
+#endif
+inline void IteratorItem::set_value(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.IteratorItem.value)
+}
+inline void IteratorItem::set_value(const void* value, size_t size) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.IteratorItem.value)
+}
+inline ::std::string* IteratorItem::mutable_value() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.IteratorItem.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* IteratorItem::release_value() {
+  // @@protoc_insertion_point(field_release:contract.IteratorItem.value)
+  
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void IteratorItem::set_allocated_value(::std::string* value) {
+  if (value != nullptr) {
+    
+  } else {
+    
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:contract.IteratorItem.value)
+}
+
+// -------------------------------------------------------------------
+
+// IteratorResponse
+
+// repeated .contract.IteratorItem items = 1;
+inline int IteratorResponse::items_size() const {
+  return items_.size();
+}
+inline void IteratorResponse::clear_items() {
+  items_.Clear();
+}
+inline ::contract::IteratorItem* IteratorResponse::mutable_items(int index) {
+  // @@protoc_insertion_point(field_mutable:contract.IteratorResponse.items)
+  return items_.Mutable(index);
+}
+inline ::google::protobuf::RepeatedPtrField< ::contract::IteratorItem >*
+IteratorResponse::mutable_items() {
+  // @@protoc_insertion_point(field_mutable_list:contract.IteratorResponse.items)
+  return &items_;
+}
+inline const ::contract::IteratorItem& IteratorResponse::items(int index) const {
+  // @@protoc_insertion_point(field_get:contract.IteratorResponse.items)
+  return items_.Get(index);
+}
+inline ::contract::IteratorItem* IteratorResponse::add_items() {
+  // @@protoc_insertion_point(field_add:contract.IteratorResponse.items)
+  return items_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::contract::IteratorItem >&
+IteratorResponse::items() const {
+  // @@protoc_insertion_point(field_list:contract.IteratorResponse.items)
+  return items_;
+}
+
+// -------------------------------------------------------------------
+
+// TransferRequest
+
+// .contract.SyscallHeader header = 1;
+inline bool TransferRequest::has_header() const {
+  return this != internal_default_instance() && header_ != nullptr;
+}
+inline void TransferRequest::clear_header() {
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+}
+inline const ::contract::SyscallHeader& TransferRequest::header() const {
+  const ::contract::SyscallHeader* p = header_;
+  // @@protoc_insertion_point(field_get:contract.TransferRequest.header)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
+      &::contract::_SyscallHeader_default_instance_);
+}
+inline ::contract::SyscallHeader* TransferRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.TransferRequest.header)
+  
+  ::contract::SyscallHeader* temp = header_;
+  header_ = nullptr;
+  return temp;
+}
+inline ::contract::SyscallHeader* TransferRequest::mutable_header() {
+  
+  if (header_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
+    header_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.TransferRequest.header)
+  return header_;
+}
+inline void TransferRequest::set_allocated_header(::contract::SyscallHeader* header) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete header_;
+  }
+  if (header) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      header = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, header, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  header_ = header;
+  // @@protoc_insertion_point(field_set_allocated:contract.TransferRequest.header)
+}
+
+// string from = 2;
+inline void TransferRequest::clear_from() {
+  from_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& TransferRequest::from() const {
+  // @@protoc_insertion_point(field_get:contract.TransferRequest.from)
+  return from_.GetNoArena();
+}
+inline void TransferRequest::set_from(const ::std::string& value) {
+  
+  from_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.TransferRequest.from)
+}
+#if LANG_CXX11
+inline void TransferRequest::set_from(::std::string&& value) {
+  
+  from_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.TransferRequest.from)
+}
+#endif
+inline void TransferRequest::set_from(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  from_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.TransferRequest.from)
+}
+inline void TransferRequest::set_from(const char* value, size_t size) {
+  
+  from_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.TransferRequest.from)
+}
+inline ::std::string* TransferRequest::mutable_from() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.TransferRequest.from)
+  return from_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* TransferRequest::release_from() {
+  // @@protoc_insertion_point(field_release:contract.TransferRequest.from)
+  
+  return from_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void TransferRequest::set_allocated_from(::std::string* from) {
+  if (from != nullptr) {
+    
+  } else {
+    
+  }
+  from_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from);
+  // @@protoc_insertion_point(field_set_allocated:contract.TransferRequest.from)
+}
+
+// string to = 3;
+inline void TransferRequest::clear_to() {
+  to_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& TransferRequest::to() const {
+  // @@protoc_insertion_point(field_get:contract.TransferRequest.to)
+  return to_.GetNoArena();
+}
+inline void TransferRequest::set_to(const ::std::string& value) {
+  
+  to_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.TransferRequest.to)
+}
+#if LANG_CXX11
+inline void TransferRequest::set_to(::std::string&& value) {
+  
+  to_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.TransferRequest.to)
+}
+#endif
+inline void TransferRequest::set_to(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  to_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.TransferRequest.to)
+}
+inline void TransferRequest::set_to(const char* value, size_t size) {
+  
+  to_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.TransferRequest.to)
+}
+inline ::std::string* TransferRequest::mutable_to() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.TransferRequest.to)
+  return to_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* TransferRequest::release_to() {
+  // @@protoc_insertion_point(field_release:contract.TransferRequest.to)
+  
+  return to_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void TransferRequest::set_allocated_to(::std::string* to) {
+  if (to != nullptr) {
+    
+  } else {
+    
+  }
+  to_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), to);
+  // @@protoc_insertion_point(field_set_allocated:contract.TransferRequest.to)
+}
+
+// string amount = 4;
+inline void TransferRequest::clear_amount() {
+  amount_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& TransferRequest::amount() const {
+  // @@protoc_insertion_point(field_get:contract.TransferRequest.amount)
+  return amount_.GetNoArena();
+}
+inline void TransferRequest::set_amount(const ::std::string& value) {
+  
+  amount_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.TransferRequest.amount)
+}
+#if LANG_CXX11
+inline void TransferRequest::set_amount(::std::string&& value) {
+  
+  amount_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.TransferRequest.amount)
+}
+#endif
+inline void TransferRequest::set_amount(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  amount_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.TransferRequest.amount)
+}
+inline void TransferRequest::set_amount(const char* value, size_t size) {
+  
+  amount_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.TransferRequest.amount)
+}
+inline ::std::string* TransferRequest::mutable_amount() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.TransferRequest.amount)
+  return amount_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* TransferRequest::release_amount() {
+  // @@protoc_insertion_point(field_release:contract.TransferRequest.amount)
+  
+  return amount_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void TransferRequest::set_allocated_amount(::std::string* amount) {
+  if (amount != nullptr) {
+    
+  } else {
+    
+  }
+  amount_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), amount);
+  // @@protoc_insertion_point(field_set_allocated:contract.TransferRequest.amount)
+}
+
+// -------------------------------------------------------------------
+
+// TransferResponse
+
+// -------------------------------------------------------------------
+
+// ContractEvent
+
+// string contract = 1;
+inline void ContractEvent::clear_contract() {
+  contract_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractEvent::contract() const {
+  // @@protoc_insertion_point(field_get:contract.ContractEvent.contract)
+  return contract_.GetNoArena();
+}
+inline void ContractEvent::set_contract(const ::std::string& value) {
+  
+  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractEvent.contract)
+}
+#if LANG_CXX11
+inline void ContractEvent::set_contract(::std::string&& value) {
+  
+  contract_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractEvent.contract)
+}
+#endif
+inline void ContractEvent::set_contract(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractEvent.contract)
+}
+inline void ContractEvent::set_contract(const char* value, size_t size) {
+  
+  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractEvent.contract)
+}
+inline ::std::string* ContractEvent::mutable_contract() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.ContractEvent.contract)
+  return contract_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ContractEvent::release_contract() {
+  // @@protoc_insertion_point(field_release:contract.ContractEvent.contract)
+  
+  return contract_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ContractEvent::set_allocated_contract(::std::string* contract) {
+  if (contract != nullptr) {
+    
+  } else {
+    
+  }
+  contract_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), contract);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractEvent.contract)
+}
+
+// string name = 2;
+inline void ContractEvent::clear_name() {
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractEvent::name() const {
+  // @@protoc_insertion_point(field_get:contract.ContractEvent.name)
+  return name_.GetNoArena();
+}
+inline void ContractEvent::set_name(const ::std::string& value) {
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractEvent.name)
+}
+#if LANG_CXX11
+inline void ContractEvent::set_name(::std::string&& value) {
+  
+  name_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractEvent.name)
+}
+#endif
+inline void ContractEvent::set_name(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractEvent.name)
+}
+inline void ContractEvent::set_name(const char* value, size_t size) {
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractEvent.name)
+}
+inline ::std::string* ContractEvent::mutable_name() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.ContractEvent.name)
+  return name_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ContractEvent::release_name() {
+  // @@protoc_insertion_point(field_release:contract.ContractEvent.name)
+  
+  return name_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ContractEvent::set_allocated_name(::std::string* name) {
+  if (name != nullptr) {
+    
+  } else {
+    
+  }
+  name_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), name);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractEvent.name)
+}
+
+// bytes body = 3;
+inline void ContractEvent::clear_body() {
+  body_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractEvent::body() const {
+  // @@protoc_insertion_point(field_get:contract.ContractEvent.body)
+  return body_.GetNoArena();
+}
+inline void ContractEvent::set_body(const ::std::string& value) {
+  
+  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractEvent.body)
+}
+#if LANG_CXX11
+inline void ContractEvent::set_body(::std::string&& value) {
+  
+  body_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractEvent.body)
+}
+#endif
+inline void ContractEvent::set_body(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractEvent.body)
+}
+inline void ContractEvent::set_body(const void* value, size_t size) {
+  
+  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractEvent.body)
+}
+inline ::std::string* ContractEvent::mutable_body() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.ContractEvent.body)
+  return body_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ContractEvent::release_body() {
+  // @@protoc_insertion_point(field_release:contract.ContractEvent.body)
+  
+  return body_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ContractEvent::set_allocated_body(::std::string* body) {
+  if (body != nullptr) {
+    
+  } else {
+    
+  }
+  body_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), body);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractEvent.body)
+}
+
+// -------------------------------------------------------------------
+
+// EmitEventRequest
+
+// .contract.SyscallHeader header = 1;
+inline bool EmitEventRequest::has_header() const {
+  return this != internal_default_instance() && header_ != nullptr;
+}
+inline void EmitEventRequest::clear_header() {
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+}
+inline const ::contract::SyscallHeader& EmitEventRequest::header() const {
+  const ::contract::SyscallHeader* p = header_;
+  // @@protoc_insertion_point(field_get:contract.EmitEventRequest.header)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
+      &::contract::_SyscallHeader_default_instance_);
+}
+inline ::contract::SyscallHeader* EmitEventRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.EmitEventRequest.header)
+  
+  ::contract::SyscallHeader* temp = header_;
+  header_ = nullptr;
+  return temp;
+}
+inline ::contract::SyscallHeader* EmitEventRequest::mutable_header() {
+  
+  if (header_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
+    header_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.EmitEventRequest.header)
+  return header_;
+}
+inline void EmitEventRequest::set_allocated_header(::contract::SyscallHeader* header) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete header_;
+  }
+  if (header) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      header = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, header, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  header_ = header;
+  // @@protoc_insertion_point(field_set_allocated:contract.EmitEventRequest.header)
+}
+
+// string name = 2;
+inline void EmitEventRequest::clear_name() {
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& EmitEventRequest::name() const {
+  // @@protoc_insertion_point(field_get:contract.EmitEventRequest.name)
+  return name_.GetNoArena();
+}
+inline void EmitEventRequest::set_name(const ::std::string& value) {
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.EmitEventRequest.name)
+}
+#if LANG_CXX11
+inline void EmitEventRequest::set_name(::std::string&& value) {
+  
+  name_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.EmitEventRequest.name)
+}
+#endif
+inline void EmitEventRequest::set_name(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.EmitEventRequest.name)
+}
+inline void EmitEventRequest::set_name(const char* value, size_t size) {
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.EmitEventRequest.name)
+}
+inline ::std::string* EmitEventRequest::mutable_name() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.EmitEventRequest.name)
+  return name_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* EmitEventRequest::release_name() {
+  // @@protoc_insertion_point(field_release:contract.EmitEventRequest.name)
+  
+  return name_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void EmitEventRequest::set_allocated_name(::std::string* name) {
+  if (name != nullptr) {
+    
+  } else {
+    
+  }
+  name_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), name);
+  // @@protoc_insertion_point(field_set_allocated:contract.EmitEventRequest.name)
+}
+
+// bytes body = 3;
+inline void EmitEventRequest::clear_body() {
+  body_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& EmitEventRequest::body() const {
+  // @@protoc_insertion_point(field_get:contract.EmitEventRequest.body)
+  return body_.GetNoArena();
+}
+inline void EmitEventRequest::set_body(const ::std::string& value) {
+  
+  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.EmitEventRequest.body)
+}
+#if LANG_CXX11
+inline void EmitEventRequest::set_body(::std::string&& value) {
+  
+  body_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.EmitEventRequest.body)
+}
+#endif
+inline void EmitEventRequest::set_body(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.EmitEventRequest.body)
+}
+inline void EmitEventRequest::set_body(const void* value, size_t size) {
+  
+  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.EmitEventRequest.body)
+}
+inline ::std::string* EmitEventRequest::mutable_body() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.EmitEventRequest.body)
+  return body_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* EmitEventRequest::release_body() {
+  // @@protoc_insertion_point(field_release:contract.EmitEventRequest.body)
+  
+  return body_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void EmitEventRequest::set_allocated_body(::std::string* body) {
+  if (body != nullptr) {
+    
+  } else {
+    
+  }
+  body_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), body);
+  // @@protoc_insertion_point(field_set_allocated:contract.EmitEventRequest.body)
+}
+
+// -------------------------------------------------------------------
+
+// EmitEventResponse
+
+// -------------------------------------------------------------------
+
+// ContractCallRequest
+
+// .contract.SyscallHeader header = 1;
+inline bool ContractCallRequest::has_header() const {
+  return this != internal_default_instance() && header_ != nullptr;
+}
+inline void ContractCallRequest::clear_header() {
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+}
+inline const ::contract::SyscallHeader& ContractCallRequest::header() const {
+  const ::contract::SyscallHeader* p = header_;
+  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.header)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
+      &::contract::_SyscallHeader_default_instance_);
+}
+inline ::contract::SyscallHeader* ContractCallRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.ContractCallRequest.header)
+  
+  ::contract::SyscallHeader* temp = header_;
+  header_ = nullptr;
+  return temp;
+}
+inline ::contract::SyscallHeader* ContractCallRequest::mutable_header() {
+  
+  if (header_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
+    header_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.header)
+  return header_;
+}
+inline void ContractCallRequest::set_allocated_header(::contract::SyscallHeader* header) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete header_;
+  }
+  if (header) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      header = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, header, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  header_ = header;
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallRequest.header)
+}
+
+// string module = 2;
+inline void ContractCallRequest::clear_module() {
+  module_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractCallRequest::module() const {
+  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.module)
+  return module_.GetNoArena();
+}
+inline void ContractCallRequest::set_module(const ::std::string& value) {
+  
+  module_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractCallRequest.module)
+}
+#if LANG_CXX11
+inline void ContractCallRequest::set_module(::std::string&& value) {
+  
+  module_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractCallRequest.module)
+}
 #endif
-inline void ArgPair::set_key(const char* value) {
+inline void ContractCallRequest::set_module(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.ArgPair.key)
+  module_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractCallRequest.module)
 }
-inline void ArgPair::set_key(const char* value, size_t size) {
+inline void ContractCallRequest::set_module(const char* value, size_t size) {
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  module_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.ArgPair.key)
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractCallRequest.module)
 }
-inline ::std::string* ArgPair::mutable_key() {
+inline ::std::string* ContractCallRequest::mutable_module() {
   
-  // @@protoc_insertion_point(field_mutable:contract.ArgPair.key)
-  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.module)
+  return module_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ContractCallRequest::release_module() {
+  // @@protoc_insertion_point(field_release:contract.ContractCallRequest.module)
+  
+  return module_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ContractCallRequest::set_allocated_module(::std::string* module) {
+  if (module != nullptr) {
+    
+  } else {
+    
+  }
+  module_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), module);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallRequest.module)
+}
+
+// string contract = 3;
+inline void ContractCallRequest::clear_contract() {
+  contract_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractCallRequest::contract() const {
+  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.contract)
+  return contract_.GetNoArena();
+}
+inline void ContractCallRequest::set_contract(const ::std::string& value) {
+  
+  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractCallRequest.contract)
+}
+#if LANG_CXX11
+inline void ContractCallRequest::set_contract(::std::string&& value) {
+  
+  contract_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractCallRequest.contract)
+}
+#endif
+inline void ContractCallRequest::set_contract(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractCallRequest.contract)
+}
+inline void ContractCallRequest::set_contract(const char* value, size_t size) {
+  
+  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractCallRequest.contract)
+}
+inline ::std::string* ContractCallRequest::mutable_contract() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.contract)
+  return contract_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ContractCallRequest::release_contract() {
+  // @@protoc_insertion_point(field_release:contract.ContractCallRequest.contract)
+  
+  return contract_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ContractCallRequest::set_allocated_contract(::std::string* contract) {
+  if (contract != nullptr) {
+    
+  } else {
+    
+  }
+  contract_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), contract);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallRequest.contract)
+}
+
+// string method = 4;
+inline void ContractCallRequest::clear_method() {
+  method_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractCallRequest::method() const {
+  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.method)
+  return method_.GetNoArena();
+}
+inline void ContractCallRequest::set_method(const ::std::string& value) {
+  
+  method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractCallRequest.method)
+}
+#if LANG_CXX11
+inline void ContractCallRequest::set_method(::std::string&& value) {
+  
+  method_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractCallRequest.method)
+}
+#endif
+inline void ContractCallRequest::set_method(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractCallRequest.method)
+}
+inline void ContractCallRequest::set_method(const char* value, size_t size) {
+  
+  method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractCallRequest.method)
+}
+inline ::std::string* ContractCallRequest::mutable_method() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.method)
+  return method_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ContractCallRequest::release_method() {
+  // @@protoc_insertion_point(field_release:contract.ContractCallRequest.method)
+  
+  return method_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ContractCallRequest::set_allocated_method(::std::string* method) {
+  if (method != nullptr) {
+    
+  } else {
+    
+  }
+  method_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), method);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallRequest.method)
+}
+
+// repeated .contract.ArgPair args = 5;
+inline int ContractCallRequest::args_size() const {
+  return args_.size();
+}
+inline void ContractCallRequest::clear_args() {
+  args_.Clear();
+}
+inline ::contract::ArgPair* ContractCallRequest::mutable_args(int index) {
+  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.args)
+  return args_.Mutable(index);
+}
+inline ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >*
+ContractCallRequest::mutable_args() {
+  // @@protoc_insertion_point(field_mutable_list:contract.ContractCallRequest.args)
+  return &args_;
+}
+inline const ::contract::ArgPair& ContractCallRequest::args(int index) const {
+  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.args)
+  return args_.Get(index);
+}
+inline ::contract::ArgPair* ContractCallRequest::add_args() {
+  // @@protoc_insertion_point(field_add:contract.ContractCallRequest.args)
+  return args_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >&
+ContractCallRequest::args() const {
+  // @@protoc_insertion_point(field_list:contract.ContractCallRequest.args)
+  return args_;
+}
+
+// -------------------------------------------------------------------
+
+// ContractCallResponse
+
+// .contract.Response response = 1;
+inline bool ContractCallResponse::has_response() const {
+  return this != internal_default_instance() && response_ != nullptr;
+}
+inline void ContractCallResponse::clear_response() {
+  if (GetArenaNoVirtual() == nullptr && response_ != nullptr) {
+    delete response_;
+  }
+  response_ = nullptr;
+}
+inline const ::contract::Response& ContractCallResponse::response() const {
+  const ::contract::Response* p = response_;
+  // @@protoc_insertion_point(field_get:contract.ContractCallResponse.response)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::Response*>(
+      &::contract::_Response_default_instance_);
+}
+inline ::contract::Response* ContractCallResponse::release_response() {
+  // @@protoc_insertion_point(field_release:contract.ContractCallResponse.response)
+  
+  ::contract::Response* temp = response_;
+  response_ = nullptr;
+  return temp;
+}
+inline ::contract::Response* ContractCallResponse::mutable_response() {
+  
+  if (response_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::Response>(GetArenaNoVirtual());
+    response_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.ContractCallResponse.response)
+  return response_;
+}
+inline void ContractCallResponse::set_allocated_response(::contract::Response* response) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete response_;
+  }
+  if (response) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      response = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, response, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  response_ = response;
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallResponse.response)
+}
+
+// -------------------------------------------------------------------
+
+// Response
+
+// int32 status = 1;
+inline void Response::clear_status() {
+  status_ = 0;
 }
-inline ::std::string* ArgPair::release_key() {
-  // @@protoc_insertion_point(field_release:contract.ArgPair.key)
-  
-  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::google::protobuf::int32 Response::status() const {
+  // @@protoc_insertion_point(field_get:contract.Response.status)
+  return status_;
 }
-inline void ArgPair::set_allocated_key(::std::string* key) {
-  if (key != nullptr) {
-    
-  } else {
-    
-  }
-  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
-  // @@protoc_insertion_point(field_set_allocated:contract.ArgPair.key)
+inline void Response::set_status(::google::protobuf::int32 value) {
+  
+  status_ = value;
+  // @@protoc_insertion_point(field_set:contract.Response.status)
 }
 
-// bytes value = 2;
-inline void ArgPair::clear_value() {
-  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// string message = 2;
+inline void Response::clear_message() {
+  message_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& ArgPair::value() const {
-  // @@protoc_insertion_point(field_get:contract.ArgPair.value)
-  return value_.GetNoArena();
+inline const ::std::string& Response::message() const {
+  // @@protoc_insertion_point(field_get:contract.Response.message)
+  return message_.GetNoArena();
 }
-inline void ArgPair::set_value(const ::std::string& value) {
+inline void Response::set_message(const ::std::string& value) {
   
-  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.ArgPair.value)
+  message_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.Response.message)
 }
 #if LANG_CXX11
-inline void ArgPair::set_value(::std::string&& value) {
+inline void Response::set_message(::std::string&& value) {
   
-  value_.SetNoArena(
+  message_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.ArgPair.value)
+  // @@protoc_insertion_point(field_set_rvalue:contract.Response.message)
 }
 #endif
-inline void ArgPair::set_value(const char* value) {
+inline void Response::set_message(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.ArgPair.value)
+  message_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.Response.message)
 }
-inline void ArgPair::set_value(const void* value, size_t size) {
+inline void Response::set_message(const char* value, size_t size) {
   
-  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  message_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.ArgPair.value)
+  // @@protoc_insertion_point(field_set_pointer:contract.Response.message)
 }
-inline ::std::string* ArgPair::mutable_value() {
+inline ::std::string* Response::mutable_message() {
   
-  // @@protoc_insertion_point(field_mutable:contract.ArgPair.value)
-  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.Response.message)
+  return message_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* ArgPair::release_value() {
-  // @@protoc_insertion_point(field_release:contract.ArgPair.value)
+inline ::std::string* Response::release_message() {
+  // @@protoc_insertion_point(field_release:contract.Response.message)
   
-  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return message_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void ArgPair::set_allocated_value(::std::string* value) {
-  if (value != nullptr) {
+inline void Response::set_allocated_message(::std::string* message) {
+  if (message != nullptr) {
     
   } else {
     
   }
-  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set_allocated:contract.ArgPair.value)
+  message_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), message);
+  // @@protoc_insertion_point(field_set_allocated:contract.Response.message)
 }
 
-// -------------------------------------------------------------------
-
-// CallArgs
-
-// string method = 1;
-inline void CallArgs::clear_method() {
-  method_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// bytes body = 3;
+inline void Response::clear_body() {
+  body_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& CallArgs::method() const {
-  // @@protoc_insertion_point(field_get:contract.CallArgs.method)
-  return method_.GetNoArena();
+inline const ::std::string& Response::body() const {
+  // @@protoc_insertion_point(field_get:contract.Response.body)
+  return body_.GetNoArena();
 }
-inline void CallArgs::set_method(const ::std::string& value) {
+inline void Response::set_body(const ::std::string& value) {
   
-  method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.CallArgs.method)
+  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.Response.body)
 }
 #if LANG_CXX11
-inline void CallArgs::set_method(::std::string&& value) {
+inline void Response::set_body(::std::string&& value) {
   
-  method_.SetNoArena(
+  body_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.CallArgs.method)
+  // @@protoc_insertion_point(field_set_rvalue:contract.Response.body)
 }
 #endif
-inline void CallArgs::set_method(const char* value) {
+inline void Response::set_body(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.CallArgs.method)
+  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.Response.body)
 }
-inline void CallArgs::set_method(const char* value, size_t size) {
+inline void Response::set_body(const void* value, size_t size) {
   
-  method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.CallArgs.method)
+  // @@protoc_insertion_point(field_set_pointer:contract.Response.body)
 }
-inline ::std::string* CallArgs::mutable_method() {
+inline ::std::string* Response::mutable_body() {
   
-  // @@protoc_insertion_point(field_mutable:contract.CallArgs.method)
-  return method_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.Response.body)
+  return body_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* CallArgs::release_method() {
-  // @@protoc_insertion_point(field_release:contract.CallArgs.method)
+inline ::std::string* Response::release_body() {
+  // @@protoc_insertion_point(field_release:contract.Response.body)
   
-  return method_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return body_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void CallArgs::set_allocated_method(::std::string* method) {
-  if (method != nullptr) {
+inline void Response::set_allocated_body(::std::string* body) {
+  if (body != nullptr) {
     
   } else {
     
   }
-  method_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), method);
-  // @@protoc_insertion_point(field_set_allocated:contract.CallArgs.method)
+  body_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), body);
+  // @@protoc_insertion_point(field_set_allocated:contract.Response.body)
 }
 
-// repeated .contract.ArgPair args = 2;
-inline int CallArgs::args_size() const {
-  return args_.size();
-}
-inline void CallArgs::clear_args() {
-  args_.Clear();
+// -------------------------------------------------------------------
+
+// SetOutputRequest
+
+// .contract.SyscallHeader header = 1;
+inline bool SetOutputRequest::has_header() const {
+  return this != internal_default_instance() && header_ != nullptr;
 }
-inline ::contract::ArgPair* CallArgs::mutable_args(int index) {
-  // @@protoc_insertion_point(field_mutable:contract.CallArgs.args)
-  return args_.Mutable(index);
+inline void SetOutputRequest::clear_header() {
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
 }
-inline ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >*
-CallArgs::mutable_args() {
-  // @@protoc_insertion_point(field_mutable_list:contract.CallArgs.args)
-  return &args_;
+inline const ::contract::SyscallHeader& SetOutputRequest::header() const {
+  const ::contract::SyscallHeader* p = header_;
+  // @@protoc_insertion_point(field_get:contract.SetOutputRequest.header)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
+      &::contract::_SyscallHeader_default_instance_);
 }
-inline const ::contract::ArgPair& CallArgs::args(int index) const {
-  // @@protoc_insertion_point(field_get:contract.CallArgs.args)
-  return args_.Get(index);
+inline ::contract::SyscallHeader* SetOutputRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.SetOutputRequest.header)
+  
+  ::contract::SyscallHeader* temp = header_;
+  header_ = nullptr;
+  return temp;
 }
-inline ::contract::ArgPair* CallArgs::add_args() {
-  // @@protoc_insertion_point(field_add:contract.CallArgs.args)
-  return args_.Add();
+inline ::contract::SyscallHeader* SetOutputRequest::mutable_header() {
+  
+  if (header_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
+    header_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.SetOutputRequest.header)
+  return header_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >&
-CallArgs::args() const {
-  // @@protoc_insertion_point(field_list:contract.CallArgs.args)
-  return args_;
+inline void SetOutputRequest::set_allocated_header(::contract::SyscallHeader* header) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete header_;
+  }
+  if (header) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      header = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, header, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  header_ = header;
+  // @@protoc_insertion_point(field_set_allocated:contract.SetOutputRequest.header)
 }
 
-// string caller = 3;
-inline void CallArgs::clear_caller() {
-  caller_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-}
-inline const ::std::string& CallArgs::caller() const {
-  // @@protoc_insertion_point(field_get:contract.CallArgs.caller)
-  return caller_.GetNoArena();
-}
-inline void CallArgs::set_caller(const ::std::string& value) {
-  
-  caller_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.CallArgs.caller)
-}
-#if LANG_CXX11
-inline void CallArgs::set_caller(::std::string&& value) {
-  
-  caller_.SetNoArena(
-    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.CallArgs.caller)
+// .contract.Response response = 2;
+inline bool SetOutputRequest::has_response() const {
+  return this != internal_default_instance() && response_ != nullptr;
 }
-#endif
-inline void CallArgs::set_caller(const char* value) {
-  GOOGLE_DCHECK(value != nullptr);
-  
-  caller_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.CallArgs.caller)
+inline void SetOutputRequest::clear_response() {
+  if (GetArenaNoVirtual() == nullptr && response_ != nullptr) {
+    delete response_;
+  }
+  response_ = nullptr;
 }
-inline void CallArgs::set_caller(const char* value, size_t size) {
-  
-  caller_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.CallArgs.caller)
+inline const ::contract::Response& SetOutputRequest::response() const {
+  const ::contract::Response* p = response_;
+  // @@protoc_insertion_point(field_get:contract.SetOutputRequest.response)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::Response*>(
+      &::contract::_Response_default_instance_);
 }
-inline ::std::string* CallArgs::mutable_caller() {
+inline ::contract::Response* SetOutputRequest::release_response() {
+  // @@protoc_insertion_point(field_release:contract.SetOutputRequest.response)
   
-  // @@protoc_insertion_point(field_mutable:contract.CallArgs.caller)
-  return caller_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::contract::Response* temp = response_;
+  response_ = nullptr;
+  return temp;
 }
-inline ::std::string* CallArgs::release_caller() {
-  // @@protoc_insertion_point(field_release:contract.CallArgs.caller)
+inline ::contract::Response* SetOutputRequest::mutable_response() {
   
-  return caller_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (response_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::Response>(GetArenaNoVirtual());
+    response_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.SetOutputRequest.response)
+  return response_;
 }
-inline void CallArgs::set_allocated_caller(::std::string* caller) {
-  if (caller != nullptr) {
+inline void SetOutputRequest::set_allocated_response(::contract::Response* response) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete response_;
+  }
+  if (response) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      response = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, response, submessage_arena);
+    }
     
   } else {
     
   }
-  caller_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), caller);
-  // @@protoc_insertion_point(field_set_allocated:contract.CallArgs.caller)
+  response_ = response;
+  // @@protoc_insertion_point(field_set_allocated:contract.SetOutputRequest.response)
 }
 
 // -------------------------------------------------------------------
 
-// SyscallHeader
-
-// int64 ctxid = 1;
-inline void SyscallHeader::clear_ctxid() {
-  ctxid_ = PROTOBUF_LONGLONG(0);
-}
-inline ::google::protobuf::int64 SyscallHeader::ctxid() const {
-  // @@protoc_insertion_point(field_get:contract.SyscallHeader.ctxid)
-  return ctxid_;
-}
-inline void SyscallHeader::set_ctxid(::google::protobuf::int64 value) {
-  
-  ctxid_ = value;
-  // @@protoc_insertion_point(field_set:contract.SyscallHeader.ctxid)
-}
+// SetOutputResponse
 
 // -------------------------------------------------------------------
 
-// PutRequest
+// GetCallArgsRequest
 
 // .contract.SyscallHeader header = 1;
-inline bool PutRequest::has_header() const {
+inline bool GetCallArgsRequest::has_header() const {
   return this != internal_default_instance() && header_ != nullptr;
 }
-inline void PutRequest::clear_header() {
+inline void GetCallArgsRequest::clear_header() {
   if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
     delete header_;
   }
   header_ = nullptr;
 }
-inline const ::contract::SyscallHeader& PutRequest::header() const {
+inline const ::contract::SyscallHeader& GetCallArgsRequest::header() const {
   const ::contract::SyscallHeader* p = header_;
-  // @@protoc_insertion_point(field_get:contract.PutRequest.header)
+  // @@protoc_insertion_point(field_get:contract.GetCallArgsRequest.header)
   return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
       &::contract::_SyscallHeader_default_instance_);
 }
-inline ::contract::SyscallHeader* PutRequest::release_header() {
-  // @@protoc_insertion_point(field_release:contract.PutRequest.header)
+inline ::contract::SyscallHeader* GetCallArgsRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.GetCallArgsRequest.header)
   
   ::contract::SyscallHeader* temp = header_;
   header_ = nullptr;
   return temp;
 }
-inline ::contract::SyscallHeader* PutRequest::mutable_header() {
+inline ::contract::SyscallHeader* GetCallArgsRequest::mutable_header() {
   
   if (header_ == nullptr) {
     auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
     header_ = p;
   }
-  // @@protoc_insertion_point(field_mutable:contract.PutRequest.header)
+  // @@protoc_insertion_point(field_mutable:contract.GetCallArgsRequest.header)
   return header_;
 }
-inline void PutRequest::set_allocated_header(::contract::SyscallHeader* header) {
+inline void GetCallArgsRequest::set_allocated_header(::contract::SyscallHeader* header) {
   ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
   if (message_arena == nullptr) {
     delete header_;
@@ -2551,156 +6675,239 @@ inline void PutRequest::set_allocated_header(::contract::SyscallHeader* header)
     
   }
   header_ = header;
-  // @@protoc_insertion_point(field_set_allocated:contract.PutRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:contract.GetCallArgsRequest.header)
 }
 
-// bytes key = 2;
-inline void PutRequest::clear_key() {
-  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// -------------------------------------------------------------------
+
+// Environment
+
+// int64 height = 1;
+inline void Environment::clear_height() {
+  height_ = PROTOBUF_LONGLONG(0);
 }
-inline const ::std::string& PutRequest::key() const {
-  // @@protoc_insertion_point(field_get:contract.PutRequest.key)
-  return key_.GetNoArena();
+inline ::google::protobuf::int64 Environment::height() const {
+  // @@protoc_insertion_point(field_get:contract.Environment.height)
+  return height_;
 }
-inline void PutRequest::set_key(const ::std::string& value) {
+inline void Environment::set_height(::google::protobuf::int64 value) {
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.PutRequest.key)
+  height_ = value;
+  // @@protoc_insertion_point(field_set:contract.Environment.height)
+}
+
+// int64 timestamp = 2;
+inline void Environment::clear_timestamp() {
+  timestamp_ = PROTOBUF_LONGLONG(0);
+}
+inline ::google::protobuf::int64 Environment::timestamp() const {
+  // @@protoc_insertion_point(field_get:contract.Environment.timestamp)
+  return timestamp_;
+}
+inline void Environment::set_timestamp(::google::protobuf::int64 value) {
+  
+  timestamp_ = value;
+  // @@protoc_insertion_point(field_set:contract.Environment.timestamp)
+}
+
+// string txid = 3;
+inline void Environment::clear_txid() {
+  txid_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& Environment::txid() const {
+  // @@protoc_insertion_point(field_get:contract.Environment.txid)
+  return txid_.GetNoArena();
+}
+inline void Environment::set_txid(const ::std::string& value) {
+  
+  txid_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.Environment.txid)
 }
 #if LANG_CXX11
-inline void PutRequest::set_key(::std::string&& value) {
+inline void Environment::set_txid(::std::string&& value) {
   
-  key_.SetNoArena(
+  txid_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.PutRequest.key)
+  // @@protoc_insertion_point(field_set_rvalue:contract.Environment.txid)
 }
 #endif
-inline void PutRequest::set_key(const char* value) {
+inline void Environment::set_txid(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.PutRequest.key)
+  txid_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.Environment.txid)
 }
-inline void PutRequest::set_key(const void* value, size_t size) {
+inline void Environment::set_txid(const char* value, size_t size) {
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  txid_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.PutRequest.key)
+  // @@protoc_insertion_point(field_set_pointer:contract.Environment.txid)
 }
-inline ::std::string* PutRequest::mutable_key() {
+inline ::std::string* Environment::mutable_txid() {
   
-  // @@protoc_insertion_point(field_mutable:contract.PutRequest.key)
-  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.Environment.txid)
+  return txid_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* PutRequest::release_key() {
-  // @@protoc_insertion_point(field_release:contract.PutRequest.key)
+inline ::std::string* Environment::release_txid() {
+  // @@protoc_insertion_point(field_release:contract.Environment.txid)
   
-  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return txid_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void PutRequest::set_allocated_key(::std::string* key) {
-  if (key != nullptr) {
+inline void Environment::set_allocated_txid(::std::string* txid) {
+  if (txid != nullptr) {
     
   } else {
     
   }
-  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
-  // @@protoc_insertion_point(field_set_allocated:contract.PutRequest.key)
+  txid_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), txid);
+  // @@protoc_insertion_point(field_set_allocated:contract.Environment.txid)
 }
 
-// bytes value = 3;
-inline void PutRequest::clear_value() {
-  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// string initiator = 4;
+inline void Environment::clear_initiator() {
+  initiator_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& PutRequest::value() const {
-  // @@protoc_insertion_point(field_get:contract.PutRequest.value)
-  return value_.GetNoArena();
+inline const ::std::string& Environment::initiator() const {
+  // @@protoc_insertion_point(field_get:contract.Environment.initiator)
+  return initiator_.GetNoArena();
 }
-inline void PutRequest::set_value(const ::std::string& value) {
+inline void Environment::set_initiator(const ::std::string& value) {
   
-  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.PutRequest.value)
+  initiator_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.Environment.initiator)
 }
 #if LANG_CXX11
-inline void PutRequest::set_value(::std::string&& value) {
+inline void Environment::set_initiator(::std::string&& value) {
   
-  value_.SetNoArena(
+  initiator_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.PutRequest.value)
+  // @@protoc_insertion_point(field_set_rvalue:contract.Environment.initiator)
 }
 #endif
-inline void PutRequest::set_value(const char* value) {
+inline void Environment::set_initiator(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.PutRequest.value)
+  initiator_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.Environment.initiator)
 }
-inline void PutRequest::set_value(const void* value, size_t size) {
+inline void Environment::set_initiator(const char* value, size_t size) {
   
-  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  initiator_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.PutRequest.value)
+  // @@protoc_insertion_point(field_set_pointer:contract.Environment.initiator)
 }
-inline ::std::string* PutRequest::mutable_value() {
+inline ::std::string* Environment::mutable_initiator() {
   
-  // @@protoc_insertion_point(field_mutable:contract.PutRequest.value)
-  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.Environment.initiator)
+  return initiator_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* PutRequest::release_value() {
-  // @@protoc_insertion_point(field_release:contract.PutRequest.value)
+inline ::std::string* Environment::release_initiator() {
+  // @@protoc_insertion_point(field_release:contract.Environment.initiator)
   
-  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return initiator_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void PutRequest::set_allocated_value(::std::string* value) {
-  if (value != nullptr) {
+inline void Environment::set_allocated_initiator(::std::string* initiator) {
+  if (initiator != nullptr) {
     
   } else {
     
   }
-  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set_allocated:contract.PutRequest.value)
+  initiator_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), initiator);
+  // @@protoc_insertion_point(field_set_allocated:contract.Environment.initiator)
 }
 
 // -------------------------------------------------------------------
 
-// PutResponse
+// GetEnvironmentRequest
+
+// .contract.SyscallHeader header = 1;
+inline bool GetEnvironmentRequest::has_header() const {
+  return this != internal_default_instance() && header_ != nullptr;
+}
+inline void GetEnvironmentRequest::clear_header() {
+  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
+    delete header_;
+  }
+  header_ = nullptr;
+}
+inline const ::contract::SyscallHeader& GetEnvironmentRequest::header() const {
+  const ::contract::SyscallHeader* p = header_;
+  // @@protoc_insertion_point(field_get:contract.GetEnvironmentRequest.header)
+  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
+      &::contract::_SyscallHeader_default_instance_);
+}
+inline ::contract::SyscallHeader* GetEnvironmentRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.GetEnvironmentRequest.header)
+  
+  ::contract::SyscallHeader* temp = header_;
+  header_ = nullptr;
+  return temp;
+}
+inline ::contract::SyscallHeader* GetEnvironmentRequest::mutable_header() {
+  
+  if (header_ == nullptr) {
+    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
+    header_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:contract.GetEnvironmentRequest.header)
+  return header_;
+}
+inline void GetEnvironmentRequest::set_allocated_header(::contract::SyscallHeader* header) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete header_;
+  }
+  if (header) {
+    ::google::protobuf::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      header = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, header, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  header_ = header;
+  // @@protoc_insertion_point(field_set_allocated:contract.GetEnvironmentRequest.header)
+}
 
 // -------------------------------------------------------------------
 
-// GetRequest
+// HashRequest
 
 // .contract.SyscallHeader header = 1;
-inline bool GetRequest::has_header() const {
+inline bool HashRequest::has_header() const {
   return this != internal_default_instance() && header_ != nullptr;
 }
-inline void GetRequest::clear_header() {
+inline void HashRequest::clear_header() {
   if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
     delete header_;
   }
   header_ = nullptr;
 }
-inline const ::contract::SyscallHeader& GetRequest::header() const {
+inline const ::contract::SyscallHeader& HashRequest::header() const {
   const ::contract::SyscallHeader* p = header_;
-  // @@protoc_insertion_point(field_get:contract.GetRequest.header)
+  // @@protoc_insertion_point(field_get:contract.HashRequest.header)
   return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
       &::contract::_SyscallHeader_default_instance_);
 }
-inline ::contract::SyscallHeader* GetRequest::release_header() {
-  // @@protoc_insertion_point(field_release:contract.GetRequest.header)
+inline ::contract::SyscallHeader* HashRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.HashRequest.header)
   
   ::contract::SyscallHeader* temp = header_;
   header_ = nullptr;
   return temp;
 }
-inline ::contract::SyscallHeader* GetRequest::mutable_header() {
+inline ::contract::SyscallHeader* HashRequest::mutable_header() {
   
   if (header_ == nullptr) {
     auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
     header_ = p;
   }
-  // @@protoc_insertion_point(field_mutable:contract.GetRequest.header)
+  // @@protoc_insertion_point(field_mutable:contract.HashRequest.header)
   return header_;
 }
-inline void GetRequest::set_allocated_header(::contract::SyscallHeader* header) {
+inline void HashRequest::set_allocated_header(::contract::SyscallHeader* header) {
   ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
   if (message_arena == nullptr) {
     delete header_;
@@ -2716,156 +6923,209 @@ inline void GetRequest::set_allocated_header(::contract::SyscallHeader* header)
     
   }
   header_ = header;
-  // @@protoc_insertion_point(field_set_allocated:contract.GetRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:contract.HashRequest.header)
 }
 
-// bytes key = 2;
-inline void GetRequest::clear_key() {
-  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// string algorithm = 2;
+inline void HashRequest::clear_algorithm() {
+  algorithm_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& GetRequest::key() const {
-  // @@protoc_insertion_point(field_get:contract.GetRequest.key)
-  return key_.GetNoArena();
+inline const ::std::string& HashRequest::algorithm() const {
+  // @@protoc_insertion_point(field_get:contract.HashRequest.algorithm)
+  return algorithm_.GetNoArena();
 }
-inline void GetRequest::set_key(const ::std::string& value) {
+inline void HashRequest::set_algorithm(const ::std::string& value) {
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.GetRequest.key)
+  algorithm_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.HashRequest.algorithm)
 }
 #if LANG_CXX11
-inline void GetRequest::set_key(::std::string&& value) {
+inline void HashRequest::set_algorithm(::std::string&& value) {
   
-  key_.SetNoArena(
+  algorithm_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.GetRequest.key)
+  // @@protoc_insertion_point(field_set_rvalue:contract.HashRequest.algorithm)
 }
 #endif
-inline void GetRequest::set_key(const char* value) {
+inline void HashRequest::set_algorithm(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.GetRequest.key)
+  algorithm_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.HashRequest.algorithm)
 }
-inline void GetRequest::set_key(const void* value, size_t size) {
+inline void HashRequest::set_algorithm(const char* value, size_t size) {
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  algorithm_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.GetRequest.key)
+  // @@protoc_insertion_point(field_set_pointer:contract.HashRequest.algorithm)
 }
-inline ::std::string* GetRequest::mutable_key() {
+inline ::std::string* HashRequest::mutable_algorithm() {
   
-  // @@protoc_insertion_point(field_mutable:contract.GetRequest.key)
-  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.HashRequest.algorithm)
+  return algorithm_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* GetRequest::release_key() {
-  // @@protoc_insertion_point(field_release:contract.GetRequest.key)
+inline ::std::string* HashRequest::release_algorithm() {
+  // @@protoc_insertion_point(field_release:contract.HashRequest.algorithm)
   
-  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return algorithm_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void GetRequest::set_allocated_key(::std::string* key) {
-  if (key != nullptr) {
+inline void HashRequest::set_allocated_algorithm(::std::string* algorithm) {
+  if (algorithm != nullptr) {
     
   } else {
     
   }
-  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
-  // @@protoc_insertion_point(field_set_allocated:contract.GetRequest.key)
+  algorithm_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), algorithm);
+  // @@protoc_insertion_point(field_set_allocated:contract.HashRequest.algorithm)
+}
+
+// bytes data = 3;
+inline void HashRequest::clear_data() {
+  data_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& HashRequest::data() const {
+  // @@protoc_insertion_point(field_get:contract.HashRequest.data)
+  return data_.GetNoArena();
+}
+inline void HashRequest::set_data(const ::std::string& value) {
+  
+  data_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.HashRequest.data)
+}
+#if LANG_CXX11
+inline void HashRequest::set_data(::std::string&& value) {
+  
+  data_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.HashRequest.data)
+}
+#endif
+inline void HashRequest::set_data(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  data_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.HashRequest.data)
+}
+inline void HashRequest::set_data(const void* value, size_t size) {
+  
+  data_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.HashRequest.data)
+}
+inline ::std::string* HashRequest::mutable_data() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.HashRequest.data)
+  return data_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* HashRequest::release_data() {
+  // @@protoc_insertion_point(field_release:contract.HashRequest.data)
+  
+  return data_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void HashRequest::set_allocated_data(::std::string* data) {
+  if (data != nullptr) {
+    
+  } else {
+    
+  }
+  data_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), data);
+  // @@protoc_insertion_point(field_set_allocated:contract.HashRequest.data)
 }
 
 // -------------------------------------------------------------------
 
-// GetResponse
+// HashResponse
 
-// bytes value = 1;
-inline void GetResponse::clear_value() {
-  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// bytes digest = 1;
+inline void HashResponse::clear_digest() {
+  digest_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& GetResponse::value() const {
-  // @@protoc_insertion_point(field_get:contract.GetResponse.value)
-  return value_.GetNoArena();
+inline const ::std::string& HashResponse::digest() const {
+  // @@protoc_insertion_point(field_get:contract.HashResponse.digest)
+  return digest_.GetNoArena();
 }
-inline void GetResponse::set_value(const ::std::string& value) {
+inline void HashResponse::set_digest(const ::std::string& value) {
   
-  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.GetResponse.value)
+  digest_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.HashResponse.digest)
 }
 #if LANG_CXX11
-inline void GetResponse::set_value(::std::string&& value) {
+inline void HashResponse::set_digest(::std::string&& value) {
   
-  value_.SetNoArena(
+  digest_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.GetResponse.value)
+  // @@protoc_insertion_point(field_set_rvalue:contract.HashResponse.digest)
 }
 #endif
-inline void GetResponse::set_value(const char* value) {
+inline void HashResponse::set_digest(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.GetResponse.value)
+  digest_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.HashResponse.digest)
 }
-inline void GetResponse::set_value(const void* value, size_t size) {
+inline void HashResponse::set_digest(const void* value, size_t size) {
   
-  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  digest_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.GetResponse.value)
+  // @@protoc_insertion_point(field_set_pointer:contract.HashResponse.digest)
 }
-inline ::std::string* GetResponse::mutable_value() {
+inline ::std::string* HashResponse::mutable_digest() {
   
-  // @@protoc_insertion_point(field_mutable:contract.GetResponse.value)
-  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.HashResponse.digest)
+  return digest_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* GetResponse::release_value() {
-  // @@protoc_insertion_point(field_release:contract.GetResponse.value)
+inline ::std::string* HashResponse::release_digest() {
+  // @@protoc_insertion_point(field_release:contract.HashResponse.digest)
   
-  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return digest_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void GetResponse::set_allocated_value(::std::string* value) {
-  if (value != nullptr) {
+inline void HashResponse::set_allocated_digest(::std::string* digest) {
+  if (digest != nullptr) {
     
   } else {
     
   }
-  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set_allocated:contract.GetResponse.value)
+  digest_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), digest);
+  // @@protoc_insertion_point(field_set_allocated:contract.HashResponse.digest)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRequest
+// VerifySignatureRequest
 
 // .contract.SyscallHeader header = 1;
-inline bool DeleteRequest::has_header() const {
+inline bool VerifySignatureRequest::has_header() const {
   return this != internal_default_instance() && header_ != nullptr;
 }
-inline void DeleteRequest::clear_header() {
+inline void VerifySignatureRequest::clear_header() {
   if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
     delete header_;
   }
   header_ = nullptr;
 }
-inline const ::contract::SyscallHeader& DeleteRequest::header() const {
+inline const ::contract::SyscallHeader& VerifySignatureRequest::header() const {
   const ::contract::SyscallHeader* p = header_;
-  // @@protoc_insertion_point(field_get:contract.DeleteRequest.header)
+  // @@protoc_insertion_point(field_get:contract.VerifySignatureRequest.header)
   return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
       &::contract::_SyscallHeader_default_instance_);
 }
-inline ::contract::SyscallHeader* DeleteRequest::release_header() {
-  // @@protoc_insertion_point(field_release:contract.DeleteRequest.header)
+inline ::contract::SyscallHeader* VerifySignatureRequest::release_header() {
+  // @@protoc_insertion_point(field_release:contract.VerifySignatureRequest.header)
   
   ::contract::SyscallHeader* temp = header_;
   header_ = nullptr;
   return temp;
 }
-inline ::contract::SyscallHeader* DeleteRequest::mutable_header() {
+inline ::contract::SyscallHeader* VerifySignatureRequest::mutable_header() {
   
   if (header_ == nullptr) {
     auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
     header_ = p;
   }
-  // @@protoc_insertion_point(field_mutable:contract.DeleteRequest.header)
+  // @@protoc_insertion_point(field_mutable:contract.VerifySignatureRequest.header)
   return header_;
 }
-inline void DeleteRequest::set_allocated_header(::contract::SyscallHeader* header) {
+inline void VerifySignatureRequest::set_allocated_header(::contract::SyscallHeader* header) {
   ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
   if (message_arena == nullptr) {
     delete header_;
@@ -2881,870 +7141,1000 @@ inline void DeleteRequest::set_allocated_header(::contract::SyscallHeader* heade
     
   }
   header_ = header;
-  // @@protoc_insertion_point(field_set_allocated:contract.DeleteRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:contract.VerifySignatureRequest.header)
 }
 
-// bytes key = 2;
-inline void DeleteRequest::clear_key() {
-  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// string algorithm = 2;
+inline void VerifySignatureRequest::clear_algorithm() {
+  algorithm_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& DeleteRequest::key() const {
-  // @@protoc_insertion_point(field_get:contract.DeleteRequest.key)
-  return key_.GetNoArena();
+inline const ::std::string& VerifySignatureRequest::algorithm() const {
+  // @@protoc_insertion_point(field_get:contract.VerifySignatureRequest.algorithm)
+  return algorithm_.GetNoArena();
 }
-inline void DeleteRequest::set_key(const ::std::string& value) {
+inline void VerifySignatureRequest::set_algorithm(const ::std::string& value) {
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.DeleteRequest.key)
+  algorithm_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.VerifySignatureRequest.algorithm)
 }
 #if LANG_CXX11
-inline void DeleteRequest::set_key(::std::string&& value) {
+inline void VerifySignatureRequest::set_algorithm(::std::string&& value) {
   
-  key_.SetNoArena(
+  algorithm_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.DeleteRequest.key)
+  // @@protoc_insertion_point(field_set_rvalue:contract.VerifySignatureRequest.algorithm)
 }
 #endif
-inline void DeleteRequest::set_key(const char* value) {
+inline void VerifySignatureRequest::set_algorithm(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.DeleteRequest.key)
+  algorithm_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.VerifySignatureRequest.algorithm)
 }
-inline void DeleteRequest::set_key(const void* value, size_t size) {
+inline void VerifySignatureRequest::set_algorithm(const char* value, size_t size) {
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  algorithm_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.DeleteRequest.key)
+  // @@protoc_insertion_point(field_set_pointer:contract.VerifySignatureRequest.algorithm)
 }
-inline ::std::string* DeleteRequest::mutable_key() {
+inline ::std::string* VerifySignatureRequest::mutable_algorithm() {
   
-  // @@protoc_insertion_point(field_mutable:contract.DeleteRequest.key)
-  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.VerifySignatureRequest.algorithm)
+  return algorithm_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* DeleteRequest::release_key() {
-  // @@protoc_insertion_point(field_release:contract.DeleteRequest.key)
+inline ::std::string* VerifySignatureRequest::release_algorithm() {
+  // @@protoc_insertion_point(field_release:contract.VerifySignatureRequest.algorithm)
   
-  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return algorithm_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void DeleteRequest::set_allocated_key(::std::string* key) {
-  if (key != nullptr) {
+inline void VerifySignatureRequest::set_allocated_algorithm(::std::string* algorithm) {
+  if (algorithm != nullptr) {
     
   } else {
     
   }
-  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
-  // @@protoc_insertion_point(field_set_allocated:contract.DeleteRequest.key)
+  algorithm_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), algorithm);
+  // @@protoc_insertion_point(field_set_allocated:contract.VerifySignatureRequest.algorithm)
 }
 
-// -------------------------------------------------------------------
-
-// DeleteResponse
-
-// -------------------------------------------------------------------
-
-// TransferRequest
-
-// .contract.SyscallHeader header = 1;
-inline bool TransferRequest::has_header() const {
-  return this != internal_default_instance() && header_ != nullptr;
+// bytes pubkey = 3;
+inline void VerifySignatureRequest::clear_pubkey() {
+  pubkey_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void TransferRequest::clear_header() {
-  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
-    delete header_;
-  }
-  header_ = nullptr;
+inline const ::std::string& VerifySignatureRequest::pubkey() const {
+  // @@protoc_insertion_point(field_get:contract.VerifySignatureRequest.pubkey)
+  return pubkey_.GetNoArena();
 }
-inline const ::contract::SyscallHeader& TransferRequest::header() const {
-  const ::contract::SyscallHeader* p = header_;
-  // @@protoc_insertion_point(field_get:contract.TransferRequest.header)
-  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
-      &::contract::_SyscallHeader_default_instance_);
+inline void VerifySignatureRequest::set_pubkey(const ::std::string& value) {
+  
+  pubkey_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.VerifySignatureRequest.pubkey)
 }
-inline ::contract::SyscallHeader* TransferRequest::release_header() {
-  // @@protoc_insertion_point(field_release:contract.TransferRequest.header)
+#if LANG_CXX11
+inline void VerifySignatureRequest::set_pubkey(::std::string&& value) {
   
-  ::contract::SyscallHeader* temp = header_;
-  header_ = nullptr;
-  return temp;
+  pubkey_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.VerifySignatureRequest.pubkey)
 }
-inline ::contract::SyscallHeader* TransferRequest::mutable_header() {
+#endif
+inline void VerifySignatureRequest::set_pubkey(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
   
-  if (header_ == nullptr) {
-    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
-    header_ = p;
-  }
-  // @@protoc_insertion_point(field_mutable:contract.TransferRequest.header)
-  return header_;
+  pubkey_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.VerifySignatureRequest.pubkey)
 }
-inline void TransferRequest::set_allocated_header(::contract::SyscallHeader* header) {
-  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
-  if (message_arena == nullptr) {
-    delete header_;
-  }
-  if (header) {
-    ::google::protobuf::Arena* submessage_arena = nullptr;
-    if (message_arena != submessage_arena) {
-      header = ::google::protobuf::internal::GetOwnedMessage(
-          message_arena, header, submessage_arena);
-    }
+inline void VerifySignatureRequest::set_pubkey(const void* value, size_t size) {
+  
+  pubkey_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.VerifySignatureRequest.pubkey)
+}
+inline ::std::string* VerifySignatureRequest::mutable_pubkey() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.VerifySignatureRequest.pubkey)
+  return pubkey_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* VerifySignatureRequest::release_pubkey() {
+  // @@protoc_insertion_point(field_release:contract.VerifySignatureRequest.pubkey)
+  
+  return pubkey_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void VerifySignatureRequest::set_allocated_pubkey(::std::string* pubkey) {
+  if (pubkey != nullptr) {
     
   } else {
     
   }
-  header_ = header;
-  // @@protoc_insertion_point(field_set_allocated:contract.TransferRequest.header)
+  pubkey_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), pubkey);
+  // @@protoc_insertion_point(field_set_allocated:contract.VerifySignatureRequest.pubkey)
 }
 
-// string from = 2;
-inline void TransferRequest::clear_from() {
-  from_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// bytes msg = 4;
+inline void VerifySignatureRequest::clear_msg() {
+  msg_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& TransferRequest::from() const {
-  // @@protoc_insertion_point(field_get:contract.TransferRequest.from)
-  return from_.GetNoArena();
+inline const ::std::string& VerifySignatureRequest::msg() const {
+  // @@protoc_insertion_point(field_get:contract.VerifySignatureRequest.msg)
+  return msg_.GetNoArena();
 }
-inline void TransferRequest::set_from(const ::std::string& value) {
+inline void VerifySignatureRequest::set_msg(const ::std::string& value) {
   
-  from_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.TransferRequest.from)
+  msg_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.VerifySignatureRequest.msg)
 }
 #if LANG_CXX11
-inline void TransferRequest::set_from(::std::string&& value) {
+inline void VerifySignatureRequest::set_msg(::std::string&& value) {
   
-  from_.SetNoArena(
+  msg_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.TransferRequest.from)
+  // @@protoc_insertion_point(field_set_rvalue:contract.VerifySignatureRequest.msg)
 }
 #endif
-inline void TransferRequest::set_from(const char* value) {
+inline void VerifySignatureRequest::set_msg(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  from_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.TransferRequest.from)
+  msg_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.VerifySignatureRequest.msg)
 }
-inline void TransferRequest::set_from(const char* value, size_t size) {
+inline void VerifySignatureRequest::set_msg(const void* value, size_t size) {
   
-  from_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  msg_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.TransferRequest.from)
+  // @@protoc_insertion_point(field_set_pointer:contract.VerifySignatureRequest.msg)
 }
-inline ::std::string* TransferRequest::mutable_from() {
+inline ::std::string* VerifySignatureRequest::mutable_msg() {
   
-  // @@protoc_insertion_point(field_mutable:contract.TransferRequest.from)
-  return from_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.VerifySignatureRequest.msg)
+  return msg_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* TransferRequest::release_from() {
-  // @@protoc_insertion_point(field_release:contract.TransferRequest.from)
+inline ::std::string* VerifySignatureRequest::release_msg() {
+  // @@protoc_insertion_point(field_release:contract.VerifySignatureRequest.msg)
   
-  return from_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return msg_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void TransferRequest::set_allocated_from(::std::string* from) {
-  if (from != nullptr) {
+inline void VerifySignatureRequest::set_allocated_msg(::std::string* msg) {
+  if (msg != nullptr) {
     
   } else {
     
   }
-  from_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from);
-  // @@protoc_insertion_point(field_set_allocated:contract.TransferRequest.from)
+  msg_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), msg);
+  // @@protoc_insertion_point(field_set_allocated:contract.VerifySignatureRequest.msg)
 }
 
-// string to = 3;
-inline void TransferRequest::clear_to() {
-  to_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// bytes sign = 5;
+inline void VerifySignatureRequest::clear_sign() {
+  sign_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& TransferRequest::to() const {
-  // @@protoc_insertion_point(field_get:contract.TransferRequest.to)
-  return to_.GetNoArena();
+inline const ::std::string& VerifySignatureRequest::sign() const {
+  // @@protoc_insertion_point(field_get:contract.VerifySignatureRequest.sign)
+  return sign_.GetNoArena();
 }
-inline void TransferRequest::set_to(const ::std::string& value) {
+inline void VerifySignatureRequest::set_sign(const ::std::string& value) {
   
-  to_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.TransferRequest.to)
+  sign_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.VerifySignatureRequest.sign)
 }
 #if LANG_CXX11
-inline void TransferRequest::set_to(::std::string&& value) {
+inline void VerifySignatureRequest::set_sign(::std::string&& value) {
   
-  to_.SetNoArena(
+  sign_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.TransferRequest.to)
+  // @@protoc_insertion_point(field_set_rvalue:contract.VerifySignatureRequest.sign)
 }
 #endif
-inline void TransferRequest::set_to(const char* value) {
+inline void VerifySignatureRequest::set_sign(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  to_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.TransferRequest.to)
+  sign_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.VerifySignatureRequest.sign)
 }
-inline void TransferRequest::set_to(const char* value, size_t size) {
+inline void VerifySignatureRequest::set_sign(const void* value, size_t size) {
   
-  to_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  sign_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.TransferRequest.to)
+  // @@protoc_insertion_point(field_set_pointer:contract.VerifySignatureRequest.sign)
 }
-inline ::std::string* TransferRequest::mutable_to() {
+inline ::std::string* VerifySignatureRequest::mutable_sign() {
   
-  // @@protoc_insertion_point(field_mutable:contract.TransferRequest.to)
-  return to_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.VerifySignatureRequest.sign)
+  return sign_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* TransferRequest::release_to() {
-  // @@protoc_insertion_point(field_release:contract.TransferRequest.to)
+inline ::std::string* VerifySignatureRequest::release_sign() {
+  // @@protoc_insertion_point(field_release:contract.VerifySignatureRequest.sign)
   
-  return to_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return sign_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void TransferRequest::set_allocated_to(::std::string* to) {
-  if (to != nullptr) {
+inline void VerifySignatureRequest::set_allocated_sign(::std::string* sign) {
+  if (sign != nullptr) {
     
   } else {
     
   }
-  to_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), to);
-  // @@protoc_insertion_point(field_set_allocated:contract.TransferRequest.to)
+  sign_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), sign);
+  // @@protoc_insertion_point(field_set_allocated:contract.VerifySignatureRequest.sign)
 }
 
-// string amount = 4;
-inline void TransferRequest::clear_amount() {
-  amount_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// -------------------------------------------------------------------
+
+// VerifySignatureResponse
+
+// bool valid = 1;
+inline void VerifySignatureResponse::clear_valid() {
+  valid_ = false;
 }
-inline const ::std::string& TransferRequest::amount() const {
-  // @@protoc_insertion_point(field_get:contract.TransferRequest.amount)
-  return amount_.GetNoArena();
+inline bool VerifySignatureResponse::valid() const {
+  // @@protoc_insertion_point(field_get:contract.VerifySignatureResponse.valid)
+  return valid_;
 }
-inline void TransferRequest::set_amount(const ::std::string& value) {
+inline void VerifySignatureResponse::set_valid(bool value) {
   
-  amount_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.TransferRequest.amount)
+  valid_ = value;
+  // @@protoc_insertion_point(field_set:contract.VerifySignatureResponse.valid)
+}
+
+// -------------------------------------------------------------------
+
+// ContractDesc
+
+// string name = 1;
+inline void ContractDesc::clear_name() {
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractDesc::name() const {
+  // @@protoc_insertion_point(field_get:contract.ContractDesc.name)
+  return name_.GetNoArena();
+}
+inline void ContractDesc::set_name(const ::std::string& value) {
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractDesc.name)
 }
 #if LANG_CXX11
-inline void TransferRequest::set_amount(::std::string&& value) {
+inline void ContractDesc::set_name(::std::string&& value) {
   
-  amount_.SetNoArena(
+  name_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.TransferRequest.amount)
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractDesc.name)
 }
 #endif
-inline void TransferRequest::set_amount(const char* value) {
+inline void ContractDesc::set_name(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  amount_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.TransferRequest.amount)
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractDesc.name)
 }
-inline void TransferRequest::set_amount(const char* value, size_t size) {
+inline void ContractDesc::set_name(const char* value, size_t size) {
   
-  amount_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.TransferRequest.amount)
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractDesc.name)
 }
-inline ::std::string* TransferRequest::mutable_amount() {
+inline ::std::string* ContractDesc::mutable_name() {
   
-  // @@protoc_insertion_point(field_mutable:contract.TransferRequest.amount)
-  return amount_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.ContractDesc.name)
+  return name_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* TransferRequest::release_amount() {
-  // @@protoc_insertion_point(field_release:contract.TransferRequest.amount)
+inline ::std::string* ContractDesc::release_name() {
+  // @@protoc_insertion_point(field_release:contract.ContractDesc.name)
   
-  return amount_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return name_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void TransferRequest::set_allocated_amount(::std::string* amount) {
-  if (amount != nullptr) {
+inline void ContractDesc::set_allocated_name(::std::string* name) {
+  if (name != nullptr) {
     
   } else {
     
   }
-  amount_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), amount);
-  // @@protoc_insertion_point(field_set_allocated:contract.TransferRequest.amount)
+  name_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), name);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractDesc.name)
 }
 
-// -------------------------------------------------------------------
-
-// TransferResponse
-
-// -------------------------------------------------------------------
-
-// ContractCallRequest
-
-// .contract.SyscallHeader header = 1;
-inline bool ContractCallRequest::has_header() const {
-  return this != internal_default_instance() && header_ != nullptr;
+// string language = 2;
+inline void ContractDesc::clear_language() {
+  language_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void ContractCallRequest::clear_header() {
-  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
-    delete header_;
+inline const ::std::string& ContractDesc::language() const {
+  // @@protoc_insertion_point(field_get:contract.ContractDesc.language)
+  return language_.GetNoArena();
+}
+inline void ContractDesc::set_language(const ::std::string& value) {
+  
+  language_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractDesc.language)
+}
+#if LANG_CXX11
+inline void ContractDesc::set_language(::std::string&& value) {
+  
+  language_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractDesc.language)
+}
+#endif
+inline void ContractDesc::set_language(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  language_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractDesc.language)
+}
+inline void ContractDesc::set_language(const char* value, size_t size) {
+  
+  language_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractDesc.language)
+}
+inline ::std::string* ContractDesc::mutable_language() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.ContractDesc.language)
+  return language_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ContractDesc::release_language() {
+  // @@protoc_insertion_point(field_release:contract.ContractDesc.language)
+  
+  return language_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ContractDesc::set_allocated_language(::std::string* language) {
+  if (language != nullptr) {
+    
+  } else {
+    
   }
-  header_ = nullptr;
+  language_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), language);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractDesc.language)
+}
+
+// bytes code_hash = 3;
+inline void ContractDesc::clear_code_hash() {
+  code_hash_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractDesc::code_hash() const {
+  // @@protoc_insertion_point(field_get:contract.ContractDesc.code_hash)
+  return code_hash_.GetNoArena();
+}
+inline void ContractDesc::set_code_hash(const ::std::string& value) {
+  
+  code_hash_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractDesc.code_hash)
+}
+#if LANG_CXX11
+inline void ContractDesc::set_code_hash(::std::string&& value) {
+  
+  code_hash_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractDesc.code_hash)
+}
+#endif
+inline void ContractDesc::set_code_hash(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  code_hash_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractDesc.code_hash)
 }
-inline const ::contract::SyscallHeader& ContractCallRequest::header() const {
-  const ::contract::SyscallHeader* p = header_;
-  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.header)
-  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
-      &::contract::_SyscallHeader_default_instance_);
+inline void ContractDesc::set_code_hash(const void* value, size_t size) {
+  
+  code_hash_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractDesc.code_hash)
 }
-inline ::contract::SyscallHeader* ContractCallRequest::release_header() {
-  // @@protoc_insertion_point(field_release:contract.ContractCallRequest.header)
+inline ::std::string* ContractDesc::mutable_code_hash() {
   
-  ::contract::SyscallHeader* temp = header_;
-  header_ = nullptr;
-  return temp;
+  // @@protoc_insertion_point(field_mutable:contract.ContractDesc.code_hash)
+  return code_hash_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::contract::SyscallHeader* ContractCallRequest::mutable_header() {
+inline ::std::string* ContractDesc::release_code_hash() {
+  // @@protoc_insertion_point(field_release:contract.ContractDesc.code_hash)
   
-  if (header_ == nullptr) {
-    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
-    header_ = p;
-  }
-  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.header)
-  return header_;
+  return code_hash_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void ContractCallRequest::set_allocated_header(::contract::SyscallHeader* header) {
-  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
-  if (message_arena == nullptr) {
-    delete header_;
-  }
-  if (header) {
-    ::google::protobuf::Arena* submessage_arena = nullptr;
-    if (message_arena != submessage_arena) {
-      header = ::google::protobuf::internal::GetOwnedMessage(
-          message_arena, header, submessage_arena);
-    }
+inline void ContractDesc::set_allocated_code_hash(::std::string* code_hash) {
+  if (code_hash != nullptr) {
     
   } else {
     
   }
-  header_ = header;
-  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallRequest.header)
+  code_hash_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), code_hash);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractDesc.code_hash)
 }
 
-// string module = 2;
-inline void ContractCallRequest::clear_module() {
-  module_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// int64 code_size = 4;
+inline void ContractDesc::clear_code_size() {
+  code_size_ = PROTOBUF_LONGLONG(0);
 }
-inline const ::std::string& ContractCallRequest::module() const {
-  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.module)
-  return module_.GetNoArena();
+inline ::google::protobuf::int64 ContractDesc::code_size() const {
+  // @@protoc_insertion_point(field_get:contract.ContractDesc.code_size)
+  return code_size_;
 }
-inline void ContractCallRequest::set_module(const ::std::string& value) {
+inline void ContractDesc::set_code_size(::google::protobuf::int64 value) {
   
-  module_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.ContractCallRequest.module)
+  code_size_ = value;
+  // @@protoc_insertion_point(field_set:contract.ContractDesc.code_size)
+}
+
+// string deployer = 5;
+inline void ContractDesc::clear_deployer() {
+  deployer_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractDesc::deployer() const {
+  // @@protoc_insertion_point(field_get:contract.ContractDesc.deployer)
+  return deployer_.GetNoArena();
+}
+inline void ContractDesc::set_deployer(const ::std::string& value) {
+  
+  deployer_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractDesc.deployer)
 }
 #if LANG_CXX11
-inline void ContractCallRequest::set_module(::std::string&& value) {
+inline void ContractDesc::set_deployer(::std::string&& value) {
   
-  module_.SetNoArena(
+  deployer_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.ContractCallRequest.module)
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractDesc.deployer)
 }
 #endif
-inline void ContractCallRequest::set_module(const char* value) {
+inline void ContractDesc::set_deployer(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  module_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.ContractCallRequest.module)
+  deployer_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractDesc.deployer)
 }
-inline void ContractCallRequest::set_module(const char* value, size_t size) {
+inline void ContractDesc::set_deployer(const char* value, size_t size) {
   
-  module_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  deployer_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.ContractCallRequest.module)
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractDesc.deployer)
 }
-inline ::std::string* ContractCallRequest::mutable_module() {
+inline ::std::string* ContractDesc::mutable_deployer() {
   
-  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.module)
-  return module_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.ContractDesc.deployer)
+  return deployer_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* ContractCallRequest::release_module() {
-  // @@protoc_insertion_point(field_release:contract.ContractCallRequest.module)
+inline ::std::string* ContractDesc::release_deployer() {
+  // @@protoc_insertion_point(field_release:contract.ContractDesc.deployer)
   
-  return module_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return deployer_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void ContractCallRequest::set_allocated_module(::std::string* module) {
-  if (module != nullptr) {
+inline void ContractDesc::set_allocated_deployer(::std::string* deployer) {
+  if (deployer != nullptr) {
     
   } else {
     
   }
-  module_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), module);
-  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallRequest.module)
+  deployer_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), deployer);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractDesc.deployer)
 }
 
-// string contract = 3;
-inline void ContractCallRequest::clear_contract() {
-  contract_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// int64 deploy_time = 6;
+inline void ContractDesc::clear_deploy_time() {
+  deploy_time_ = PROTOBUF_LONGLONG(0);
 }
-inline const ::std::string& ContractCallRequest::contract() const {
-  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.contract)
-  return contract_.GetNoArena();
+inline ::google::protobuf::int64 ContractDesc::deploy_time() const {
+  // @@protoc_insertion_point(field_get:contract.ContractDesc.deploy_time)
+  return deploy_time_;
 }
-inline void ContractCallRequest::set_contract(const ::std::string& value) {
+inline void ContractDesc::set_deploy_time(::google::protobuf::int64 value) {
   
-  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.ContractCallRequest.contract)
+  deploy_time_ = value;
+  // @@protoc_insertion_point(field_set:contract.ContractDesc.deploy_time)
+}
+
+// int64 version = 7;
+inline void ContractDesc::clear_version() {
+  version_ = PROTOBUF_LONGLONG(0);
+}
+inline ::google::protobuf::int64 ContractDesc::version() const {
+  // @@protoc_insertion_point(field_get:contract.ContractDesc.version)
+  return version_;
+}
+inline void ContractDesc::set_version(::google::protobuf::int64 value) {
+  
+  version_ = value;
+  // @@protoc_insertion_point(field_set:contract.ContractDesc.version)
+}
+
+// bytes abi = 8;
+inline void ContractDesc::clear_abi() {
+  abi_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& ContractDesc::abi() const {
+  // @@protoc_insertion_point(field_get:contract.ContractDesc.abi)
+  return abi_.GetNoArena();
+}
+inline void ContractDesc::set_abi(const ::std::string& value) {
+  
+  abi_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractDesc.abi)
 }
 #if LANG_CXX11
-inline void ContractCallRequest::set_contract(::std::string&& value) {
+inline void ContractDesc::set_abi(::std::string&& value) {
   
-  contract_.SetNoArena(
+  abi_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.ContractCallRequest.contract)
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractDesc.abi)
 }
 #endif
-inline void ContractCallRequest::set_contract(const char* value) {
+inline void ContractDesc::set_abi(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.ContractCallRequest.contract)
+  abi_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractDesc.abi)
 }
-inline void ContractCallRequest::set_contract(const char* value, size_t size) {
+inline void ContractDesc::set_abi(const void* value, size_t size) {
   
-  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  abi_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.ContractCallRequest.contract)
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractDesc.abi)
 }
-inline ::std::string* ContractCallRequest::mutable_contract() {
+inline ::std::string* ContractDesc::mutable_abi() {
   
-  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.contract)
-  return contract_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.ContractDesc.abi)
+  return abi_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* ContractCallRequest::release_contract() {
-  // @@protoc_insertion_point(field_release:contract.ContractCallRequest.contract)
+inline ::std::string* ContractDesc::release_abi() {
+  // @@protoc_insertion_point(field_release:contract.ContractDesc.abi)
   
-  return contract_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return abi_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void ContractCallRequest::set_allocated_contract(::std::string* contract) {
-  if (contract != nullptr) {
+inline void ContractDesc::set_allocated_abi(::std::string* abi) {
+  if (abi != nullptr) {
     
   } else {
     
   }
-  contract_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), contract);
-  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallRequest.contract)
+  abi_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), abi);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractDesc.abi)
 }
 
-// string method = 4;
-inline void ContractCallRequest::clear_method() {
+// bool non_reentrant = 9;
+inline void ContractDesc::clear_non_reentrant() {
+  non_reentrant_ = false;
+}
+inline bool ContractDesc::non_reentrant() const {
+  // @@protoc_insertion_point(field_get:contract.ContractDesc.non_reentrant)
+  return non_reentrant_;
+}
+inline void ContractDesc::set_non_reentrant(bool value) {
+  
+  non_reentrant_ = value;
+  // @@protoc_insertion_point(field_set:contract.ContractDesc.non_reentrant)
+}
+
+// -------------------------------------------------------------------
+
+// MethodACL
+
+// string method = 1;
+inline void MethodACL::clear_method() {
   method_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& ContractCallRequest::method() const {
-  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.method)
+inline const ::std::string& MethodACL::method() const {
+  // @@protoc_insertion_point(field_get:contract.MethodACL.method)
   return method_.GetNoArena();
 }
-inline void ContractCallRequest::set_method(const ::std::string& value) {
+inline void MethodACL::set_method(const ::std::string& value) {
   
   method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.ContractCallRequest.method)
+  // @@protoc_insertion_point(field_set:contract.MethodACL.method)
 }
 #if LANG_CXX11
-inline void ContractCallRequest::set_method(::std::string&& value) {
+inline void MethodACL::set_method(::std::string&& value) {
   
   method_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.ContractCallRequest.method)
+  // @@protoc_insertion_point(field_set_rvalue:contract.MethodACL.method)
 }
 #endif
-inline void ContractCallRequest::set_method(const char* value) {
+inline void MethodACL::set_method(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
   method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.ContractCallRequest.method)
+  // @@protoc_insertion_point(field_set_char:contract.MethodACL.method)
 }
-inline void ContractCallRequest::set_method(const char* value, size_t size) {
+inline void MethodACL::set_method(const char* value, size_t size) {
   
   method_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.ContractCallRequest.method)
+  // @@protoc_insertion_point(field_set_pointer:contract.MethodACL.method)
 }
-inline ::std::string* ContractCallRequest::mutable_method() {
+inline ::std::string* MethodACL::mutable_method() {
   
-  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.method)
+  // @@protoc_insertion_point(field_mutable:contract.MethodACL.method)
   return method_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* ContractCallRequest::release_method() {
-  // @@protoc_insertion_point(field_release:contract.ContractCallRequest.method)
+inline ::std::string* MethodACL::release_method() {
+  // @@protoc_insertion_point(field_release:contract.MethodACL.method)
   
   return method_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void ContractCallRequest::set_allocated_method(::std::string* method) {
+inline void MethodACL::set_allocated_method(::std::string* method) {
   if (method != nullptr) {
     
   } else {
     
   }
   method_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), method);
-  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallRequest.method)
+  // @@protoc_insertion_point(field_set_allocated:contract.MethodACL.method)
 }
 
-// repeated .contract.ArgPair args = 5;
-inline int ContractCallRequest::args_size() const {
-  return args_.size();
+// repeated string callers = 2;
+inline int MethodACL::callers_size() const {
+  return callers_.size();
 }
-inline void ContractCallRequest::clear_args() {
-  args_.Clear();
+inline void MethodACL::clear_callers() {
+  callers_.Clear();
 }
-inline ::contract::ArgPair* ContractCallRequest::mutable_args(int index) {
-  // @@protoc_insertion_point(field_mutable:contract.ContractCallRequest.args)
-  return args_.Mutable(index);
+inline const ::std::string& MethodACL::callers(int index) const {
+  // @@protoc_insertion_point(field_get:contract.MethodACL.callers)
+  return callers_.Get(index);
 }
-inline ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >*
-ContractCallRequest::mutable_args() {
-  // @@protoc_insertion_point(field_mutable_list:contract.ContractCallRequest.args)
-  return &args_;
+inline ::std::string* MethodACL::mutable_callers(int index) {
+  // @@protoc_insertion_point(field_mutable:contract.MethodACL.callers)
+  return callers_.Mutable(index);
 }
-inline const ::contract::ArgPair& ContractCallRequest::args(int index) const {
-  // @@protoc_insertion_point(field_get:contract.ContractCallRequest.args)
-  return args_.Get(index);
+inline void MethodACL::set_callers(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:contract.MethodACL.callers)
+  callers_.Mutable(index)->assign(value);
 }
-inline ::contract::ArgPair* ContractCallRequest::add_args() {
-  // @@protoc_insertion_point(field_add:contract.ContractCallRequest.args)
-  return args_.Add();
+#if LANG_CXX11
+inline void MethodACL::set_callers(int index, ::std::string&& value) {
+  // @@protoc_insertion_point(field_set:contract.MethodACL.callers)
+  callers_.Mutable(index)->assign(std::move(value));
 }
-inline const ::google::protobuf::RepeatedPtrField< ::contract::ArgPair >&
-ContractCallRequest::args() const {
-  // @@protoc_insertion_point(field_list:contract.ContractCallRequest.args)
-  return args_;
+#endif
+inline void MethodACL::set_callers(int index, const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  callers_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:contract.MethodACL.callers)
 }
-
-// -------------------------------------------------------------------
-
-// ContractCallResponse
-
-// .contract.Response response = 1;
-inline bool ContractCallResponse::has_response() const {
-  return this != internal_default_instance() && response_ != nullptr;
+inline void MethodACL::set_callers(int index, const char* value, size_t size) {
+  callers_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:contract.MethodACL.callers)
 }
-inline void ContractCallResponse::clear_response() {
-  if (GetArenaNoVirtual() == nullptr && response_ != nullptr) {
-    delete response_;
-  }
-  response_ = nullptr;
+inline ::std::string* MethodACL::add_callers() {
+  // @@protoc_insertion_point(field_add_mutable:contract.MethodACL.callers)
+  return callers_.Add();
 }
-inline const ::contract::Response& ContractCallResponse::response() const {
-  const ::contract::Response* p = response_;
-  // @@protoc_insertion_point(field_get:contract.ContractCallResponse.response)
-  return p != nullptr ? *p : *reinterpret_cast<const ::contract::Response*>(
-      &::contract::_Response_default_instance_);
+inline void MethodACL::add_callers(const ::std::string& value) {
+  callers_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:contract.MethodACL.callers)
 }
-inline ::contract::Response* ContractCallResponse::release_response() {
-  // @@protoc_insertion_point(field_release:contract.ContractCallResponse.response)
-  
-  ::contract::Response* temp = response_;
-  response_ = nullptr;
-  return temp;
+#if LANG_CXX11
+inline void MethodACL::add_callers(::std::string&& value) {
+  callers_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:contract.MethodACL.callers)
 }
-inline ::contract::Response* ContractCallResponse::mutable_response() {
-  
-  if (response_ == nullptr) {
-    auto* p = CreateMaybeMessage<::contract::Response>(GetArenaNoVirtual());
-    response_ = p;
-  }
-  // @@protoc_insertion_point(field_mutable:contract.ContractCallResponse.response)
-  return response_;
+#endif
+inline void MethodACL::add_callers(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  callers_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:contract.MethodACL.callers)
 }
-inline void ContractCallResponse::set_allocated_response(::contract::Response* response) {
-  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
-  if (message_arena == nullptr) {
-    delete response_;
-  }
-  if (response) {
-    ::google::protobuf::Arena* submessage_arena = nullptr;
-    if (message_arena != submessage_arena) {
-      response = ::google::protobuf::internal::GetOwnedMessage(
-          message_arena, response, submessage_arena);
-    }
-    
-  } else {
-    
-  }
-  response_ = response;
-  // @@protoc_insertion_point(field_set_allocated:contract.ContractCallResponse.response)
+inline void MethodACL::add_callers(const char* value, size_t size) {
+  callers_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:contract.MethodACL.callers)
+}
+inline const ::google::protobuf::RepeatedPtrField<::std::string>&
+MethodACL::callers() const {
+  // @@protoc_insertion_point(field_list:contract.MethodACL.callers)
+  return callers_;
+}
+inline ::google::protobuf::RepeatedPtrField<::std::string>*
+MethodACL::mutable_callers() {
+  // @@protoc_insertion_point(field_mutable_list:contract.MethodACL.callers)
+  return &callers_;
 }
 
-// -------------------------------------------------------------------
-
-// Response
-
-// int32 status = 1;
-inline void Response::clear_status() {
-  status_ = 0;
+// repeated string roles = 3;
+inline int MethodACL::roles_size() const {
+  return roles_.size();
 }
-inline ::google::protobuf::int32 Response::status() const {
-  // @@protoc_insertion_point(field_get:contract.Response.status)
-  return status_;
+inline void MethodACL::clear_roles() {
+  roles_.Clear();
 }
-inline void Response::set_status(::google::protobuf::int32 value) {
-  
-  status_ = value;
-  // @@protoc_insertion_point(field_set:contract.Response.status)
+inline const ::std::string& MethodACL::roles(int index) const {
+  // @@protoc_insertion_point(field_get:contract.MethodACL.roles)
+  return roles_.Get(index);
+}
+inline ::std::string* MethodACL::mutable_roles(int index) {
+  // @@protoc_insertion_point(field_mutable:contract.MethodACL.roles)
+  return roles_.Mutable(index);
+}
+inline void MethodACL::set_roles(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:contract.MethodACL.roles)
+  roles_.Mutable(index)->assign(value);
+}
+#if LANG_CXX11
+inline void MethodACL::set_roles(int index, ::std::string&& value) {
+  // @@protoc_insertion_point(field_set:contract.MethodACL.roles)
+  roles_.Mutable(index)->assign(std::move(value));
+}
+#endif
+inline void MethodACL::set_roles(int index, const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  roles_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:contract.MethodACL.roles)
+}
+inline void MethodACL::set_roles(int index, const char* value, size_t size) {
+  roles_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:contract.MethodACL.roles)
+}
+inline ::std::string* MethodACL::add_roles() {
+  // @@protoc_insertion_point(field_add_mutable:contract.MethodACL.roles)
+  return roles_.Add();
+}
+inline void MethodACL::add_roles(const ::std::string& value) {
+  roles_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:contract.MethodACL.roles)
+}
+#if LANG_CXX11
+inline void MethodACL::add_roles(::std::string&& value) {
+  roles_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:contract.MethodACL.roles)
+}
+#endif
+inline void MethodACL::add_roles(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  roles_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:contract.MethodACL.roles)
+}
+inline void MethodACL::add_roles(const char* value, size_t size) {
+  roles_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:contract.MethodACL.roles)
+}
+inline const ::google::protobuf::RepeatedPtrField<::std::string>&
+MethodACL::roles() const {
+  // @@protoc_insertion_point(field_list:contract.MethodACL.roles)
+  return roles_;
+}
+inline ::google::protobuf::RepeatedPtrField<::std::string>*
+MethodACL::mutable_roles() {
+  // @@protoc_insertion_point(field_mutable_list:contract.MethodACL.roles)
+  return &roles_;
 }
 
-// string message = 2;
-inline void Response::clear_message() {
-  message_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// -------------------------------------------------------------------
+
+// ACLRole
+
+// string name = 1;
+inline void ACLRole::clear_name() {
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& Response::message() const {
-  // @@protoc_insertion_point(field_get:contract.Response.message)
-  return message_.GetNoArena();
+inline const ::std::string& ACLRole::name() const {
+  // @@protoc_insertion_point(field_get:contract.ACLRole.name)
+  return name_.GetNoArena();
 }
-inline void Response::set_message(const ::std::string& value) {
+inline void ACLRole::set_name(const ::std::string& value) {
   
-  message_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.Response.message)
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ACLRole.name)
 }
 #if LANG_CXX11
-inline void Response::set_message(::std::string&& value) {
+inline void ACLRole::set_name(::std::string&& value) {
   
-  message_.SetNoArena(
+  name_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.Response.message)
+  // @@protoc_insertion_point(field_set_rvalue:contract.ACLRole.name)
 }
 #endif
-inline void Response::set_message(const char* value) {
+inline void ACLRole::set_name(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
   
-  message_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.Response.message)
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ACLRole.name)
 }
-inline void Response::set_message(const char* value, size_t size) {
+inline void ACLRole::set_name(const char* value, size_t size) {
   
-  message_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.Response.message)
+  // @@protoc_insertion_point(field_set_pointer:contract.ACLRole.name)
 }
-inline ::std::string* Response::mutable_message() {
+inline ::std::string* ACLRole::mutable_name() {
   
-  // @@protoc_insertion_point(field_mutable:contract.Response.message)
-  return message_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:contract.ACLRole.name)
+  return name_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* Response::release_message() {
-  // @@protoc_insertion_point(field_release:contract.Response.message)
+inline ::std::string* ACLRole::release_name() {
+  // @@protoc_insertion_point(field_release:contract.ACLRole.name)
   
-  return message_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return name_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void Response::set_allocated_message(::std::string* message) {
-  if (message != nullptr) {
+inline void ACLRole::set_allocated_name(::std::string* name) {
+  if (name != nullptr) {
     
   } else {
     
   }
-  message_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), message);
-  // @@protoc_insertion_point(field_set_allocated:contract.Response.message)
+  name_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), name);
+  // @@protoc_insertion_point(field_set_allocated:contract.ACLRole.name)
 }
 
-// bytes body = 3;
-inline void Response::clear_body() {
-  body_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// repeated string members = 2;
+inline int ACLRole::members_size() const {
+  return members_.size();
 }
-inline const ::std::string& Response::body() const {
-  // @@protoc_insertion_point(field_get:contract.Response.body)
-  return body_.GetNoArena();
+inline void ACLRole::clear_members() {
+  members_.Clear();
 }
-inline void Response::set_body(const ::std::string& value) {
-  
-  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:contract.Response.body)
+inline const ::std::string& ACLRole::members(int index) const {
+  // @@protoc_insertion_point(field_get:contract.ACLRole.members)
+  return members_.Get(index);
+}
+inline ::std::string* ACLRole::mutable_members(int index) {
+  // @@protoc_insertion_point(field_mutable:contract.ACLRole.members)
+  return members_.Mutable(index);
+}
+inline void ACLRole::set_members(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:contract.ACLRole.members)
+  members_.Mutable(index)->assign(value);
 }
 #if LANG_CXX11
-inline void Response::set_body(::std::string&& value) {
-  
-  body_.SetNoArena(
-    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:contract.Response.body)
+inline void ACLRole::set_members(int index, ::std::string&& value) {
+  // @@protoc_insertion_point(field_set:contract.ACLRole.members)
+  members_.Mutable(index)->assign(std::move(value));
 }
 #endif
-inline void Response::set_body(const char* value) {
+inline void ACLRole::set_members(int index, const char* value) {
   GOOGLE_DCHECK(value != nullptr);
-  
-  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:contract.Response.body)
+  members_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:contract.ACLRole.members)
 }
-inline void Response::set_body(const void* value, size_t size) {
-  
-  body_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:contract.Response.body)
+inline void ACLRole::set_members(int index, const char* value, size_t size) {
+  members_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:contract.ACLRole.members)
 }
-inline ::std::string* Response::mutable_body() {
-  
-  // @@protoc_insertion_point(field_mutable:contract.Response.body)
-  return body_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::std::string* ACLRole::add_members() {
+  // @@protoc_insertion_point(field_add_mutable:contract.ACLRole.members)
+  return members_.Add();
 }
-inline ::std::string* Response::release_body() {
-  // @@protoc_insertion_point(field_release:contract.Response.body)
-  
-  return body_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline void ACLRole::add_members(const ::std::string& value) {
+  members_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:contract.ACLRole.members)
 }
-inline void Response::set_allocated_body(::std::string* body) {
-  if (body != nullptr) {
-    
-  } else {
-    
-  }
-  body_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), body);
-  // @@protoc_insertion_point(field_set_allocated:contract.Response.body)
+#if LANG_CXX11
+inline void ACLRole::add_members(::std::string&& value) {
+  members_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:contract.ACLRole.members)
+}
+#endif
+inline void ACLRole::add_members(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  members_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:contract.ACLRole.members)
+}
+inline void ACLRole::add_members(const char* value, size_t size) {
+  members_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:contract.ACLRole.members)
+}
+inline const ::google::protobuf::RepeatedPtrField<::std::string>&
+ACLRole::members() const {
+  // @@protoc_insertion_point(field_list:contract.ACLRole.members)
+  return members_;
+}
+inline ::google::protobuf::RepeatedPtrField<::std::string>*
+ACLRole::mutable_members() {
+  // @@protoc_insertion_point(field_mutable_list:contract.ACLRole.members)
+  return &members_;
 }
 
 // -------------------------------------------------------------------
 
-// SetOutputRequest
+// ContractACL
 
-// .contract.SyscallHeader header = 1;
-inline bool SetOutputRequest::has_header() const {
-  return this != internal_default_instance() && header_ != nullptr;
+// string contract = 1;
+inline void ContractACL::clear_contract() {
+  contract_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void SetOutputRequest::clear_header() {
-  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
-    delete header_;
-  }
-  header_ = nullptr;
+inline const ::std::string& ContractACL::contract() const {
+  // @@protoc_insertion_point(field_get:contract.ContractACL.contract)
+  return contract_.GetNoArena();
 }
-inline const ::contract::SyscallHeader& SetOutputRequest::header() const {
-  const ::contract::SyscallHeader* p = header_;
-  // @@protoc_insertion_point(field_get:contract.SetOutputRequest.header)
-  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
-      &::contract::_SyscallHeader_default_instance_);
+inline void ContractACL::set_contract(const ::std::string& value) {
+  
+  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:contract.ContractACL.contract)
 }
-inline ::contract::SyscallHeader* SetOutputRequest::release_header() {
-  // @@protoc_insertion_point(field_release:contract.SetOutputRequest.header)
+#if LANG_CXX11
+inline void ContractACL::set_contract(::std::string&& value) {
   
-  ::contract::SyscallHeader* temp = header_;
-  header_ = nullptr;
-  return temp;
+  contract_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:contract.ContractACL.contract)
 }
-inline ::contract::SyscallHeader* SetOutputRequest::mutable_header() {
+#endif
+inline void ContractACL::set_contract(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
   
-  if (header_ == nullptr) {
-    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
-    header_ = p;
-  }
-  // @@protoc_insertion_point(field_mutable:contract.SetOutputRequest.header)
-  return header_;
+  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:contract.ContractACL.contract)
 }
-inline void SetOutputRequest::set_allocated_header(::contract::SyscallHeader* header) {
-  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
-  if (message_arena == nullptr) {
-    delete header_;
-  }
-  if (header) {
-    ::google::protobuf::Arena* submessage_arena = nullptr;
-    if (message_arena != submessage_arena) {
-      header = ::google::protobuf::internal::GetOwnedMessage(
-          message_arena, header, submessage_arena);
-    }
+inline void ContractACL::set_contract(const char* value, size_t size) {
+  
+  contract_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:contract.ContractACL.contract)
+}
+inline ::std::string* ContractACL::mutable_contract() {
+  
+  // @@protoc_insertion_point(field_mutable:contract.ContractACL.contract)
+  return contract_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ContractACL::release_contract() {
+  // @@protoc_insertion_point(field_release:contract.ContractACL.contract)
+  
+  return contract_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ContractACL::set_allocated_contract(::std::string* contract) {
+  if (contract != nullptr) {
     
   } else {
     
   }
-  header_ = header;
-  // @@protoc_insertion_point(field_set_allocated:contract.SetOutputRequest.header)
+  contract_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), contract);
+  // @@protoc_insertion_point(field_set_allocated:contract.ContractACL.contract)
 }
 
-// .contract.Response response = 2;
-inline bool SetOutputRequest::has_response() const {
-  return this != internal_default_instance() && response_ != nullptr;
+// repeated .contract.MethodACL methods = 2;
+inline int ContractACL::methods_size() const {
+  return methods_.size();
 }
-inline void SetOutputRequest::clear_response() {
-  if (GetArenaNoVirtual() == nullptr && response_ != nullptr) {
-    delete response_;
-  }
-  response_ = nullptr;
+inline void ContractACL::clear_methods() {
+  methods_.Clear();
 }
-inline const ::contract::Response& SetOutputRequest::response() const {
-  const ::contract::Response* p = response_;
-  // @@protoc_insertion_point(field_get:contract.SetOutputRequest.response)
-  return p != nullptr ? *p : *reinterpret_cast<const ::contract::Response*>(
-      &::contract::_Response_default_instance_);
+inline ::contract::MethodACL* ContractACL::mutable_methods(int index) {
+  // @@protoc_insertion_point(field_mutable:contract.ContractACL.methods)
+  return methods_.Mutable(index);
 }
-inline ::contract::Response* SetOutputRequest::release_response() {
-  // @@protoc_insertion_point(field_release:contract.SetOutputRequest.response)
-  
-  ::contract::Response* temp = response_;
-  response_ = nullptr;
-  return temp;
+inline ::google::protobuf::RepeatedPtrField< ::contract::MethodACL >*
+ContractACL::mutable_methods() {
+  // @@protoc_insertion_point(field_mutable_list:contract.ContractACL.methods)
+  return &methods_;
 }
-inline ::contract::Response* SetOutputRequest::mutable_response() {
-  
-  if (response_ == nullptr) {
-    auto* p = CreateMaybeMessage<::contract::Response>(GetArenaNoVirtual());
-    response_ = p;
-  }
-  // @@protoc_insertion_point(field_mutable:contract.SetOutputRequest.response)
-  return response_;
+inline const ::contract::MethodACL& ContractACL::methods(int index) const {
+  // @@protoc_insertion_point(field_get:contract.ContractACL.methods)
+  return methods_.Get(index);
 }
-inline void SetOutputRequest::set_allocated_response(::contract::Response* response) {
-  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
-  if (message_arena == nullptr) {
-    delete response_;
-  }
-  if (response) {
-    ::google::protobuf::Arena* submessage_arena = nullptr;
-    if (message_arena != submessage_arena) {
-      response = ::google::protobuf::internal::GetOwnedMessage(
-          message_arena, response, submessage_arena);
-    }
-    
-  } else {
-    
-  }
-  response_ = response;
-  // @@protoc_insertion_point(field_set_allocated:contract.SetOutputRequest.response)
+inline ::contract::MethodACL* ContractACL::add_methods() {
+  // @@protoc_insertion_point(field_add:contract.ContractACL.methods)
+  return methods_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::contract::MethodACL >&
+ContractACL::methods() const {
+  // @@protoc_insertion_point(field_list:contract.ContractACL.methods)
+  return methods_;
 }
 
-// -------------------------------------------------------------------
-
-// SetOutputResponse
-
-// -------------------------------------------------------------------
-
-// GetCallArgsRequest
-
-// .contract.SyscallHeader header = 1;
-inline bool GetCallArgsRequest::has_header() const {
-  return this != internal_default_instance() && header_ != nullptr;
+// repeated .contract.ACLRole roles = 3;
+inline int ContractACL::roles_size() const {
+  return roles_.size();
 }
-inline void GetCallArgsRequest::clear_header() {
-  if (GetArenaNoVirtual() == nullptr && header_ != nullptr) {
-    delete header_;
-  }
-  header_ = nullptr;
+inline void ContractACL::clear_roles() {
+  roles_.Clear();
 }
-inline const ::contract::SyscallHeader& GetCallArgsRequest::header() const {
-  const ::contract::SyscallHeader* p = header_;
-  // @@protoc_insertion_point(field_get:contract.GetCallArgsRequest.header)
-  return p != nullptr ? *p : *reinterpret_cast<const ::contract::SyscallHeader*>(
-      &::contract::_SyscallHeader_default_instance_);
+inline ::contract::ACLRole* ContractACL::mutable_roles(int index) {
+  // @@protoc_insertion_point(field_mutable:contract.ContractACL.roles)
+  return roles_.Mutable(index);
 }
-inline ::contract::SyscallHeader* GetCallArgsRequest::release_header() {
-  // @@protoc_insertion_point(field_release:contract.GetCallArgsRequest.header)
-  
-  ::contract::SyscallHeader* temp = header_;
-  header_ = nullptr;
-  return temp;
+inline ::google::protobuf::RepeatedPtrField< ::contract::ACLRole >*
+ContractACL::mutable_roles() {
+  // @@protoc_insertion_point(field_mutable_list:contract.ContractACL.roles)
+  return &roles_;
 }
-inline ::contract::SyscallHeader* GetCallArgsRequest::mutable_header() {
-  
-  if (header_ == nullptr) {
-    auto* p = CreateMaybeMessage<::contract::SyscallHeader>(GetArenaNoVirtual());
-    header_ = p;
-  }
-  // @@protoc_insertion_point(field_mutable:contract.GetCallArgsRequest.header)
-  return header_;
+inline const ::contract::ACLRole& ContractACL::roles(int index) const {
+  // @@protoc_insertion_point(field_get:contract.ContractACL.roles)
+  return roles_.Get(index);
 }
-inline void GetCallArgsRequest::set_allocated_header(::contract::SyscallHeader* header) {
-  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
-  if (message_arena == nullptr) {
-    delete header_;
-  }
-  if (header) {
-    ::google::protobuf::Arena* submessage_arena = nullptr;
-    if (message_arena != submessage_arena) {
-      header = ::google::protobuf::internal::GetOwnedMessage(
-          message_arena, header, submessage_arena);
-    }
-    
-  } else {
-    
-  }
-  header_ = header;
-  // @@protoc_insertion_point(field_set_allocated:contract.GetCallArgsRequest.header)
+inline ::contract::ACLRole* ContractACL::add_roles() {
+  // @@protoc_insertion_point(field_add:contract.ContractACL.roles)
+  return roles_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::contract::ACLRole >&
+ContractACL::roles() const {
+  // @@protoc_insertion_point(field_list:contract.ContractACL.roles)
+  return roles_;
 }
 
 #ifdef __GNUC__
@@ -3782,6 +8172,38 @@ inline void GetCallArgsRequest::set_allocated_header(::contract::SyscallHeader*
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
@@ -1,12 +1,54 @@
 package code
 
+import "math/big"
+
 // GetContractState is the context in which the contract runs
 type Context interface {
 	Caller() string
 	Args() map[string][]byte
 	Method() string
+	// BlockHeight, Timestamp, TxID and Initiator describe the execution environment
+	BlockHeight() int64
+	Timestamp() int64
+	TxID() string
+	Initiator() string
 	PutObject(key []byte, value []byte) error
 	GetObject(key []byte) ([]byte, error)
 	DeleteObject(key []byte) error
+	NewIterator(start, limit []byte) Iterator
+	Transfer(to string, amount *big.Int) error
+	EmitEvent(name string, body []byte) error
+	// Sha256, Keccak256 and Ripemd160 compute digests by the host, which is much cheaper than doing it in wasm
+	Sha256(data []byte) ([]byte, error)
+	Keccak256(data []byte) ([]byte, error)
+	Ripemd160(data []byte) ([]byte, error)
+	// VerifyEd25519 verifies an ed25519 signature of msg
+	VerifyEd25519(pubkey, msg, sign []byte) (bool, error)
+	// VerifySecp256k1 verifies a DER or 64 bytes r||s signature of a 32 bytes digest
+	VerifySecp256k1(pubkey, digest, sign []byte) (bool, error)
 	Call(module, contract, method string, args map[string][]byte) (*Response, error)
 }
+
+// Iterator iterates over key-value pairs of the contract storage in key order
+type Iterator interface {
+	Next() bool
+	Key() []byte
+	Value() []byte
+	Error() error
+	Close()
+}
+
+// PrefixRange returns the key range [start, limit) that covers all keys with the given prefix
+func PrefixRange(prefix []byte) ([]byte, []byte) {
+	var limit []byte
+	for i := len(prefix) - 1; i >= 0; i-- {
+		c := prefix[i]
+		if c < 0xff {
+			limit = make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i] = c + 1
+			break
+		}
+	}
+	return prefix, limit
+}
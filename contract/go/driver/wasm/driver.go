@@ -3,10 +3,15 @@
 package wasm
 
 import (
+	"syscall/js"
+
 	"github.com/BeDreamCoder/uwavm/contract/go/code"
 	"github.com/BeDreamCoder/uwavm/contract/go/exec"
 )
 
+// defaultHandler handles the methods without their own handler, such as the optional migrate
+const defaultHandler = "*"
+
 type driver struct {
 }
 
@@ -15,7 +20,18 @@ func New() code.Driver {
 	return new(driver)
 }
 
+// Serve 通过syscall/js.FuncOf为合约的方法注册回调后等待调用，合约实例在多次调用之间保持存活，
+// 每次调用前内存恢复到注册完回调时的状态，合约的状态必须通过GetObject读取
 func (d *driver) Serve(contract code.Contract) {
 	initDebugLog()
-	exec.RunContract(0, contract, syscall)
+	handler := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		exec.RunContract(0, contract, syscall)
+		return nil
+	})
+	registry := js.Global().Get("contract")
+	for _, method := range exec.ContractMethods(contract) {
+		registry.Call("register", method, handler)
+	}
+	registry.Call("register", defaultHandler, handler)
+	select {}
 }
@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"math/big"
+	"strings"
 
 	"github.com/BeDreamCoder/uwavm/contract/go/code"
 	"github.com/BeDreamCoder/uwavm/contract/go/driver"
@@ -42,11 +43,17 @@ func (x *xdb) Map(name string) *xmap {
 	}
 }
 
+// SetContext 合约实例在多次调用之间保持存活，每次调用重新加载状态并丢弃上次调用缓存的map数据
 func (x *xdb) SetContext(ctx code.Context) {
 	x.ctx = ctx
 	for key, n := range x.dirty {
+		if strings.HasPrefix(key, typeMap) {
+			delete(x.dirty, key)
+			continue
+		}
+		n.SetInt64(0)
 		value, err := ctx.GetObject([]byte(key))
-		if err == nil {
+		if err == nil && value != nil {
 			n.SetString(string(value), 10)
 		}
 	}
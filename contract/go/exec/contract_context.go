@@ -2,6 +2,7 @@ package exec
 
 import (
 	"log"
+	"math/big"
 
 	"github.com/BeDreamCoder/uwavm/contract/go/code"
 	"github.com/BeDreamCoder/uwavm/contract/go/pb"
@@ -13,11 +14,18 @@ const (
 	methodDelete       = "DeleteObject"
 	methodOutput       = "SetOutput"
 	methodGetCallArgs  = "GetCallArgs"
+	methodTransfer     = "Transfer"
+	methodIterator     = "NewIterator"
+	methodEmitEvent    = "EmitEvent"
+	methodGetEnv       = "GetEnvironment"
+	methodHash         = "Hash"
+	methodVerify       = "VerifySignature"
 	methodContractCall = "ContractCall"
 )
 
 type contractContext struct {
 	callArgs       pb.CallArgs
+	env            pb.Environment
 	contractArgs   map[string][]byte
 	bridgeCallFunc BridgeCallFunc
 	header         pb.SyscallHeader
@@ -43,7 +51,10 @@ func (c *contractContext) Init() error {
 	for _, pair := range c.callArgs.GetArgs() {
 		c.contractArgs[pair.GetKey()] = pair.GetValue()
 	}
-	return nil
+
+	var envRequest pb.GetEnvironmentRequest
+	envRequest.Header = &c.header
+	return c.bridgeCallFunc(methodGetEnv, &envRequest, &c.env)
 }
 
 func (c *contractContext) Method() string {
@@ -58,6 +69,22 @@ func (c *contractContext) Caller() string {
 	return c.callArgs.Caller
 }
 
+func (c *contractContext) BlockHeight() int64 {
+	return c.env.Height
+}
+
+func (c *contractContext) Timestamp() int64 {
+	return c.env.Timestamp
+}
+
+func (c *contractContext) TxID() string {
+	return c.env.Txid
+}
+
+func (c *contractContext) Initiator() string {
+	return c.env.Initiator
+}
+
 func (c *contractContext) PutObject(key, value []byte) error {
 	req := &pb.PutRequest{
 		Header: &c.header,
@@ -90,6 +117,80 @@ func (c *contractContext) DeleteObject(key []byte) error {
 	return c.bridgeCallFunc(methodDelete, req, rep)
 }
 
+func (c *contractContext) NewIterator(start, limit []byte) code.Iterator {
+	return newKvIterator(c, start, limit)
+}
+
+func (c *contractContext) Transfer(to string, amount *big.Int) error {
+	req := &pb.TransferRequest{
+		Header: &c.header,
+		To:     to,
+		Amount: amount.String(),
+	}
+	rep := new(pb.TransferResponse)
+	return c.bridgeCallFunc(methodTransfer, req, rep)
+}
+
+func (c *contractContext) EmitEvent(name string, body []byte) error {
+	req := &pb.EmitEventRequest{
+		Header: &c.header,
+		Name:   name,
+		Body:   body,
+	}
+	rep := new(pb.EmitEventResponse)
+	return c.bridgeCallFunc(methodEmitEvent, req, rep)
+}
+
+func (c *contractContext) hash(algorithm string, data []byte) ([]byte, error) {
+	req := &pb.HashRequest{
+		Header:    &c.header,
+		Algorithm: algorithm,
+		Data:      data,
+	}
+	rep := new(pb.HashResponse)
+	err := c.bridgeCallFunc(methodHash, req, rep)
+	if err != nil {
+		return nil, err
+	}
+	return rep.Digest, nil
+}
+
+func (c *contractContext) Sha256(data []byte) ([]byte, error) {
+	return c.hash("sha256", data)
+}
+
+func (c *contractContext) Keccak256(data []byte) ([]byte, error) {
+	return c.hash("keccak256", data)
+}
+
+func (c *contractContext) Ripemd160(data []byte) ([]byte, error) {
+	return c.hash("ripemd160", data)
+}
+
+func (c *contractContext) verify(algorithm string, pubkey, msg, sign []byte) (bool, error) {
+	req := &pb.VerifySignatureRequest{
+		Header:    &c.header,
+		Algorithm: algorithm,
+		Pubkey:    pubkey,
+		Msg:       msg,
+		Sign:      sign,
+	}
+	rep := new(pb.VerifySignatureResponse)
+	err := c.bridgeCallFunc(methodVerify, req, rep)
+	if err != nil {
+		return false, err
+	}
+	return rep.Valid, nil
+}
+
+func (c *contractContext) VerifyEd25519(pubkey, msg, sign []byte) (bool, error) {
+	return c.verify("ed25519", pubkey, msg, sign)
+}
+
+func (c *contractContext) VerifySecp256k1(pubkey, digest, sign []byte) (bool, error) {
+	return c.verify("secp256k1", pubkey, digest, sign)
+}
+
 func (c *contractContext) Call(module, contract, method string, args map[string][]byte) (*code.Response, error) {
 	var argPairs []*pb.ArgPair
 	// 在合约里面单次合约调用的map迭代随机因子是确定的，因此这里不需要排序
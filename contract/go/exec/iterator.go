@@ -0,0 +1,89 @@
+package exec
+
+import (
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+)
+
+const (
+	iteratorBatchSize = 100
+)
+
+// kvIterator 分批从链上拉取迭代结果，每批最多iteratorBatchSize个
+type kvIterator struct {
+	ctx   *contractContext
+	start []byte
+	limit []byte
+
+	items []*pb.IteratorItem
+	cur   *pb.IteratorItem
+	idx   int
+	end   bool
+	err   error
+}
+
+func newKvIterator(ctx *contractContext, start, limit []byte) *kvIterator {
+	return &kvIterator{
+		ctx:   ctx,
+		start: start,
+		limit: limit,
+	}
+}
+
+func (it *kvIterator) load() {
+	req := &pb.IteratorRequest{
+		Header: &it.ctx.header,
+		Start:  it.start,
+		Limit:  it.limit,
+		Cap:    iteratorBatchSize,
+	}
+	rep := new(pb.IteratorResponse)
+	if err := it.ctx.bridgeCallFunc(methodIterator, req, rep); err != nil {
+		it.err = err
+		return
+	}
+	it.items = rep.GetItems()
+	it.idx = 0
+	if len(it.items) < iteratorBatchSize {
+		it.end = true
+		return
+	}
+	// 下一批从最后一个key的后继开始
+	lastKey := it.items[len(it.items)-1].GetKey()
+	it.start = append(append([]byte(nil), lastKey...), 0)
+}
+
+func (it *kvIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.idx >= len(it.items) {
+		if it.end {
+			return false
+		}
+		it.load()
+		if it.err != nil || len(it.items) == 0 {
+			return false
+		}
+	}
+	it.cur = it.items[it.idx]
+	it.idx++
+	return true
+}
+
+func (it *kvIterator) Key() []byte {
+	return it.cur.GetKey()
+}
+
+func (it *kvIterator) Value() []byte {
+	return it.cur.GetValue()
+}
+
+func (it *kvIterator) Error() error {
+	return it.err
+}
+
+func (it *kvIterator) Close() {
+	it.items = nil
+	it.cur = nil
+	it.end = true
+}
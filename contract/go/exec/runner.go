@@ -4,11 +4,32 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/BeDreamCoder/uwavm/contract/go/code"
 	"github.com/golang/protobuf/proto"
 )
 
+const migrateMethod = "migrate"
+
+// ContractMethods returns the names of the methods which can be called on the contract,
+// the first letter of the names is lower case
+func ContractMethods(contract code.Contract) []string {
+	var methods []string
+	contractv := reflect.ValueOf(contract)
+	methodType := reflect.TypeOf((func(code.Context) code.Response)(nil))
+	for i := 0; i < contractv.NumMethod(); i++ {
+		if contractv.Method(i).Type() != methodType {
+			continue
+		}
+		name := contractv.Type().Method(i).Name
+		r, n := utf8.DecodeRuneInString(name)
+		methods = append(methods, string(unicode.ToLower(r))+name[n:])
+	}
+	return methods
+}
+
 type BridgeCallFunc func(method string, request proto.Message, response proto.Message) error
 
 func RunContract(ctxid int64, contract code.Contract, bridgeCall BridgeCallFunc) {
@@ -37,6 +58,12 @@ func RunContract(ctxid int64, contract code.Contract, bridgeCall BridgeCallFunc)
 	methodName := ctx.Method()
 	contractv := reflect.ValueOf(contract)
 	methodv := contractv.MethodByName(strings.Title(methodName))
+	if !methodv.IsValid() && methodName == migrateMethod {
+		// migrate是可选的，合约升级时没有实现该方法表示不需要迁移状态
+		resp = code.OK([]byte("upgrade success"))
+		ctx.SetOutput(&resp)
+		return
+	}
 	if !methodv.IsValid() {
 		resp = code.Errors("bad method " + methodName)
 		ctx.SetOutput(&resp)
@@ -411,6 +411,158 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
+type IteratorRequest struct {
+	Header *SyscallHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// start key, inclusive
+	Start []byte `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// limit key, exclusive
+	Limit []byte `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// max number of items returned in a batch
+	Cap                  int32    `protobuf:"varint,4,opt,name=cap,proto3" json:"cap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IteratorRequest) Reset()         { *m = IteratorRequest{} }
+func (m *IteratorRequest) String() string { return proto.CompactTextString(m) }
+func (*IteratorRequest) ProtoMessage()    {}
+func (*IteratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{9}
+}
+
+func (m *IteratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IteratorRequest.Unmarshal(m, b)
+}
+func (m *IteratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IteratorRequest.Marshal(b, m, deterministic)
+}
+func (m *IteratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IteratorRequest.Merge(m, src)
+}
+func (m *IteratorRequest) XXX_Size() int {
+	return xxx_messageInfo_IteratorRequest.Size(m)
+}
+func (m *IteratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IteratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IteratorRequest proto.InternalMessageInfo
+
+func (m *IteratorRequest) GetHeader() *SyscallHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *IteratorRequest) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *IteratorRequest) GetLimit() []byte {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *IteratorRequest) GetCap() int32 {
+	if m != nil {
+		return m.Cap
+	}
+	return 0
+}
+
+type IteratorItem struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IteratorItem) Reset()         { *m = IteratorItem{} }
+func (m *IteratorItem) String() string { return proto.CompactTextString(m) }
+func (*IteratorItem) ProtoMessage()    {}
+func (*IteratorItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{10}
+}
+
+func (m *IteratorItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IteratorItem.Unmarshal(m, b)
+}
+func (m *IteratorItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IteratorItem.Marshal(b, m, deterministic)
+}
+func (m *IteratorItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IteratorItem.Merge(m, src)
+}
+func (m *IteratorItem) XXX_Size() int {
+	return xxx_messageInfo_IteratorItem.Size(m)
+}
+func (m *IteratorItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_IteratorItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IteratorItem proto.InternalMessageInfo
+
+func (m *IteratorItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *IteratorItem) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type IteratorResponse struct {
+	Items                []*IteratorItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *IteratorResponse) Reset()         { *m = IteratorResponse{} }
+func (m *IteratorResponse) String() string { return proto.CompactTextString(m) }
+func (*IteratorResponse) ProtoMessage()    {}
+func (*IteratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{11}
+}
+
+func (m *IteratorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IteratorResponse.Unmarshal(m, b)
+}
+func (m *IteratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IteratorResponse.Marshal(b, m, deterministic)
+}
+func (m *IteratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IteratorResponse.Merge(m, src)
+}
+func (m *IteratorResponse) XXX_Size() int {
+	return xxx_messageInfo_IteratorResponse.Size(m)
+}
+func (m *IteratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IteratorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IteratorResponse proto.InternalMessageInfo
+
+func (m *IteratorResponse) GetItems() []*IteratorItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type TransferRequest struct {
 	Header               *SyscallHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	From                 string         `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
@@ -425,7 +577,7 @@ func (m *TransferRequest) Reset()         { *m = TransferRequest{} }
 func (m *TransferRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferRequest) ProtoMessage()    {}
 func (*TransferRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dea6d8c13449a4cc, []int{9}
+	return fileDescriptor_dea6d8c13449a4cc, []int{12}
 }
 
 func (m *TransferRequest) XXX_Unmarshal(b []byte) error {
@@ -484,7 +636,7 @@ func (m *TransferResponse) Reset()         { *m = TransferResponse{} }
 func (m *TransferResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferResponse) ProtoMessage()    {}
 func (*TransferResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dea6d8c13449a4cc, []int{10}
+	return fileDescriptor_dea6d8c13449a4cc, []int{13}
 }
 
 func (m *TransferResponse) XXX_Unmarshal(b []byte) error {
@@ -505,6 +657,147 @@ func (m *TransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransferResponse proto.InternalMessageInfo
 
+type ContractEvent struct {
+	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Body                 []byte   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractEvent) Reset()         { *m = ContractEvent{} }
+func (m *ContractEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractEvent) ProtoMessage()    {}
+func (*ContractEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{14}
+}
+
+func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEvent.Unmarshal(m, b)
+}
+func (m *ContractEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEvent.Marshal(b, m, deterministic)
+}
+func (m *ContractEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEvent.Merge(m, src)
+}
+func (m *ContractEvent) XXX_Size() int {
+	return xxx_messageInfo_ContractEvent.Size(m)
+}
+func (m *ContractEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEvent proto.InternalMessageInfo
+
+func (m *ContractEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+type EmitEventRequest struct {
+	Header               *SyscallHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Body                 []byte         `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *EmitEventRequest) Reset()         { *m = EmitEventRequest{} }
+func (m *EmitEventRequest) String() string { return proto.CompactTextString(m) }
+func (*EmitEventRequest) ProtoMessage()    {}
+func (*EmitEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{15}
+}
+
+func (m *EmitEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmitEventRequest.Unmarshal(m, b)
+}
+func (m *EmitEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmitEventRequest.Marshal(b, m, deterministic)
+}
+func (m *EmitEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmitEventRequest.Merge(m, src)
+}
+func (m *EmitEventRequest) XXX_Size() int {
+	return xxx_messageInfo_EmitEventRequest.Size(m)
+}
+func (m *EmitEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmitEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmitEventRequest proto.InternalMessageInfo
+
+func (m *EmitEventRequest) GetHeader() *SyscallHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *EmitEventRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EmitEventRequest) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+type EmitEventResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmitEventResponse) Reset()         { *m = EmitEventResponse{} }
+func (m *EmitEventResponse) String() string { return proto.CompactTextString(m) }
+func (*EmitEventResponse) ProtoMessage()    {}
+func (*EmitEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{16}
+}
+
+func (m *EmitEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmitEventResponse.Unmarshal(m, b)
+}
+func (m *EmitEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmitEventResponse.Marshal(b, m, deterministic)
+}
+func (m *EmitEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmitEventResponse.Merge(m, src)
+}
+func (m *EmitEventResponse) XXX_Size() int {
+	return xxx_messageInfo_EmitEventResponse.Size(m)
+}
+func (m *EmitEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmitEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmitEventResponse proto.InternalMessageInfo
+
 type ContractCallRequest struct {
 	Header               *SyscallHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Module               string         `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
@@ -520,7 +813,7 @@ func (m *ContractCallRequest) Reset()         { *m = ContractCallRequest{} }
 func (m *ContractCallRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractCallRequest) ProtoMessage()    {}
 func (*ContractCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dea6d8c13449a4cc, []int{11}
+	return fileDescriptor_dea6d8c13449a4cc, []int{17}
 }
 
 func (m *ContractCallRequest) XXX_Unmarshal(b []byte) error {
@@ -587,7 +880,7 @@ func (m *ContractCallResponse) Reset()         { *m = ContractCallResponse{} }
 func (m *ContractCallResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractCallResponse) ProtoMessage()    {}
 func (*ContractCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dea6d8c13449a4cc, []int{12}
+	return fileDescriptor_dea6d8c13449a4cc, []int{18}
 }
 
 func (m *ContractCallResponse) XXX_Unmarshal(b []byte) error {
@@ -628,7 +921,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dea6d8c13449a4cc, []int{13}
+	return fileDescriptor_dea6d8c13449a4cc, []int{19}
 }
 
 func (m *Response) XXX_Unmarshal(b []byte) error {
@@ -682,7 +975,7 @@ func (m *SetOutputRequest) Reset()         { *m = SetOutputRequest{} }
 func (m *SetOutputRequest) String() string { return proto.CompactTextString(m) }
 func (*SetOutputRequest) ProtoMessage()    {}
 func (*SetOutputRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dea6d8c13449a4cc, []int{14}
+	return fileDescriptor_dea6d8c13449a4cc, []int{20}
 }
 
 func (m *SetOutputRequest) XXX_Unmarshal(b []byte) error {
@@ -727,7 +1020,7 @@ func (m *SetOutputResponse) Reset()         { *m = SetOutputResponse{} }
 func (m *SetOutputResponse) String() string { return proto.CompactTextString(m) }
 func (*SetOutputResponse) ProtoMessage()    {}
 func (*SetOutputResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dea6d8c13449a4cc, []int{15}
+	return fileDescriptor_dea6d8c13449a4cc, []int{21}
 }
 
 func (m *SetOutputResponse) XXX_Unmarshal(b []byte) error {
@@ -759,7 +1052,7 @@ func (m *GetCallArgsRequest) Reset()         { *m = GetCallArgsRequest{} }
 func (m *GetCallArgsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCallArgsRequest) ProtoMessage()    {}
 func (*GetCallArgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dea6d8c13449a4cc, []int{16}
+	return fileDescriptor_dea6d8c13449a4cc, []int{22}
 }
 
 func (m *GetCallArgsRequest) XXX_Unmarshal(b []byte) error {
@@ -787,6 +1080,584 @@ func (m *GetCallArgsRequest) GetHeader() *SyscallHeader {
 	return nil
 }
 
+type Environment struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// unix time in nanoseconds
+	Timestamp            int64    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Txid                 string   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Initiator            string   `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Environment) Reset()         { *m = Environment{} }
+func (m *Environment) String() string { return proto.CompactTextString(m) }
+func (*Environment) ProtoMessage()    {}
+func (*Environment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{23}
+}
+
+func (m *Environment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Environment.Unmarshal(m, b)
+}
+func (m *Environment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Environment.Marshal(b, m, deterministic)
+}
+func (m *Environment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Environment.Merge(m, src)
+}
+func (m *Environment) XXX_Size() int {
+	return xxx_messageInfo_Environment.Size(m)
+}
+func (m *Environment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Environment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Environment proto.InternalMessageInfo
+
+func (m *Environment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Environment) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Environment) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *Environment) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+type GetEnvironmentRequest struct {
+	Header               *SyscallHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetEnvironmentRequest) Reset()         { *m = GetEnvironmentRequest{} }
+func (m *GetEnvironmentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEnvironmentRequest) ProtoMessage()    {}
+func (*GetEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{24}
+}
+
+func (m *GetEnvironmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEnvironmentRequest.Unmarshal(m, b)
+}
+func (m *GetEnvironmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEnvironmentRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEnvironmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEnvironmentRequest.Merge(m, src)
+}
+func (m *GetEnvironmentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEnvironmentRequest.Size(m)
+}
+func (m *GetEnvironmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEnvironmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEnvironmentRequest proto.InternalMessageInfo
+
+func (m *GetEnvironmentRequest) GetHeader() *SyscallHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+// algorithm: sha256, keccak256, ripemd160
+type HashRequest struct {
+	Header               *SyscallHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Algorithm            string         `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Data                 []byte         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *HashRequest) Reset()         { *m = HashRequest{} }
+func (m *HashRequest) String() string { return proto.CompactTextString(m) }
+func (*HashRequest) ProtoMessage()    {}
+func (*HashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{25}
+}
+
+func (m *HashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashRequest.Unmarshal(m, b)
+}
+func (m *HashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashRequest.Marshal(b, m, deterministic)
+}
+func (m *HashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashRequest.Merge(m, src)
+}
+func (m *HashRequest) XXX_Size() int {
+	return xxx_messageInfo_HashRequest.Size(m)
+}
+func (m *HashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashRequest proto.InternalMessageInfo
+
+func (m *HashRequest) GetHeader() *SyscallHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *HashRequest) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *HashRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type HashResponse struct {
+	Digest               []byte   `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HashResponse) Reset()         { *m = HashResponse{} }
+func (m *HashResponse) String() string { return proto.CompactTextString(m) }
+func (*HashResponse) ProtoMessage()    {}
+func (*HashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{26}
+}
+
+func (m *HashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashResponse.Unmarshal(m, b)
+}
+func (m *HashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashResponse.Marshal(b, m, deterministic)
+}
+func (m *HashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashResponse.Merge(m, src)
+}
+func (m *HashResponse) XXX_Size() int {
+	return xxx_messageInfo_HashResponse.Size(m)
+}
+func (m *HashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashResponse proto.InternalMessageInfo
+
+func (m *HashResponse) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+// algorithm: ed25519, secp256k1
+// secp256k1 verifies a DER or 64 bytes r||s signature of a 32 bytes digest
+type VerifySignatureRequest struct {
+	Header               *SyscallHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Algorithm            string         `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Pubkey               []byte         `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Msg                  []byte         `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Sign                 []byte         `protobuf:"bytes,5,opt,name=sign,proto3" json:"sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *VerifySignatureRequest) Reset()         { *m = VerifySignatureRequest{} }
+func (m *VerifySignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifySignatureRequest) ProtoMessage()    {}
+func (*VerifySignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{27}
+}
+
+func (m *VerifySignatureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifySignatureRequest.Unmarshal(m, b)
+}
+func (m *VerifySignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifySignatureRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifySignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifySignatureRequest.Merge(m, src)
+}
+func (m *VerifySignatureRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifySignatureRequest.Size(m)
+}
+func (m *VerifySignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifySignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifySignatureRequest proto.InternalMessageInfo
+
+func (m *VerifySignatureRequest) GetHeader() *SyscallHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *VerifySignatureRequest) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *VerifySignatureRequest) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *VerifySignatureRequest) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *VerifySignatureRequest) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+type VerifySignatureResponse struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifySignatureResponse) Reset()         { *m = VerifySignatureResponse{} }
+func (m *VerifySignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifySignatureResponse) ProtoMessage()    {}
+func (*VerifySignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{28}
+}
+
+func (m *VerifySignatureResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifySignatureResponse.Unmarshal(m, b)
+}
+func (m *VerifySignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifySignatureResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifySignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifySignatureResponse.Merge(m, src)
+}
+func (m *VerifySignatureResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifySignatureResponse.Size(m)
+}
+func (m *VerifySignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifySignatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifySignatureResponse proto.InternalMessageInfo
+
+func (m *VerifySignatureResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+// ContractDesc describes a deployed contract
+type ContractDesc struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// sha256 of the wasm code
+	CodeHash []byte `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	CodeSize int64  `protobuf:"varint,4,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
+	Deployer string `protobuf:"bytes,5,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	// unix time in nanoseconds of the deploy environment
+	DeployTime int64  `protobuf:"varint,6,opt,name=deploy_time,json=deployTime,proto3" json:"deploy_time,omitempty"`
+	Version    int64  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	Abi        []byte `protobuf:"bytes,8,opt,name=abi,proto3" json:"abi,omitempty"`
+	// reject nested calls that re-enter the contract while it is on the call stack
+	NonReentrant         bool     `protobuf:"varint,9,opt,name=non_reentrant,json=nonReentrant,proto3" json:"non_reentrant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractDesc) Reset()         { *m = ContractDesc{} }
+func (m *ContractDesc) String() string { return proto.CompactTextString(m) }
+func (*ContractDesc) ProtoMessage()    {}
+func (*ContractDesc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{29}
+}
+
+func (m *ContractDesc) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractDesc.Unmarshal(m, b)
+}
+func (m *ContractDesc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractDesc.Marshal(b, m, deterministic)
+}
+func (m *ContractDesc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractDesc.Merge(m, src)
+}
+func (m *ContractDesc) XXX_Size() int {
+	return xxx_messageInfo_ContractDesc.Size(m)
+}
+func (m *ContractDesc) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractDesc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractDesc proto.InternalMessageInfo
+
+func (m *ContractDesc) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractDesc) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *ContractDesc) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *ContractDesc) GetCodeSize() int64 {
+	if m != nil {
+		return m.CodeSize
+	}
+	return 0
+}
+
+func (m *ContractDesc) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
+func (m *ContractDesc) GetDeployTime() int64 {
+	if m != nil {
+		return m.DeployTime
+	}
+	return 0
+}
+
+func (m *ContractDesc) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ContractDesc) GetAbi() []byte {
+	if m != nil {
+		return m.Abi
+	}
+	return nil
+}
+
+func (m *ContractDesc) GetNonReentrant() bool {
+	if m != nil {
+		return m.NonReentrant
+	}
+	return false
+}
+
+// MethodACL lists the callers and roles allowed to call the method,
+// the rule of method "*" applies to the methods without their own rule
+type MethodACL struct {
+	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Callers              []string `protobuf:"bytes,2,rep,name=callers,proto3" json:"callers,omitempty"`
+	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MethodACL) Reset()         { *m = MethodACL{} }
+func (m *MethodACL) String() string { return proto.CompactTextString(m) }
+func (*MethodACL) ProtoMessage()    {}
+func (*MethodACL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{30}
+}
+
+func (m *MethodACL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MethodACL.Unmarshal(m, b)
+}
+func (m *MethodACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MethodACL.Marshal(b, m, deterministic)
+}
+func (m *MethodACL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodACL.Merge(m, src)
+}
+func (m *MethodACL) XXX_Size() int {
+	return xxx_messageInfo_MethodACL.Size(m)
+}
+func (m *MethodACL) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodACL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MethodACL proto.InternalMessageInfo
+
+func (m *MethodACL) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *MethodACL) GetCallers() []string {
+	if m != nil {
+		return m.Callers
+	}
+	return nil
+}
+
+func (m *MethodACL) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type ACLRole struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Members              []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ACLRole) Reset()         { *m = ACLRole{} }
+func (m *ACLRole) String() string { return proto.CompactTextString(m) }
+func (*ACLRole) ProtoMessage()    {}
+func (*ACLRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{31}
+}
+
+func (m *ACLRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ACLRole.Unmarshal(m, b)
+}
+func (m *ACLRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ACLRole.Marshal(b, m, deterministic)
+}
+func (m *ACLRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ACLRole.Merge(m, src)
+}
+func (m *ACLRole) XXX_Size() int {
+	return xxx_messageInfo_ACLRole.Size(m)
+}
+func (m *ACLRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_ACLRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ACLRole proto.InternalMessageInfo
+
+func (m *ACLRole) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ACLRole) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// ContractACL restricts the callers of the contract methods,
+// methods without a rule are open to everyone
+type ContractACL struct {
+	Contract             string       `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Methods              []*MethodACL `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	Roles                []*ACLRole   `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ContractACL) Reset()         { *m = ContractACL{} }
+func (m *ContractACL) String() string { return proto.CompactTextString(m) }
+func (*ContractACL) ProtoMessage()    {}
+func (*ContractACL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dea6d8c13449a4cc, []int{32}
+}
+
+func (m *ContractACL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractACL.Unmarshal(m, b)
+}
+func (m *ContractACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractACL.Marshal(b, m, deterministic)
+}
+func (m *ContractACL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractACL.Merge(m, src)
+}
+func (m *ContractACL) XXX_Size() int {
+	return xxx_messageInfo_ContractACL.Size(m)
+}
+func (m *ContractACL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractACL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractACL proto.InternalMessageInfo
+
+func (m *ContractACL) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractACL) GetMethods() []*MethodACL {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+func (m *ContractACL) GetRoles() []*ACLRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ArgPair)(nil), "contract.ArgPair")
 	proto.RegisterType((*CallArgs)(nil), "contract.CallArgs")
@@ -797,49 +1668,97 @@ func init() {
 	proto.RegisterType((*GetResponse)(nil), "contract.GetResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "contract.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "contract.DeleteResponse")
+	proto.RegisterType((*IteratorRequest)(nil), "contract.IteratorRequest")
+	proto.RegisterType((*IteratorItem)(nil), "contract.IteratorItem")
+	proto.RegisterType((*IteratorResponse)(nil), "contract.IteratorResponse")
 	proto.RegisterType((*TransferRequest)(nil), "contract.TransferRequest")
 	proto.RegisterType((*TransferResponse)(nil), "contract.TransferResponse")
+	proto.RegisterType((*ContractEvent)(nil), "contract.ContractEvent")
+	proto.RegisterType((*EmitEventRequest)(nil), "contract.EmitEventRequest")
+	proto.RegisterType((*EmitEventResponse)(nil), "contract.EmitEventResponse")
 	proto.RegisterType((*ContractCallRequest)(nil), "contract.ContractCallRequest")
 	proto.RegisterType((*ContractCallResponse)(nil), "contract.ContractCallResponse")
 	proto.RegisterType((*Response)(nil), "contract.Response")
 	proto.RegisterType((*SetOutputRequest)(nil), "contract.SetOutputRequest")
 	proto.RegisterType((*SetOutputResponse)(nil), "contract.SetOutputResponse")
 	proto.RegisterType((*GetCallArgsRequest)(nil), "contract.GetCallArgsRequest")
+	proto.RegisterType((*Environment)(nil), "contract.Environment")
+	proto.RegisterType((*GetEnvironmentRequest)(nil), "contract.GetEnvironmentRequest")
+	proto.RegisterType((*HashRequest)(nil), "contract.HashRequest")
+	proto.RegisterType((*HashResponse)(nil), "contract.HashResponse")
+	proto.RegisterType((*VerifySignatureRequest)(nil), "contract.VerifySignatureRequest")
+	proto.RegisterType((*VerifySignatureResponse)(nil), "contract.VerifySignatureResponse")
+	proto.RegisterType((*ContractDesc)(nil), "contract.ContractDesc")
+	proto.RegisterType((*MethodACL)(nil), "contract.MethodACL")
+	proto.RegisterType((*ACLRole)(nil), "contract.ACLRole")
+	proto.RegisterType((*ContractACL)(nil), "contract.ContractACL")
 }
 
 func init() { proto.RegisterFile("contract/pb/contract.proto", fileDescriptor_dea6d8c13449a4cc) }
 
 var fileDescriptor_dea6d8c13449a4cc = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x55, 0xec, 0x24, 0x4d, 0x27, 0xa4, 0xa4, 0xdb, 0xaa, 0x58, 0x3d, 0x45, 0x8b, 0x2a, 0xe5,
-	0x80, 0x62, 0x28, 0x5f, 0xd0, 0xa6, 0xd0, 0xde, 0x1a, 0x6d, 0x39, 0x71, 0x5b, 0xdb, 0x53, 0x27,
-	0xc2, 0xce, 0x9a, 0xdd, 0x75, 0x21, 0x17, 0xfe, 0x8b, 0xbf, 0x43, 0xde, 0x5d, 0x3b, 0x8e, 0x84,
-	0x10, 0x4a, 0x7b, 0x9b, 0x67, 0xcf, 0xcc, 0x7b, 0x4f, 0x33, 0x3b, 0x70, 0x1e, 0x8b, 0xb5, 0x96,
-	0x3c, 0xd6, 0x61, 0x11, 0x85, 0x75, 0x3c, 0x2b, 0xa4, 0xd0, 0x82, 0x0c, 0x6a, 0x4c, 0x3f, 0xc0,
-	0xc1, 0x95, 0x4c, 0x17, 0x7c, 0x25, 0xc9, 0x18, 0xfc, 0x6f, 0xb8, 0x09, 0x3a, 0x93, 0xce, 0xf4,
-	0x90, 0x55, 0x21, 0x39, 0x85, 0xde, 0x13, 0xcf, 0x4a, 0x0c, 0xbc, 0x49, 0x67, 0xfa, 0x8a, 0x59,
-	0x40, 0x39, 0x0c, 0xe6, 0x3c, 0xcb, 0xae, 0x64, 0xaa, 0xc8, 0x19, 0xf4, 0x73, 0xd4, 0x4b, 0x91,
-	0xb8, 0x32, 0x87, 0xc8, 0x05, 0x74, 0xb9, 0x4c, 0x55, 0xe0, 0x4d, 0xfc, 0xe9, 0xf0, 0xf2, 0x78,
-	0xd6, 0xf0, 0x3b, 0x32, 0x66, 0x7e, 0x57, 0xe5, 0x31, 0xcf, 0x32, 0x94, 0x81, 0x6f, 0xcb, 0x2d,
-	0xa2, 0x17, 0x30, 0x7a, 0xd8, 0xa8, 0x0a, 0xdc, 0x21, 0x4f, 0x50, 0x56, 0x4a, 0x62, 0xfd, 0x73,
-	0x65, 0x69, 0x7c, 0x66, 0x01, 0x45, 0x80, 0x45, 0xa9, 0x19, 0x7e, 0x2f, 0x51, 0x69, 0x12, 0x42,
-	0x7f, 0x69, 0xb2, 0x4d, 0xd2, 0xf0, 0xf2, 0xcd, 0x96, 0x75, 0xa7, 0x19, 0x73, 0x69, 0xb5, 0x61,
-	0x6b, 0x6e, 0xd7, 0xb0, 0xdf, 0x36, 0x3c, 0x82, 0xa1, 0xa1, 0x51, 0x85, 0x58, 0x2b, 0xa4, 0xf7,
-	0x00, 0xb7, 0xf8, 0x82, 0xac, 0xf4, 0x2d, 0x0c, 0x4d, 0x43, 0xdb, 0x7f, 0x2b, 0xa2, 0xd3, 0x16,
-	0xc1, 0x60, 0x74, 0x83, 0x19, 0x6a, 0x7c, 0x41, 0xe2, 0x31, 0x1c, 0xd5, 0x3d, 0x9d, 0xb7, 0x5f,
-	0xf0, 0xfa, 0x8b, 0xe4, 0x6b, 0xf5, 0x88, 0x72, 0x6f, 0x1e, 0x02, 0xdd, 0x47, 0x29, 0x72, 0x43,
-	0x74, 0xc8, 0x4c, 0x4c, 0x8e, 0xc0, 0xd3, 0xc2, 0x0d, 0xd9, 0xd3, 0xa2, 0x1a, 0x3c, 0xcf, 0x45,
-	0xb9, 0xd6, 0x41, 0xd7, 0x0e, 0xde, 0x22, 0x4a, 0x60, 0xbc, 0xe5, 0x77, 0x9a, 0x7e, 0x77, 0xe0,
-	0x64, 0xee, 0x28, 0xab, 0xc5, 0xdb, 0x5b, 0x58, 0xb5, 0xac, 0x22, 0x29, 0x33, 0x74, 0xd2, 0x1c,
-	0x22, 0xe7, 0xd0, 0xbc, 0x07, 0x27, 0xb1, 0xc1, 0xad, 0x05, 0xef, 0xfe, 0x75, 0xc1, 0x7b, 0xff,
-	0x5c, 0x70, 0xfa, 0x19, 0x4e, 0x77, 0xa5, 0xbb, 0x19, 0xcf, 0x60, 0x20, 0x5d, 0xec, 0xd4, 0x93,
-	0x6d, 0x8b, 0x3a, 0x8b, 0x35, 0x39, 0x74, 0x01, 0x83, 0xa6, 0xf6, 0x0c, 0xfa, 0x4a, 0x73, 0x5d,
-	0x2a, 0x53, 0xd9, 0x63, 0x0e, 0x91, 0x00, 0x0e, 0x72, 0x54, 0x8a, 0xa7, 0xb5, 0xbf, 0x1a, 0x56,
-	0x13, 0x89, 0x44, 0xb2, 0x71, 0x5b, 0x6d, 0x62, 0xaa, 0x60, 0xfc, 0x80, 0xfa, 0xbe, 0xd4, 0xc5,
-	0x33, 0x5e, 0x50, 0xdb, 0x86, 0xf7, 0x1f, 0x36, 0x4e, 0xe0, 0xb8, 0x45, 0xea, 0x3e, 0x7e, 0x02,
-	0x72, 0x8b, 0xba, 0x3e, 0x29, 0xfb, 0x6a, 0xb9, 0x7e, 0x7f, 0xe7, 0x7f, 0x7d, 0x97, 0xae, 0xf4,
-	0xb2, 0x8c, 0x66, 0xb1, 0xc8, 0xc3, 0x6b, 0xbc, 0x91, 0xc8, 0xf3, 0xb9, 0x48, 0x50, 0x86, 0xe5,
-	0x0f, 0xfe, 0x94, 0x37, 0x57, 0x30, 0x4c, 0x45, 0x58, 0x44, 0x51, 0xdf, 0x1c, 0xc3, 0x8f, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x78, 0x13, 0xd7, 0xab, 0x2a, 0x05, 0x00, 0x00,
+	// 1008 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
+	0x14, 0x95, 0xed, 0xf8, 0xeb, 0xda, 0x69, 0xdd, 0x4d, 0x70, 0x57, 0xa1, 0x12, 0xd1, 0x54, 0x85,
+	0x3c, 0x94, 0x18, 0x8a, 0x04, 0xaf, 0xa4, 0x49, 0x48, 0x2a, 0x15, 0x35, 0x1a, 0x57, 0x3c, 0xf0,
+	0x12, 0x8d, 0xed, 0x9b, 0xf5, 0xa8, 0xbb, 0x3b, 0x66, 0x66, 0x36, 0x90, 0x3e, 0x20, 0x78, 0xe1,
+	0x9f, 0xf0, 0x23, 0xf8, 0x77, 0x68, 0xbe, 0x76, 0xd7, 0x10, 0xa2, 0xca, 0x2d, 0x6f, 0xf7, 0xdc,
+	0x9d, 0x99, 0x73, 0xce, 0xdd, 0xbb, 0x77, 0x07, 0xf6, 0xe6, 0x22, 0xd7, 0x92, 0xcd, 0xf5, 0x64,
+	0x35, 0x9b, 0x84, 0xf8, 0x70, 0x25, 0x85, 0x16, 0x51, 0x2f, 0x60, 0xf2, 0x25, 0x74, 0x8f, 0x64,
+	0x72, 0xc1, 0xb8, 0x8c, 0x46, 0xd0, 0x7a, 0x83, 0x37, 0x71, 0x63, 0xbf, 0x71, 0xd0, 0xa7, 0x26,
+	0x8c, 0x76, 0xa1, 0x7d, 0xcd, 0xd2, 0x02, 0xe3, 0xe6, 0x7e, 0xe3, 0x60, 0x48, 0x1d, 0x20, 0x0c,
+	0x7a, 0xc7, 0x2c, 0x4d, 0x8f, 0x64, 0xa2, 0xa2, 0x31, 0x74, 0x32, 0xd4, 0x4b, 0xb1, 0xf0, 0xdb,
+	0x3c, 0x8a, 0x9e, 0xc0, 0x16, 0x93, 0x89, 0x8a, 0x9b, 0xfb, 0xad, 0x83, 0xc1, 0xb3, 0x07, 0x87,
+	0x25, 0xbf, 0x27, 0xa3, 0x5b, 0xcc, 0x6f, 0x9f, 0xb3, 0x34, 0x45, 0x19, 0xb7, 0xdc, 0x76, 0x87,
+	0xc8, 0x13, 0xd8, 0x9e, 0xde, 0x28, 0x03, 0xce, 0x91, 0x2d, 0x50, 0x1a, 0x25, 0x73, 0xfd, 0x0b,
+	0x77, 0x34, 0x2d, 0xea, 0x00, 0x41, 0x80, 0x8b, 0x42, 0x53, 0xfc, 0xa9, 0x40, 0xa5, 0xa3, 0x09,
+	0x74, 0x96, 0x76, 0xb5, 0x5d, 0x34, 0x78, 0xf6, 0xb0, 0x62, 0x5d, 0x3b, 0x8c, 0xfa, 0x65, 0xc1,
+	0xb0, 0x33, 0xb7, 0x6e, 0xb8, 0x55, 0x37, 0xbc, 0x0d, 0x03, 0x4b, 0xa3, 0x56, 0x22, 0x57, 0x48,
+	0x5e, 0x01, 0x9c, 0xe1, 0x07, 0x64, 0x25, 0x8f, 0x61, 0x70, 0x86, 0xe5, 0xf9, 0x95, 0x88, 0x46,
+	0x5d, 0x04, 0x85, 0xed, 0x13, 0x4c, 0x51, 0xe3, 0x07, 0x24, 0x1e, 0xc1, 0xbd, 0x70, 0xa6, 0xf7,
+	0xf6, 0x5b, 0x03, 0xee, 0xbf, 0xd0, 0x28, 0x99, 0x16, 0x72, 0x63, 0xa2, 0x5d, 0x68, 0x2b, 0xcd,
+	0xa4, 0x0e, 0x6d, 0x63, 0x81, 0xc9, 0xa6, 0x3c, 0xe3, 0x3a, 0xd4, 0xd6, 0x02, 0x23, 0x6a, 0xce,
+	0x56, 0xf1, 0xd6, 0x7e, 0xe3, 0xa0, 0x4d, 0x4d, 0x48, 0xbe, 0x86, 0x61, 0x50, 0xf0, 0x42, 0x63,
+	0x56, 0x6f, 0xcb, 0xe1, 0x5d, 0x6d, 0xf9, 0x2d, 0x8c, 0x2a, 0xe5, 0xbe, 0x94, 0x4f, 0xa1, 0xcd,
+	0x35, 0x66, 0x2a, 0x6e, 0xd8, 0x3e, 0x1c, 0x57, 0xca, 0xeb, 0x14, 0xd4, 0x2d, 0x22, 0xbf, 0xc2,
+	0xfd, 0xd7, 0x92, 0xe5, 0xea, 0x0a, 0x37, 0xf7, 0x1e, 0xc1, 0xd6, 0x95, 0x14, 0x99, 0x95, 0xd6,
+	0xa7, 0x36, 0x8e, 0xee, 0x41, 0x53, 0x0b, 0xdf, 0xe1, 0x4d, 0x2d, 0x4c, 0xd7, 0xb3, 0x4c, 0x14,
+	0xb9, 0xb6, 0xb6, 0xfb, 0xd4, 0x23, 0x12, 0xc1, 0xa8, 0xe2, 0xf7, 0x2f, 0x64, 0x0a, 0xdb, 0xc7,
+	0x9e, 0xf1, 0xf4, 0x1a, 0x73, 0x1d, 0xed, 0x41, 0xf9, 0xf1, 0xfa, 0x6f, 0xae, 0xc4, 0x86, 0x3c,
+	0x67, 0x19, 0x06, 0x72, 0x13, 0x9b, 0xdc, 0x4c, 0x2c, 0x6e, 0x7c, 0xd5, 0x6d, 0x4c, 0xde, 0xc0,
+	0xe8, 0x34, 0xe3, 0xee, 0xc0, 0xf7, 0x71, 0xfa, 0x4e, 0x64, 0x3b, 0xf0, 0xa0, 0x46, 0xe6, 0x6d,
+	0xfd, 0xd5, 0x80, 0x9d, 0xe0, 0xcb, 0x0c, 0x93, 0x8d, 0x55, 0x98, 0x01, 0x24, 0x16, 0x45, 0x1a,
+	0x74, 0x78, 0xb4, 0x56, 0xa6, 0xd6, 0x3f, 0xca, 0x54, 0x0d, 0xad, 0xad, 0x5b, 0x87, 0x56, 0xfb,
+	0xce, 0xa1, 0x45, 0xbe, 0x83, 0xdd, 0x75, 0xe9, 0xbe, 0xd9, 0x0e, 0xa1, 0x27, 0x7d, 0xec, 0xd5,
+	0x47, 0xd5, 0x11, 0x61, 0x15, 0x2d, 0xd7, 0x90, 0x0b, 0xe8, 0x95, 0x7b, 0xc7, 0xd0, 0x51, 0x9a,
+	0xe9, 0x42, 0xd9, 0x9d, 0x6d, 0xea, 0x51, 0x14, 0x43, 0x37, 0x43, 0xa5, 0x58, 0x12, 0xfc, 0x05,
+	0x78, 0x6b, 0xa9, 0x15, 0x8c, 0xa6, 0xa8, 0x5f, 0x15, 0x7a, 0xf5, 0x1e, 0x53, 0xb1, 0x6e, 0xa3,
+	0xf9, 0x0e, 0x36, 0x76, 0xe0, 0x41, 0x8d, 0xd4, 0x27, 0x4f, 0x21, 0x3a, 0x43, 0x1d, 0x7e, 0x13,
+	0x9b, 0x6a, 0x21, 0x05, 0x0c, 0x4e, 0xf3, 0x6b, 0x2e, 0x45, 0x9e, 0x99, 0xde, 0x1f, 0x9b, 0xfd,
+	0x3c, 0x59, 0x6a, 0xff, 0x1b, 0xf0, 0x28, 0x7a, 0x04, 0x7d, 0xcd, 0x33, 0x54, 0x9a, 0x65, 0x2b,
+	0xab, 0xb9, 0x45, 0xab, 0x84, 0xa9, 0x94, 0xfd, 0x75, 0xb8, 0x36, 0xb0, 0xb1, 0xd9, 0xc1, 0x73,
+	0xae, 0xb9, 0x19, 0x01, 0xbe, 0x0b, 0xaa, 0x04, 0x39, 0x87, 0x8f, 0xce, 0x50, 0xd7, 0x98, 0x37,
+	0x36, 0xb0, 0x82, 0xc1, 0x39, 0x53, 0xcb, 0x8d, 0x5f, 0xc6, 0x23, 0xe8, 0xb3, 0x34, 0x11, 0x92,
+	0xeb, 0x65, 0x98, 0x29, 0x55, 0xc2, 0x38, 0x5b, 0x30, 0xcd, 0x42, 0x0f, 0x98, 0x98, 0x7c, 0x0a,
+	0x43, 0xc7, 0x58, 0x75, 0xd6, 0x82, 0x27, 0xa8, 0xb4, 0x9f, 0xa0, 0x1e, 0x91, 0x3f, 0x1b, 0x30,
+	0xfe, 0x01, 0x25, 0xbf, 0xba, 0x99, 0xf2, 0x24, 0x67, 0xba, 0x90, 0xf8, 0x3f, 0xa9, 0x1c, 0x43,
+	0x67, 0x55, 0xcc, 0xcc, 0x0c, 0x77, 0x3a, 0x3d, 0x32, 0x83, 0x3d, 0x53, 0x89, 0xad, 0xfe, 0x90,
+	0x9a, 0xd0, 0xf8, 0x51, 0x3c, 0xc9, 0xe3, 0xb6, 0xf3, 0x63, 0x62, 0x32, 0x81, 0x87, 0xff, 0x92,
+	0xb9, 0xf6, 0xa3, 0xf4, 0x97, 0x82, 0x1e, 0x75, 0x80, 0xfc, 0xd1, 0x84, 0x61, 0xf8, 0x3e, 0x4f,
+	0x50, 0xcd, 0xcb, 0x41, 0xd5, 0xa8, 0x0d, 0xaa, 0x3d, 0xe8, 0xa5, 0x2c, 0x4f, 0x8a, 0xea, 0xc3,
+	0x2a, 0x71, 0xf4, 0x31, 0xf4, 0xe7, 0x62, 0x81, 0x97, 0x4b, 0xa6, 0x96, 0x5e, 0x72, 0xcf, 0x24,
+	0x4c, 0x59, 0xcb, 0x87, 0x8a, 0xbf, 0x45, 0x2b, 0xbd, 0xe5, 0x1e, 0x4e, 0xf9, 0x5b, 0x7b, 0xea,
+	0x02, 0x57, 0xa9, 0xb8, 0x41, 0x69, 0x3d, 0xf4, 0x69, 0x89, 0xa3, 0x4f, 0x60, 0xe0, 0xe2, 0x4b,
+	0xd3, 0x99, 0x71, 0xc7, 0x6e, 0x05, 0x97, 0x7a, 0xcd, 0x33, 0x34, 0x9f, 0xfa, 0x35, 0x4a, 0xc5,
+	0x45, 0x1e, 0x77, 0xed, 0xc3, 0x00, 0x4d, 0xa1, 0xd8, 0x8c, 0xc7, 0x3d, 0x57, 0x28, 0x36, 0xe3,
+	0xd1, 0x63, 0xd8, 0xce, 0x45, 0x7e, 0x29, 0x11, 0x8d, 0xcf, 0x5c, 0xc7, 0x7d, 0x5b, 0x81, 0x61,
+	0x2e, 0x72, 0x1a, 0x72, 0x64, 0x0a, 0xfd, 0xef, 0xed, 0x60, 0x3b, 0x3a, 0x7e, 0xf9, 0x9f, 0x17,
+	0xb5, 0x18, 0xba, 0xee, 0xce, 0xe5, 0xee, 0x6a, 0x7d, 0x1a, 0xa0, 0xa9, 0xae, 0x14, 0x29, 0xaa,
+	0xb8, 0x65, 0xf3, 0x0e, 0x90, 0x6f, 0xa0, 0x7b, 0x74, 0xfc, 0x92, 0x8a, 0x14, 0x6f, 0xad, 0xab,
+	0x9d, 0x57, 0xd9, 0xac, 0x76, 0x9c, 0x87, 0xe4, 0xf7, 0x06, 0x0c, 0xc2, 0x6b, 0x31, 0x82, 0xee,
+	0xfa, 0x8f, 0x7d, 0x0e, 0x5d, 0x27, 0x2f, 0x5c, 0x20, 0x77, 0xaa, 0x0e, 0x2c, 0x2d, 0xd1, 0xb0,
+	0x26, 0xfa, 0xac, 0xae, 0x74, 0x7d, 0x70, 0x3b, 0xa9, 0x5e, 0xfc, 0xf3, 0x2f, 0xce, 0x5b, 0x3f,
+	0x3e, 0x4d, 0xb8, 0x5e, 0x16, 0xb3, 0xc3, 0xb9, 0xc8, 0x26, 0xcf, 0xf1, 0x44, 0x22, 0xcb, 0x8e,
+	0xc5, 0x02, 0xe5, 0xa4, 0xf8, 0x99, 0x5d, 0x67, 0xe5, 0x45, 0x79, 0x92, 0x88, 0xc9, 0x6a, 0x36,
+	0xeb, 0xd8, 0xfb, 0xf2, 0x57, 0x7f, 0x0f, 0x00, 0x3a, 0xb3, 0x0c, 0x78, 0x4d, 0x0b, 0x00, 0x00,
 }
@@ -2,9 +2,10 @@ module github.com/BeDreamCoder/uwavm
 
 go 1.13
 
-replace github.com/go-interpreter/wagon => github.com/BeDreamCoder/wagon v0.6.1
+replace github.com/go-interpreter/wagon => ./third_party/wagon
 
 require (
+	github.com/btcsuite/btcd v0.20.1-beta
 	github.com/go-interpreter/wagon v0.6.0
 	github.com/go-stack/stack v1.8.0 // indirect
 	github.com/golang/protobuf v1.3.2
@@ -15,5 +16,5 @@ require (
 	github.com/spf13/cobra v0.0.5
 	github.com/spf13/pflag v1.0.3
 	github.com/syndtr/goleveldb v1.0.0
-	golang.org/x/net v0.0.0-20181114220301-adae6a3d119a // indirect
+	golang.org/x/crypto v0.0.0-20191206172530-e9b2fee46413
 )
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var accountBalanceCmd *cobra.Command
+var accountTransferCmd *cobra.Command
+var accountIssueCmd *cobra.Command
+var accountNonceCmd *cobra.Command
+
+func AccountBalanceCmd() *cobra.Command {
+	accountBalanceCmd = &cobra.Command{
+		Use:   "balance",
+		Short: "Query the native token balance of the specified account.",
+		Long:  "Query the native token balance of the specified account.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return accountBalance(cmd, args)
+		},
+	}
+	flagList := []string{
+		"account",
+	}
+	attachFlags(accountBalanceCmd, flagList)
+
+	return accountBalanceCmd
+}
+
+func AccountTransferCmd() *cobra.Command {
+	accountTransferCmd = &cobra.Command{
+		Use:   "transfer",
+		Short: "Transfer native tokens between accounts.",
+		Long: "Transfer native tokens between accounts. " +
+			"The transfer carries the next nonce of the source account and is signed with --key, the source account defaults to the key.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return accountTransfer(cmd, args)
+		},
+	}
+	flagList := []string{
+		"from",
+		"to",
+		"amount",
+		"key",
+		"nonce",
+	}
+	attachFlags(accountTransferCmd, flagList)
+
+	return accountTransferCmd
+}
+
+func AccountIssueCmd() *cobra.Command {
+	accountIssueCmd = &cobra.Command{
+		Use:   "issue",
+		Short: "Issue new native tokens to the specified account.",
+		Long:  "Issue new native tokens to the specified account, only the admin set at genesis can issue. The request carries the next nonce of the admin and is signed with --key.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return accountIssue(cmd, args)
+		},
+	}
+	flagList := []string{
+		"to",
+		"amount",
+		"key",
+		"nonce",
+	}
+	attachFlags(accountIssueCmd, flagList)
+
+	return accountIssueCmd
+}
+
+func AccountNonceCmd() *cobra.Command {
+	accountNonceCmd = &cobra.Command{
+		Use:   "nonce",
+		Short: "Query the nonce of the specified account.",
+		Long:  "Query the nonce of the last invocation of the specified account, the next invocation must carry the next nonce.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return accountNonce(cmd, args)
+		},
+	}
+	flagList := []string{
+		"account",
+	}
+	attachFlags(accountNonceCmd, flagList)
+
+	return accountNonceCmd
+}
+
+func accountBalance(cmd *cobra.Command, args []string) error {
+	if accountName == "" {
+		return errors.Errorf("must provide account name")
+	}
+	balance, err := bridge.GetBridge(nil).GetBalance(accountName)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Account:", accountName)
+	fmt.Println("Balance:", balance.String())
+	return nil
+}
+
+func accountTransfer(cmd *cobra.Command, args []string) error {
+	if transferTo == "" {
+		return errors.Errorf("must provide transfer target account")
+	}
+	if _, err := bridge.ParseAmount(transferAmount); err != nil {
+		return errors.Wrap(err, "transfer amount error")
+	}
+	if transferFrom == "" {
+		transferFrom = signKey
+	}
+	if transferFrom == "" {
+		return errors.Errorf("must provide transfer source account")
+	}
+	if signKey != "" && signKey != transferFrom {
+		return errors.Errorf("account %s can not sign with the key of %s", transferFrom, signKey)
+	}
+
+	transferArgs := map[string][]byte{
+		"caller": []byte(transferFrom),
+		"to":     []byte(transferTo),
+		"amount": []byte(transferAmount),
+	}
+	if err := withNonce(transferArgs, transferFrom); err != nil {
+		return err
+	}
+	if err := signArgs(bridge.OpTransfer, "", transferArgs); err != nil {
+		return err
+	}
+	b := bridge.GetBridge(nil)
+	if err := b.Transfer(transferArgs); err != nil {
+		return err
+	}
+	for _, account := range []string{transferFrom, transferTo} {
+		balance, err := b.GetBalance(account)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Balance of %s: %s\n", account, balance.String())
+	}
+	return nil
+}
+
+func accountIssue(cmd *cobra.Command, args []string) error {
+	if transferTo == "" {
+		return errors.Errorf("must provide issue target account")
+	}
+	if _, err := bridge.ParseAmount(transferAmount); err != nil {
+		return errors.Wrap(err, "issue amount error")
+	}
+	if signKey == "" {
+		return errors.Errorf("must sign with the key of the admin")
+	}
+
+	issueArgs := map[string][]byte{
+		"caller": []byte(signKey),
+		"to":     []byte(transferTo),
+		"amount": []byte(transferAmount),
+	}
+	if err := withNonce(issueArgs, signKey); err != nil {
+		return err
+	}
+	if err := signArgs(bridge.OpIssue, "", issueArgs); err != nil {
+		return err
+	}
+	b := bridge.GetBridge(nil)
+	if err := b.Issue(issueArgs); err != nil {
+		return err
+	}
+	balance, err := b.GetBalance(transferTo)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Balance of %s: %s\n", transferTo, balance.String())
+	return nil
+}
+
+func accountNonce(cmd *cobra.Command, args []string) error {
+	if accountName == "" {
+		return errors.Errorf("must provide account name")
+	}
+	nonce, err := bridge.GetBridge(nil).GetNonce(accountName)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Account:", accountName)
+	fmt.Println("Nonce:", nonce)
+	fmt.Println("Next:", nonce+1)
+	return nil
+}
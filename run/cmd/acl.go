@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var contractACLCmd *cobra.Command
+
+const aclCmdName = "acl"
+
+func ACLCmd() *cobra.Command {
+	contractACLCmd = &cobra.Command{
+		Use:   aclCmdName,
+		Short: "Manage the method access control list of a contract: set|get.",
+		Long:  "Manage the method access control list of a contract: set|get.",
+	}
+	contractACLCmd.AddCommand(aclSetCmd())
+	contractACLCmd.AddCommand(aclGetCmd())
+
+	return contractACLCmd
+}
+
+func aclSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set the method access control list of the specified contract.",
+		Long: "Set the method access control list of the specified contract by its deployer, the request carries the next nonce " +
+			"of the deployer and is signed with --key. The file is in JSON format like " +
+			`{"methods":[{"method":"mint","callers":["alice"],"roles":["admin"]}],"roles":[{"name":"admin","members":["bob"]}]}, ` +
+			`the rule of method "*" applies to the methods without their own rule and an empty list removes all the rules.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return contractACLSet(cmd, args)
+		},
+	}
+	flagList := []string{
+		"name",
+		"caller",
+		"acl",
+		"key",
+		"nonce",
+	}
+	attachFlags(cmd, flagList)
+
+	return cmd
+}
+
+func aclGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get the method access control list of the specified contract.",
+		Long:  "Get the method access control list of the specified contract in JSON format.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return contractACLGet(cmd, args)
+		},
+	}
+	flagList := []string{
+		"name",
+	}
+	attachFlags(cmd, flagList)
+
+	return cmd
+}
+
+func contractACLSet(cmd *cobra.Command, args []string) error {
+	if contractName == "" {
+		return errors.Errorf("must provide contract name")
+	}
+	if contractCaller == "" {
+		contractCaller = signKey
+	}
+	if contractCaller == "" {
+		return errors.Errorf("must provide contract caller")
+	}
+	if signKey != "" && signKey != contractCaller {
+		return errors.Errorf("caller %s can not sign with the key of %s", contractCaller, signKey)
+	}
+	if contractACLPath == "" {
+		return errors.Errorf("must provide acl file path")
+	}
+	buf, err := ioutil.ReadFile(contractACLPath)
+	if err != nil {
+		return err
+	}
+	acl := new(pb.ContractACL)
+	if err = jsonpb.UnmarshalString(string(buf), acl); err != nil {
+		return errors.Wrap(err, "invalid acl file")
+	}
+	acl.Contract = contractName
+	aclBuf, err := proto.Marshal(acl)
+	if err != nil {
+		return err
+	}
+	aclArgs := map[string][]byte{
+		"contract_name": []byte(contractName),
+		"caller":        []byte(contractCaller),
+		"acl":           aclBuf,
+	}
+	if err = withNonce(aclArgs, contractCaller); err != nil {
+		return err
+	}
+	if err = signArgs(bridge.OpSetACL, "", aclArgs); err != nil {
+		return err
+	}
+	if err = bridge.GetBridge(nil).SetContractACL(aclArgs); err != nil {
+		return err
+	}
+	fmt.Println("Methods:", len(acl.GetMethods()))
+	fmt.Println("Roles:", len(acl.GetRoles()))
+	return nil
+}
+
+func contractACLGet(cmd *cobra.Command, args []string) error {
+	if contractName == "" {
+		return errors.Errorf("must provide contract name")
+	}
+	acl, err := bridge.GetBridge(nil).GetContractACL(contractName)
+	if err != nil {
+		return err
+	}
+	if acl == nil {
+		acl = &pb.ContractACL{Contract: contractName}
+	}
+	out, err := (&jsonpb.Marshaler{Indent: "  "}).MarshalToString(acl)
+	if err != nil {
+		return err
+	}
+	fmt.Println(out)
+	return nil
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/BeDreamCoder/uwavm/vm"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var contractBatchCmd *cobra.Command
+
+const batchCmdName = "batch"
+
+func BatchCmd() *cobra.Command {
+	contractBatchCmd = &cobra.Command{
+		Use:   batchCmdName,
+		Short: "Invoke a batch of contract methods.",
+		Long: "Invoke a batch of contract methods in order, the file is a JSON array like " +
+			`[{"name":"erc20","method":"invoke","args":{"action":"transfer","to":"bob","token":"10"},"caller":"alice","key":"alice","nonce":0,"gas_limit":0}], ` +
+			`the caller defaults to the key, a zero nonce means the next nonce of the caller and a zero gas limit means unlimited. ` +
+			`With --parallel the invocations are executed concurrently and the conflicting ones are executed again in order.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return contractBatch(cmd, args)
+		},
+	}
+	flagList := []string{
+		"batch",
+		"parallel",
+		"height",
+		"timestamp",
+		"initiator",
+		"json",
+	}
+	attachFlags(contractBatchCmd, flagList)
+
+	return contractBatchCmd
+}
+
+// batchEntry is an invocation of the batch file
+type batchEntry struct {
+	Name     string            `json:"name"`
+	Method   string            `json:"method"`
+	Args     map[string]string `json:"args"`
+	Caller   string            `json:"caller"`
+	Key      string            `json:"key"`
+	Nonce    uint64            `json:"nonce"`
+	GasLimit int64             `json:"gas_limit"`
+}
+
+// batchStep is the report of an invocation of the batch
+type batchStep struct {
+	Step       int    `json:"step"`
+	Method     string `json:"method"`
+	Status     int32  `json:"status"`
+	Gas        int64  `json:"gas"`
+	Reexecuted bool   `json:"reexecuted"`
+	Body       string `json:"body,omitempty"`
+	Message    string `json:"message,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+func contractBatch(cmd *cobra.Command, args []string) error {
+	if batchPath == "" {
+		return errors.Errorf("must provide batch file path")
+	}
+	buf, err := ioutil.ReadFile(batchPath)
+	if err != nil {
+		return err
+	}
+	var entries []*batchEntry
+	if err = json.Unmarshal(buf, &entries); err != nil {
+		return errors.Wrap(err, "invalid batch file")
+	}
+	invocations, err := makeBatchInvocations(entries)
+	if err != nil {
+		return err
+	}
+
+	b := bridge.GetBridge(nil)
+	wasm, ok := b.GetVirtualMachine("wasm")
+	if !ok {
+		return errors.New("not found VirtualMachine name wasm")
+	}
+	results := vm.NewBatchExecutor(wasm, b, 0).Execute(invocations, batchParallel)
+
+	steps := make([]*batchStep, len(results))
+	for i, result := range results {
+		step := &batchStep{
+			Step:       i + 1,
+			Method:     entries[i].Method,
+			Status:     result.Response.GetStatus(),
+			Gas:        result.ResourceUsed.TotalGas(),
+			Reexecuted: result.Reexecuted,
+			Body:       string(result.Response.GetBody()),
+			Message:    result.Response.GetMessage(),
+		}
+		if result.Err != nil {
+			step.Error = result.Err.Error()
+		}
+		steps[i] = step
+	}
+	if outputJSON {
+		buf, err := json.MarshalIndent(steps, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(buf))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "STEP\tMETHOD\tSTATUS\tGAS\tREEXECUTED\tRESULT")
+	for _, step := range steps {
+		result := step.Body
+		if step.Status >= bridge.StatusErrorThreshold {
+			result = step.Message
+		}
+		if step.Error != "" {
+			result = "error: " + step.Error
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%t\t%s\n", step.Step, step.Method, step.Status, step.Gas, step.Reexecuted, result)
+	}
+	return w.Flush()
+}
+
+// makeBatchInvocations 按顺序为未指定nonce的调用分配caller的下一个nonce并签名
+func makeBatchInvocations(entries []*batchEntry) ([]*vm.Invocation, error) {
+	nonces := make(map[string]uint64)
+	invocations := make([]*vm.Invocation, len(entries))
+	for i, entry := range entries {
+		if entry.Name == "" {
+			return nil, errors.Errorf("step %d: must provide contract name", i+1)
+		}
+		if entry.Method == "" {
+			return nil, errors.Errorf("step %d: must provide contract method name", i+1)
+		}
+		caller := entry.Caller
+		if caller == "" {
+			caller = entry.Key
+		}
+		if caller == "" {
+			return nil, errors.Errorf("step %d: must provide contract caller", i+1)
+		}
+		if entry.Key != "" && entry.Key != caller {
+			return nil, errors.Errorf("step %d: caller %s can not sign with the key of %s", i+1, caller, entry.Key)
+		}
+		if entry.GasLimit < 0 {
+			return nil, errors.Errorf("step %d: gas limit should not be negative", i+1)
+		}
+
+		argsBuf, err := encodeContractArgs(entry.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		n := entry.Nonce
+		if n == 0 {
+			last, ok := nonces[caller]
+			if !ok {
+				if last, err = bridge.GetBridge(nil).GetNonce(caller); err != nil {
+					return nil, err
+				}
+			}
+			n = last + 1
+		}
+		nonces[caller] = n
+
+		invokeArgs := withEnvArgs(map[string][]byte{
+			"contract_name": []byte(entry.Name),
+			"args":          argsBuf,
+			"caller":        []byte(caller),
+			bridge.NonceArg: []byte(strconv.FormatUint(n, 10)),
+		})
+		if err = signArgsWithKey(entry.Key, bridge.OpInvoke, entry.Method, invokeArgs); err != nil {
+			return nil, err
+		}
+
+		limits := gas.MaxLimits
+		if entry.GasLimit != 0 {
+			limits = gas.FromGas(entry.GasLimit)
+		}
+		invocations[i] = &vm.Invocation{
+			Method: entry.Method,
+			Args:   invokeArgs,
+			Limits: limits,
+		}
+	}
+	return invocations, nil
+}
+
+// encodeContractArgs converts the string args to the JSON format expected by the contract
+func encodeContractArgs(args map[string]string) ([]byte, error) {
+	m := make(map[string][]byte, len(args))
+	for k, v := range args {
+		m[k] = []byte(v)
+	}
+	return json.Marshal(m)
+}
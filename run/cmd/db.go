@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/spf13/cobra"
+)
+
+var dbMigrateCmd *cobra.Command
+
+func DBMigrateCmd() *cobra.Command {
+	dbMigrateCmd = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the database to the current key layout.",
+		Long: "Migrate the database to the current key layout, contract state keys are assigned to the deployed contract with the longest matching name. " +
+			"Data written by an older version must be migrated before it is used, the legacy contracts did not record their deployer " +
+			"and --legacy-deployer becomes the account allowed to upgrade them.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return dbMigrate(cmd, args)
+		},
+	}
+	flagList := []string{
+		"legacy-deployer",
+	}
+	attachFlags(dbMigrateCmd, flagList)
+
+	return dbMigrateCmd
+}
+
+func dbMigrate(cmd *cobra.Command, args []string) error {
+	migrated, skipped, err := bridge.GetBridge(nil).MigrateKeyLayout(legacyDeployer)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Migrated:", migrated)
+	fmt.Println("Skipped:", skipped)
+	return nil
+}
@@ -27,7 +27,16 @@ func DeployCmd() *cobra.Command {
 		"language",
 		"args",
 		"path",
+		"abi",
 		"caller",
+		"height",
+		"timestamp",
+		"txid",
+		"initiator",
+		"gas-limit",
+		"key",
+		"max-call-depth",
+		"non-reentrant",
 	}
 	attachFlags(contractDeployCmd, flagList)
 
@@ -43,9 +52,16 @@ func contractDeploy(cmd *cobra.Command, args []string) error {
 		return errors.New("not found VirtualMachine name wasm")
 	}
 
-	if resp, resourceUsed, err := vm.DeployContract(makeDeployArgs()); err != nil {
+	deployArgs := makeDeployArgs()
+	if err := signArgs(bridge.OpDeploy, "", deployArgs); err != nil {
+		return err
+	}
+	if resp, resourceUsed, rwset, err := vm.DeployContract(deployArgs, makeGasLimits()); err != nil {
 		return err
 	} else {
+		if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+			return err
+		}
 		fmt.Println("Status:", resp.GetStatus())
 		fmt.Println("Message:", resp.GetMessage())
 		fmt.Println("Bdoy:", string(resp.GetBody()))
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var contractDescribeCmd *cobra.Command
+
+const describeCmdName = "describe"
+
+func DescribeCmd() *cobra.Command {
+	contractDescribeCmd = &cobra.Command{
+		Use:   describeCmdName,
+		Short: "Describe the specified wasm contract.",
+		Long:  "Describe the specified wasm contract, including the language, code hash, deployer and version.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return contractDescribe(cmd, args)
+		},
+	}
+	flagList := []string{
+		"name",
+	}
+	attachFlags(contractDescribeCmd, flagList)
+
+	return contractDescribeCmd
+}
+
+func contractDescribe(cmd *cobra.Command, args []string) error {
+	if contractName == "" {
+		return errors.Errorf("must provide contract name")
+	}
+	desc, err := bridge.GetBridge(nil).GetContractDesc(contractName)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Name:", desc.GetName())
+	fmt.Println("Language:", desc.GetLanguage())
+	fmt.Println("Version:", desc.GetVersion())
+	fmt.Println("Deployer:", desc.GetDeployer())
+	fmt.Println("DeployTime:", time.Unix(0, desc.GetDeployTime()).Format(time.RFC3339))
+	fmt.Println("CodeHash:", hex.EncodeToString(desc.GetCodeHash()))
+	fmt.Println("CodeSize:", desc.GetCodeSize())
+	fmt.Println("NonReentrant:", desc.GetNonReentrant())
+	if len(desc.GetAbi()) != 0 {
+		fmt.Println("ABI:", string(desc.GetAbi()))
+	}
+
+	history, err := bridge.GetBridge(nil).GetContractHistory(contractName)
+	if err != nil {
+		return err
+	}
+	if len(history) != 0 {
+		fmt.Println("History:")
+	}
+	for _, version := range history {
+		fmt.Printf("  %d %s %s\n", version.GetVersion(),
+			time.Unix(0, version.GetDeployTime()).Format(time.RFC3339),
+			hex.EncodeToString(version.GetCodeHash()))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/spf13/cobra"
+)
+
+var contractEventsCmd *cobra.Command
+
+const eventsCmdName = "events"
+
+func EventsCmd() *cobra.Command {
+	contractEventsCmd = &cobra.Command{
+		Use:   eventsCmdName,
+		Short: "List the events emitted by contracts.",
+		Long:  "List the events emitted by contracts, filtered by contract name and event name.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return contractEvents(cmd, args)
+		},
+	}
+	flagList := []string{
+		"name",
+		"event",
+	}
+	attachFlags(contractEventsCmd, flagList)
+
+	return contractEventsCmd
+}
+
+func contractEvents(cmd *cobra.Command, args []string) error {
+	events, err := bridge.GetBridge(nil).QueryEvents(contractName, eventName)
+	if err != nil {
+		return err
+	}
+	for i, event := range events {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Println("Contract:", event.GetContract())
+		fmt.Println("Event:", event.GetName())
+		fmt.Println("Body:", string(event.GetBody()))
+	}
+	return nil
+}
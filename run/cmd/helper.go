@@ -1,14 +1,19 @@
 package cmd
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"strconv"
+	"time"
 
 	"github.com/BeDreamCoder/uwavm/bridge"
 	"github.com/BeDreamCoder/uwavm/common/db/leveldb"
 	"github.com/BeDreamCoder/uwavm/common/log"
 	"github.com/BeDreamCoder/uwavm/vm"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -21,6 +26,39 @@ var (
 	contractArgs   string
 	contractPath   string
 	contractCaller string
+	contractAbi    string
+
+	contractACLPath string
+
+	batchPath     string
+	batchParallel bool
+
+	signKey  string
+	nonce    uint64
+	adminKey bool
+
+	accountName    string
+	transferFrom   string
+	transferTo     string
+	transferAmount string
+
+	eventName string
+
+	envHeight    int64
+	envTimestamp int64
+	envTxid      string
+	envInitiator string
+
+	gasLimit     int64
+	maxCallDepth int
+
+	nonReentrant bool
+
+	listLanguage string
+	listDeployer string
+	outputJSON   bool
+
+	legacyDeployer string
 )
 
 var flags *pflag.FlagSet
@@ -30,6 +68,9 @@ func InitCmd(cmd *cobra.Command, args []string) {
 	bridge := bridge.GetBridge(db)
 	vm := vm.NewVMManager(db, bridge)
 	bridge.RegisterExecutor("wasm", vm)
+	if maxCallDepth > 0 {
+		bridge.SetMaxCallDepth(maxCallDepth)
+	}
 }
 
 func init() {
@@ -52,6 +93,52 @@ func resetFlags() {
 		fmt.Sprintf("Path to wasm binary files"))
 	flags.StringVarP(&contractCaller, "caller", "c", "",
 		fmt.Sprint("Contract caller name"))
+	flags.StringVar(&contractAbi, "abi", "",
+		fmt.Sprint("Path to the ABI file of the contract, optional"))
+	flags.StringVar(&contractACLPath, "acl", "",
+		fmt.Sprint("Path to the method access control list file of the contract in JSON format"))
+	flags.StringVar(&batchPath, "batch", "",
+		fmt.Sprint("Path to the batch file of contract invocations in JSON format"))
+	flags.BoolVar(&batchParallel, "parallel", false,
+		fmt.Sprint("Execute the invocations of the batch concurrently, the result is the same as the serial execution"))
+	flags.StringVar(&signKey, "key", "",
+		fmt.Sprint("Account in the local keystore to sign the request with, the caller defaults to it"))
+	flags.Uint64Var(&nonce, "nonce", 0,
+		fmt.Sprint("Nonce of the request, defaults to the next nonce of the caller"))
+	flags.BoolVar(&adminKey, "admin", false,
+		fmt.Sprint("Register the key as the admin key which issues native tokens and registers keys, only allowed on a new database"))
+	flags.StringVarP(&accountName, "account", "u", "",
+		fmt.Sprint("Name of the account"))
+	flags.StringVarP(&transferFrom, "from", "f", "",
+		fmt.Sprint("Transfer source account, defaults to the account of --key"))
+	flags.StringVarP(&transferTo, "to", "t", "",
+		fmt.Sprint("Transfer target account"))
+	flags.StringVarP(&transferAmount, "amount", "v", "0",
+		fmt.Sprint("Transfer amount in decimal"))
+	flags.StringVarP(&eventName, "event", "e", "",
+		fmt.Sprint("Name of the contract event"))
+	flags.Int64Var(&envHeight, "height", 0,
+		fmt.Sprint("Block height of the execution environment"))
+	flags.Int64Var(&envTimestamp, "timestamp", 0,
+		fmt.Sprint("Unix time in nanoseconds of the execution environment, defaults to now"))
+	flags.StringVar(&envTxid, "txid", "",
+		fmt.Sprint("Transaction id of the execution environment, a random one is generated if empty"))
+	flags.StringVar(&envInitiator, "initiator", "",
+		fmt.Sprint("Transaction initiator of the execution environment, defaults to the caller"))
+	flags.Int64Var(&gasLimit, "gas-limit", 0,
+		fmt.Sprint("Maximum gas each of cpu, memory and disk may consume, 0 means unlimited"))
+	flags.IntVar(&maxCallDepth, "max-call-depth", bridge.DefaultMaxCallDepth,
+		fmt.Sprint("Maximum depth of nested contract calls"))
+	flags.BoolVar(&nonReentrant, "non-reentrant", false,
+		fmt.Sprint("Reject nested calls that re-enter the contract while it is on the call stack"))
+	flags.StringVar(&listLanguage, "lang", "",
+		fmt.Sprint("Only list the contracts written in the language"))
+	flags.StringVar(&listDeployer, "deployer", "",
+		fmt.Sprint("Only list the contracts deployed by the account"))
+	flags.BoolVar(&outputJSON, "json", false,
+		fmt.Sprint("Print the output in JSON format"))
+	flags.StringVar(&legacyDeployer, "legacy-deployer", "",
+		fmt.Sprint("Deployer recorded for the legacy contracts when migrating the database"))
 }
 
 func attachFlags(cmd *cobra.Command, names []string) {
@@ -70,11 +157,17 @@ func checkContractCmdParams(cmd *cobra.Command) error {
 		return errors.Errorf("must provide contract name")
 	}
 
+	if contractCaller == "" {
+		contractCaller = signKey
+	}
 	if contractCaller == "" {
 		return errors.Errorf("must provide contract caller")
 	}
+	if signKey != "" && signKey != contractCaller {
+		return errors.Errorf("caller %s can not sign with the key of %s", contractCaller, signKey)
+	}
 
-	if cmd.Name() == deployCmdName {
+	if cmd.Name() == deployCmdName || cmd.Name() == upgradeCmdName {
 		if contractPath == "" {
 			return errors.Errorf("must provide contract wasm file path")
 		}
@@ -84,6 +177,10 @@ func checkContractCmdParams(cmd *cobra.Command) error {
 		}
 	}
 
+	if gasLimit < 0 {
+		return errors.Errorf("gas limit should not be negative")
+	}
+
 	if contractArgs != "{}" {
 		var f map[string]string
 		err := json.Unmarshal([]byte(contractArgs), &f)
@@ -105,25 +202,91 @@ func checkContractCmdParams(cmd *cobra.Command) error {
 }
 
 func makeDeployArgs() map[string][]byte {
+	args := makeUpgradeArgs()
+	args["language"] = []byte(contractLang)
+	args["non_reentrant"] = []byte(strconv.FormatBool(nonReentrant))
+	return args
+}
+
+// makeUpgradeArgs 升级时沿用旧版本的语言
+func makeUpgradeArgs() map[string][]byte {
 	codebuf, err := ioutil.ReadFile(contractPath)
 	if err != nil {
 		panic(err)
 	}
 
-	return map[string][]byte{
+	args := map[string][]byte{
 		"contract_name": []byte(contractName),
 		"contract_code": codebuf,
-		"language":      []byte(contractLang),
 		"args":          []byte(contractArgs),
 		"caller":        []byte(contractCaller),
 	}
+	if contractAbi != "" {
+		abi, err := ioutil.ReadFile(contractAbi)
+		if err != nil {
+			panic(err)
+		}
+		args["abi"] = abi
+	}
+	return withEnvArgs(args)
 }
 
 func makeInvokeOrQueryArgs() map[string][]byte {
-	return map[string][]byte{
+	return withEnvArgs(map[string][]byte{
 		"contract_name": []byte(contractName),
-		"language":      []byte(contractLang),
 		"args":          []byte(contractArgs),
 		"caller":        []byte(contractCaller),
+	})
+}
+
+// withNonce 为请求填入--nonce指定的nonce，未指定时使用caller的下一个nonce
+func withNonce(args map[string][]byte, caller string) error {
+	n := nonce
+	if n == 0 {
+		current, err := bridge.GetBridge(nil).GetNonce(caller)
+		if err != nil {
+			return err
+		}
+		n = current + 1
+	}
+	args[bridge.NonceArg] = []byte(strconv.FormatUint(n, 10))
+	return nil
+}
+
+// commitRWSet 提交调用的读写集合，调用失败时读写集合只包含消耗的nonce，同样需要提交
+func commitRWSet(rwset *bridge.RWSet, callErr error) error {
+	if err := bridge.GetBridge(nil).CommitRWSet(rwset); err != nil && callErr == nil {
+		return err
+	}
+	return callErr
+}
+
+func makeGasLimits() gas.Limits {
+	if gasLimit == 0 {
+		return gas.MaxLimits
+	}
+	return gas.FromGas(gasLimit)
+}
+
+// withEnvArgs fills the execution environment into the contract call args
+func withEnvArgs(args map[string][]byte) map[string][]byte {
+	timestamp := envTimestamp
+	if timestamp == 0 {
+		timestamp = time.Now().UnixNano()
+	}
+	txid := envTxid
+	if txid == "" {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			panic(err)
+		}
+		txid = hex.EncodeToString(buf)
+	}
+	args["height"] = []byte(strconv.FormatInt(envHeight, 10))
+	args["timestamp"] = []byte(strconv.FormatInt(timestamp, 10))
+	args["txid"] = []byte(txid)
+	if envInitiator != "" {
+		args["initiator"] = []byte(envInitiator)
 	}
+	return args
 }
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
 	"github.com/spf13/cobra"
 )
 
@@ -24,10 +26,17 @@ func InvokeCmd() *cobra.Command {
 	}
 	flagList := []string{
 		"name",
-		"language",
 		"method",
 		"args",
 		"caller",
+		"height",
+		"timestamp",
+		"txid",
+		"initiator",
+		"gas-limit",
+		"key",
+		"nonce",
+		"max-call-depth",
 	}
 	attachFlags(contractInvokeCmd, flagList)
 
@@ -43,13 +52,37 @@ func contractInvokeOrQuery(cmd *cobra.Command, args []string, method string) err
 		return errors.New("not found VirtualMachine name wasm")
 	}
 
-	if resp, resourceUsed, err := vm.InvokeContract(method, makeInvokeOrQueryArgs()); err != nil {
-		return err
-	} else {
-		fmt.Println("Status:", resp.GetStatus())
-		fmt.Println("Message:", resp.GetMessage())
-		fmt.Println("Bdoy:", string(resp.GetBody()))
-		fmt.Println("Gas:", resourceUsed.TotalGas())
+	invokeArgs := makeInvokeOrQueryArgs()
+	// 查询以只读方式调用合约，不产生写集合
+	if cmd.Name() == queryCmdName {
+		if err := signArgs(bridge.OpQuery, method, invokeArgs); err != nil {
+			return err
+		}
+		resp, resourceUsed, err := vm.QueryContract(method, invokeArgs, makeGasLimits())
+		if err != nil {
+			return err
+		}
+		printResponse(resp, resourceUsed)
 		return nil
 	}
+
+	if err := withNonce(invokeArgs, contractCaller); err != nil {
+		return err
+	}
+	if err := signArgs(bridge.OpInvoke, method, invokeArgs); err != nil {
+		return err
+	}
+	resp, resourceUsed, rwset, err := vm.InvokeContract(method, invokeArgs, makeGasLimits())
+	if err = commitRWSet(rwset, err); err != nil {
+		return err
+	}
+	printResponse(resp, resourceUsed)
+	return nil
+}
+
+func printResponse(resp *pb.Response, resourceUsed gas.Limits) {
+	fmt.Println("Status:", resp.GetStatus())
+	fmt.Println("Message:", resp.GetMessage())
+	fmt.Println("Bdoy:", string(resp.GetBody()))
+	fmt.Println("Gas:", resourceUsed.TotalGas())
 }
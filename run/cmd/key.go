@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var keyNewCmd *cobra.Command
+var keyListCmd *cobra.Command
+
+const keyFileSuffix = ".key"
+
+// keyFile is the content of a key file in the local keystore
+type keyFile struct {
+	Account    string `json:"account"`
+	PublicKey  string `json:"public_key"`
+	PrivateKey string `json:"private_key"`
+}
+
+func KeyNewCmd() *cobra.Command {
+	keyNewCmd = &cobra.Command{
+		Use:   "new",
+		Short: "Create a keypair for the specified account.",
+		Long: "Create an ed25519 keypair for the specified account in the local keystore and register the public key, requests of the account must be signed with --key afterwards. " +
+			"Only the admin can register keys, the registration is signed with --key of the admin. " +
+			"With --admin the account becomes the admin which issues native tokens and registers keys, the admin can only be set on a new database.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return keyNew(cmd, args)
+		},
+	}
+	flagList := []string{
+		"account",
+		"admin",
+		"key",
+		"nonce",
+	}
+	attachFlags(keyNewCmd, flagList)
+
+	return keyNewCmd
+}
+
+func KeyListCmd() *cobra.Command {
+	keyListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the keys in the local keystore.",
+		Long:  "List the keys in the local keystore.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return keyList(cmd, args)
+		},
+	}
+
+	return keyListCmd
+}
+
+func keystoreDir() string {
+	return path.Join(util.GoPath(), "src/github.com/BeDreamCoder/uwavm/output/keystore")
+}
+
+func keyNew(cmd *cobra.Command, args []string) error {
+	if accountName == "" {
+		return errors.Errorf("must provide account name")
+	}
+	if !adminKey && signKey == "" {
+		return errors.Errorf("must sign with the key of the admin")
+	}
+	if _, err := util.CreateDirIfMissing(keystoreDir()); err != nil {
+		return err
+	}
+	filename := path.Join(keystoreDir(), accountName+keyFileSuffix)
+	if _, err := os.Stat(filename); err == nil {
+		return errors.Errorf("key of account %s already exists", accountName)
+	}
+	// 账户的公钥不能被替换，已注册的账户不能再创建密钥
+	registered, err := bridge.GetBridge(nil).GetAccountKey(accountName)
+	if err != nil {
+		return err
+	}
+	if registered != nil {
+		return errors.Errorf("account %s already has a registered key", accountName)
+	}
+
+	pubkey, privkey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	buf, err := json.MarshalIndent(&keyFile{
+		Account:    accountName,
+		PublicKey:  hex.EncodeToString(pubkey),
+		PrivateKey: hex.EncodeToString(privkey),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if adminKey {
+		err = bridge.GetBridge(nil).InitAdmin(accountName, pubkey)
+	} else {
+		err = registerKey(accountName, pubkey)
+	}
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(filename, buf, 0600); err != nil {
+		return err
+	}
+	fmt.Println("Account:", accountName)
+	fmt.Println("PublicKey:", hex.EncodeToString(pubkey))
+	return nil
+}
+
+// registerKey 以--key指定的admin签名注册账户公钥
+func registerKey(account string, pubkey ed25519.PublicKey) error {
+	registerArgs := map[string][]byte{
+		"caller":     []byte(signKey),
+		"account":    []byte(account),
+		"public_key": pubkey,
+	}
+	if err := withNonce(registerArgs, signKey); err != nil {
+		return err
+	}
+	if err := signArgs(bridge.OpRegisterKey, "", registerArgs); err != nil {
+		return err
+	}
+	return bridge.GetBridge(nil).RegisterAccountKey(registerArgs)
+}
+
+func keyList(cmd *cobra.Command, args []string) error {
+	infos, err := ioutil.ReadDir(keystoreDir())
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	var keys []*keyFile
+	for _, info := range infos {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), keyFileSuffix) {
+			continue
+		}
+		key, err := readKeyFile(strings.TrimSuffix(info.Name(), keyFileSuffix))
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Account < keys[j].Account
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ACCOUNT\tPUBLIC KEY\tREGISTERED")
+	for _, key := range keys {
+		registered, err := bridge.GetBridge(nil).GetAccountKey(key.Account)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%s\t%v\n", key.Account, key.PublicKey, hex.EncodeToString(registered) == key.PublicKey)
+	}
+	return w.Flush()
+}
+
+func readKeyFile(account string) (*keyFile, error) {
+	buf, err := ioutil.ReadFile(path.Join(keystoreDir(), account+keyFileSuffix))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Errorf("key of account %s not found in keystore", account)
+		}
+		return nil, err
+	}
+	key := new(keyFile)
+	if err = json.Unmarshal(buf, key); err != nil {
+		return nil, errors.Wrapf(err, "bad key file of account %s", account)
+	}
+	return key, nil
+}
+
+// signArgs 使用--key指定账户的私钥对请求签名，未指定--key时不签名
+func signArgs(op, method string, args map[string][]byte) error {
+	return signArgsWithKey(signKey, op, method, args)
+}
+
+// signArgsWithKey 使用本地keystore中account的私钥对请求签名，account为空时不签名
+func signArgsWithKey(account, op, method string, args map[string][]byte) error {
+	if account == "" {
+		return nil
+	}
+	key, err := readKeyFile(account)
+	if err != nil {
+		return err
+	}
+	privkey, err := hex.DecodeString(key.PrivateKey)
+	if err != nil || len(privkey) != ed25519.PrivateKeySize {
+		return errors.Errorf("bad private key of account %s", account)
+	}
+	bridge.SignRequest(ed25519.PrivateKey(privkey), op, method, args)
+	return nil
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/spf13/cobra"
+)
+
+var contractListCmd *cobra.Command
+
+const listCmdName = "list"
+
+// contractInfo is the JSON output of a deployed contract
+type contractInfo struct {
+	Name       string `json:"name"`
+	Language   string `json:"language"`
+	Version    int64  `json:"version"`
+	Deployer   string `json:"deployer"`
+	DeployTime string `json:"deploy_time"`
+	CodeHash   string `json:"code_hash"`
+	CodeSize   int64  `json:"code_size"`
+}
+
+func ListCmd() *cobra.Command {
+	contractListCmd = &cobra.Command{
+		Use:   listCmdName,
+		Short: "List the deployed wasm contracts.",
+		Long:  "List the deployed wasm contracts, filtered by language and deployer.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return contractList(cmd, args)
+		},
+	}
+	flagList := []string{
+		"lang",
+		"deployer",
+		"json",
+	}
+	attachFlags(contractListCmd, flagList)
+
+	return contractListCmd
+}
+
+func contractList(cmd *cobra.Command, args []string) error {
+	contracts, err := bridge.GetBridge(nil).ListContracts(bridge.ContractFilter{
+		Language: listLanguage,
+		Deployer: listDeployer,
+	})
+	if err != nil {
+		return err
+	}
+
+	infos := make([]*contractInfo, 0, len(contracts))
+	for _, desc := range contracts {
+		infos = append(infos, &contractInfo{
+			Name:       desc.GetName(),
+			Language:   desc.GetLanguage(),
+			Version:    desc.GetVersion(),
+			Deployer:   desc.GetDeployer(),
+			DeployTime: time.Unix(0, desc.GetDeployTime()).Format(time.RFC3339),
+			CodeHash:   hex.EncodeToString(desc.GetCodeHash()),
+			CodeSize:   desc.GetCodeSize(),
+		})
+	}
+	if outputJSON {
+		buf, err := json.MarshalIndent(infos, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(buf))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tLANGUAGE\tVERSION\tDEPLOYER\tDEPLOY TIME")
+	for _, info := range infos {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", info.Name, info.Language, info.Version, info.Deployer, info.DeployTime)
+	}
+	return w.Flush()
+}
@@ -20,10 +20,16 @@ func QueryCmd() *cobra.Command {
 	}
 	flagList := []string{
 		"name",
-		"language",
 		"method",
 		"args",
 		"caller",
+		"height",
+		"timestamp",
+		"txid",
+		"initiator",
+		"gas-limit",
+		"key",
+		"max-call-depth",
 	}
 	attachFlags(contractQueryCmd, flagList)
 
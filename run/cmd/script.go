@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const runCmdName = "run"
+
+func RunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   runCmdName + " <script.json>",
+		Short: "Run a script of contract deploy|invoke|query steps.",
+		Long: "Run a script of contract steps in order in one process, the script is a JSON array like " +
+			`[{"op":"deploy","name":"erc20","language":"c","path":"erc20_c.wasm","args":{"totalSupply":"1000"},"caller":"alice"},` +
+			`{"op":"invoke","name":"erc20","method":"transfer","args":{"from":"alice","to":"bob","amount":"10"},"caller":"alice","expect":{"status":200}},` +
+			`{"op":"query","name":"erc20","method":"balance","args":{"caller":"bob"},"caller":"bob","expect":{"body":"10"}}], ` +
+			`the contract path is relative to the script, the caller defaults to the key and a zero nonce means the next nonce of the caller. ` +
+			`The expect of a step checks the status, the body or the error message containing the given text, ` +
+			`a step failing with an error without expecting it also fails. The script stops at the first failed step.`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runScript(cmd, args)
+		},
+	}
+	flagList := []string{
+		"height",
+		"timestamp",
+		"initiator",
+		"json",
+	}
+	attachFlags(cmd, flagList)
+
+	return cmd
+}
+
+// scriptStep is a step of the script
+type scriptStep struct {
+	Op           string            `json:"op"`
+	Name         string            `json:"name"`
+	Method       string            `json:"method"`
+	Args         map[string]string `json:"args"`
+	Caller       string            `json:"caller"`
+	Key          string            `json:"key"`
+	Nonce        uint64            `json:"nonce"`
+	GasLimit     int64             `json:"gas_limit"`
+	Language     string            `json:"language"`
+	Path         string            `json:"path"`
+	Abi          string            `json:"abi"`
+	NonReentrant bool              `json:"non_reentrant"`
+	Expect       *scriptExpect     `json:"expect"`
+}
+
+// scriptExpect is the assertion of a step, the zero fields are not checked
+type scriptExpect struct {
+	Status int32   `json:"status"`
+	Body   *string `json:"body"`
+	Error  string  `json:"error"`
+}
+
+// scriptReport is the report of a step
+type scriptReport struct {
+	Step    int    `json:"step"`
+	Op      string `json:"op"`
+	Name    string `json:"name"`
+	Method  string `json:"method,omitempty"`
+	Status  int32  `json:"status"`
+	Gas     int64  `json:"gas"`
+	Body    string `json:"body,omitempty"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Passed  bool   `json:"passed"`
+	Failure string `json:"failure,omitempty"`
+}
+
+func runScript(cmd *cobra.Command, args []string) error {
+	buf, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var steps []*scriptStep
+	if err = json.Unmarshal(buf, &steps); err != nil {
+		return errors.Wrap(err, "invalid script file")
+	}
+	dir := filepath.Dir(args[0])
+
+	var reports []*scriptReport
+	var failed *scriptReport
+	for i, step := range steps {
+		report, err := runScriptStep(dir, step)
+		if err != nil {
+			return errors.Wrapf(err, "step %d", i+1)
+		}
+		report.Step = i + 1
+		reports = append(reports, report)
+		if !report.Passed {
+			failed = report
+			break
+		}
+	}
+
+	if outputJSON {
+		buf, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(buf))
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "STEP\tOP\tNAME\tMETHOD\tSTATUS\tGAS\tRESULT\tCHECK")
+		for _, report := range reports {
+			result := report.Body
+			if report.Status >= bridge.StatusErrorThreshold {
+				result = report.Message
+			}
+			if report.Error != "" {
+				result = "error: " + report.Error
+			}
+			check := "ok"
+			if !report.Passed {
+				check = "FAIL: " + report.Failure
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n", report.Step, report.Op, report.Name,
+				report.Method, report.Status, report.Gas, result, check)
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+	}
+	if failed != nil {
+		return errors.Errorf("step %d failed: %s", failed.Step, failed.Failure)
+	}
+	return nil
+}
+
+// runScriptStep 执行一个步骤并检查结果，返回的error表示脚本本身有误
+func runScriptStep(dir string, step *scriptStep) (*scriptReport, error) {
+	if step.Name == "" {
+		return nil, errors.Errorf("must provide contract name")
+	}
+	caller := step.Caller
+	if caller == "" {
+		caller = step.Key
+	}
+	if caller == "" {
+		return nil, errors.Errorf("must provide contract caller")
+	}
+	if step.Key != "" && step.Key != caller {
+		return nil, errors.Errorf("caller %s can not sign with the key of %s", caller, step.Key)
+	}
+	if step.GasLimit < 0 {
+		return nil, errors.Errorf("gas limit should not be negative")
+	}
+	limits := gas.MaxLimits
+	if step.GasLimit != 0 {
+		limits = gas.FromGas(step.GasLimit)
+	}
+	argsBuf, err := encodeContractArgs(step.Args)
+	if err != nil {
+		return nil, err
+	}
+	vm, ok := bridge.GetBridge(nil).GetVirtualMachine("wasm")
+	if !ok {
+		return nil, errors.New("not found VirtualMachine name wasm")
+	}
+
+	var (
+		resp         *pb.Response
+		resourceUsed gas.Limits
+		rwset        *bridge.RWSet
+	)
+	switch step.Op {
+	case deployCmdName:
+		if step.Path == "" {
+			return nil, errors.Errorf("must provide contract wasm file path")
+		}
+		var deployArgs map[string][]byte
+		if deployArgs, err = makeScriptDeployArgs(dir, step, caller, argsBuf); err != nil {
+			return nil, err
+		}
+		if err = signArgsWithKey(step.Key, bridge.OpDeploy, "", deployArgs); err != nil {
+			return nil, err
+		}
+		resp, resourceUsed, rwset, err = vm.DeployContract(deployArgs, limits)
+	case invokeCmdName, queryCmdName:
+		if step.Method == "" {
+			return nil, errors.Errorf("must provide contract method name")
+		}
+		invokeArgs := withEnvArgs(map[string][]byte{
+			"contract_name": []byte(step.Name),
+			"args":          argsBuf,
+			"caller":        []byte(caller),
+		})
+		if step.Op == queryCmdName {
+			if err = signArgsWithKey(step.Key, bridge.OpQuery, step.Method, invokeArgs); err != nil {
+				return nil, err
+			}
+			resp, resourceUsed, err = vm.QueryContract(step.Method, invokeArgs, limits)
+			break
+		}
+		n := step.Nonce
+		if n == 0 {
+			current, err := bridge.GetBridge(nil).GetNonce(caller)
+			if err != nil {
+				return nil, err
+			}
+			n = current + 1
+		}
+		invokeArgs[bridge.NonceArg] = []byte(strconv.FormatUint(n, 10))
+		if err = signArgsWithKey(step.Key, bridge.OpInvoke, step.Method, invokeArgs); err != nil {
+			return nil, err
+		}
+		resp, resourceUsed, rwset, err = vm.InvokeContract(step.Method, invokeArgs, limits)
+	default:
+		return nil, errors.Errorf("unknown op %q, should be deploy, invoke or query", step.Op)
+	}
+	// 失败的调用同样提交消耗的nonce
+	err = commitRWSet(rwset, err)
+
+	report := &scriptReport{
+		Op:      step.Op,
+		Name:    step.Name,
+		Method:  step.Method,
+		Status:  resp.GetStatus(),
+		Gas:     resourceUsed.TotalGas(),
+		Body:    string(resp.GetBody()),
+		Message: resp.GetMessage(),
+	}
+	if err != nil {
+		report.Error = err.Error()
+	}
+	report.Failure = checkScriptExpect(step.Expect, resp, err)
+	report.Passed = report.Failure == ""
+	return report, nil
+}
+
+func makeScriptDeployArgs(dir string, step *scriptStep, caller string, argsBuf []byte) (map[string][]byte, error) {
+	code, err := ioutil.ReadFile(scriptPath(dir, step.Path))
+	if err != nil {
+		return nil, err
+	}
+	language := step.Language
+	if language == "" {
+		language = "go"
+	}
+	args := map[string][]byte{
+		"contract_name": []byte(step.Name),
+		"contract_code": code,
+		"args":          argsBuf,
+		"caller":        []byte(caller),
+		"language":      []byte(language),
+		"non_reentrant": []byte(strconv.FormatBool(step.NonReentrant)),
+	}
+	if step.Abi != "" {
+		abi, err := ioutil.ReadFile(scriptPath(dir, step.Abi))
+		if err != nil {
+			return nil, err
+		}
+		args["abi"] = abi
+	}
+	return withEnvArgs(args), nil
+}
+
+// scriptPath resolves the path relative to the directory of the script
+func scriptPath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// checkScriptExpect returns the reason why the result does not match the expect, empty if it matches
+func checkScriptExpect(expect *scriptExpect, resp *pb.Response, err error) string {
+	if expect == nil {
+		expect = &scriptExpect{}
+	}
+	if expect.Error != "" {
+		if err == nil {
+			return fmt.Sprintf("expect error %q, got status %d", expect.Error, resp.GetStatus())
+		}
+		if !strings.Contains(err.Error(), expect.Error) {
+			return fmt.Sprintf("expect error %q, got %q", expect.Error, err.Error())
+		}
+		return ""
+	}
+	if err != nil {
+		return fmt.Sprintf("unexpected error: %v", err)
+	}
+	if expect.Status != 0 && resp.GetStatus() != expect.Status {
+		return fmt.Sprintf("expect status %d, got %d", expect.Status, resp.GetStatus())
+	}
+	if expect.Body != nil && string(resp.GetBody()) != *expect.Body {
+		return fmt.Sprintf("expect body %q, got %q", *expect.Body, resp.GetBody())
+	}
+	return ""
+}
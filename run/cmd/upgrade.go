@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/spf13/cobra"
+)
+
+var contractUpgradeCmd *cobra.Command
+
+const upgradeCmdName = "upgrade"
+
+func UpgradeCmd() *cobra.Command {
+	contractUpgradeCmd = &cobra.Command{
+		Use:       upgradeCmdName,
+		Short:     "Upgrade the specified wasm contract.",
+		Long:      "Upgrade the specified wasm contract by its deployer, the contract state is kept and the optional migrate method is called with the args. The upgrade carries the next nonce of the caller.",
+		ValidArgs: []string{"1"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return contractUpgrade(cmd, args)
+		},
+	}
+	flagList := []string{
+		"name",
+		"args",
+		"path",
+		"abi",
+		"caller",
+		"height",
+		"timestamp",
+		"txid",
+		"initiator",
+		"gas-limit",
+		"key",
+		"nonce",
+		"max-call-depth",
+		"non-reentrant",
+	}
+	attachFlags(contractUpgradeCmd, flagList)
+
+	return contractUpgradeCmd
+}
+
+func contractUpgrade(cmd *cobra.Command, args []string) error {
+	if err := checkContractCmdParams(cmd); err != nil {
+		return err
+	}
+	vm, ok := bridge.GetBridge(nil).GetVirtualMachine("wasm")
+	if !ok {
+		return errors.New("not found VirtualMachine name wasm")
+	}
+
+	upgradeArgs := makeUpgradeArgs()
+	// 未指定时沿用旧版本的重入设置
+	if cmd.Flags().Changed("non-reentrant") {
+		upgradeArgs["non_reentrant"] = []byte(strconv.FormatBool(nonReentrant))
+	}
+	if err := withNonce(upgradeArgs, contractCaller); err != nil {
+		return err
+	}
+	if err := signArgs(bridge.OpUpgrade, "", upgradeArgs); err != nil {
+		return err
+	}
+	resp, resourceUsed, rwset, err := vm.UpgradeContract(upgradeArgs, makeGasLimits())
+	if err = commitRWSet(rwset, err); err != nil {
+		return err
+	}
+	printResponse(resp, resourceUsed)
+	return nil
+}
@@ -23,8 +23,26 @@ var mainCmd = &cobra.Command{
 
 var contractCmd = &cobra.Command{
 	Use:   "contract",
-	Short: "Operate a contract: deploy|invoke|query|list.",
-	Long:  "Operate a contract: deploy|invoke|query|list.",
+	Short: "Operate a contract: deploy|invoke|query|upgrade|list|describe|events|acl|batch.",
+	Long:  "Operate a contract: deploy|invoke|query|upgrade|list|describe|events|acl|batch.",
+}
+
+var accountCmd = &cobra.Command{
+	Use:   "account",
+	Short: "Operate accounts: balance|transfer|nonce.",
+	Long:  "Operate accounts: balance|transfer|nonce.",
+}
+
+var keyCmd = &cobra.Command{
+	Use:   "key",
+	Short: "Manage the keys of the local keystore: new|list.",
+	Long:  "Manage the keys of the local keystore: new|list.",
+}
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Maintain the database: migrate.",
+	Long:  "Maintain the database: migrate.",
 }
 
 // Cmd returns the cobra command for Chaincode
@@ -32,10 +50,41 @@ func ContractCmd() *cobra.Command {
 	contractCmd.AddCommand(cmdpkg.DeployCmd())
 	contractCmd.AddCommand(cmdpkg.InvokeCmd())
 	contractCmd.AddCommand(cmdpkg.QueryCmd())
+	contractCmd.AddCommand(cmdpkg.EventsCmd())
+	contractCmd.AddCommand(cmdpkg.DescribeCmd())
+	contractCmd.AddCommand(cmdpkg.ListCmd())
+	contractCmd.AddCommand(cmdpkg.UpgradeCmd())
+	contractCmd.AddCommand(cmdpkg.ACLCmd())
+	contractCmd.AddCommand(cmdpkg.BatchCmd())
 
 	return contractCmd
 }
 
+// AccountCmd returns the cobra command for native token accounts
+func AccountCmd() *cobra.Command {
+	accountCmd.AddCommand(cmdpkg.AccountBalanceCmd())
+	accountCmd.AddCommand(cmdpkg.AccountTransferCmd())
+	accountCmd.AddCommand(cmdpkg.AccountIssueCmd())
+	accountCmd.AddCommand(cmdpkg.AccountNonceCmd())
+
+	return accountCmd
+}
+
+// KeyCmd returns the cobra command for the local keystore
+func KeyCmd() *cobra.Command {
+	keyCmd.AddCommand(cmdpkg.KeyNewCmd())
+	keyCmd.AddCommand(cmdpkg.KeyListCmd())
+
+	return keyCmd
+}
+
+// DBCmd returns the cobra command for database maintenance
+func DBCmd() *cobra.Command {
+	dbCmd.AddCommand(cmdpkg.DBMigrateCmd())
+
+	return dbCmd
+}
+
 func makeDeployArgs(modulePath string) map[string][]byte {
 	codebuf, err := ioutil.ReadFile(modulePath)
 	if err != nil {
@@ -73,6 +122,10 @@ func main() {
 	// Define command-line flags that are valid for all commands and
 	// subcommands.
 	mainCmd.AddCommand(ContractCmd())
+	mainCmd.AddCommand(AccountCmd())
+	mainCmd.AddCommand(KeyCmd())
+	mainCmd.AddCommand(DBCmd())
+	mainCmd.AddCommand(cmdpkg.RunCmd())
 
 	// On failure Cobra prints the usage message and error string, so we only
 	// need to exit with a non-0 status
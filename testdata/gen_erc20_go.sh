@@ -0,0 +1,19 @@
+#!/bin/bash
+
+# 重新生成erc20_go.wasm，源码为contract/go/example/erc20.go。
+# go合约的运行时实现的是go1.12的syscall/js，必须使用go1.12.17编译：
+#   go get golang.org/dl/go1.12.17 && go1.12.17 download
+
+set -eux
+
+GO=${GO:-go1.12.17}
+case "$($GO version)" in
+*go1.12.17*) ;;
+*)
+	echo "erc20_go.wasm must be built with go1.12.17, got $($GO version)" >&2
+	exit 1
+	;;
+esac
+
+cd "$(dirname "$0")/../contract/go"
+GOOS=js GOARCH=wasm $GO build -o ../../testdata/erc20_go.wasm example/erc20.go
@@ -0,0 +1,6 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package wagon is a WebAssembly-based interpreter in Go, for Go.
+package wagon
@@ -0,0 +1,182 @@
+package vm
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+)
+
+// Invocation is a contract invocation of a batch, the args are the same as the args of InvokeContract
+type Invocation struct {
+	Method string
+	Args   map[string][]byte
+	Limits gas.Limits
+}
+
+// InvocationResult is the result of an invocation of a batch, the rwset has been committed if it is not nil,
+// the rwset of a failed invocation only contains the consumed nonce
+type InvocationResult struct {
+	Response     *pb.Response
+	ResourceUsed gas.Limits
+	RWSet        *bridge.RWSet
+	Err          error
+	// Reexecuted is true if the invocation conflicted with the earlier ones and was executed again
+	Reexecuted bool
+}
+
+// BatchExecutor 批量执行合约调用，结果与按顺序逐个执行并提交完全一致。
+// 并行执行时所有调用先直接读取数据库并发执行，再按顺序检查每个调用的读集合
+// 是否被之前提交的调用修改过，有冲突的调用在之前的结果提交后重新执行。
+// 数据库不提供快照，执行期间BatchExecutor必须是唯一的写入者，并行阶段各个调用才能读到同一份状态；
+// 其他写入者修改过的读集合在提交时版本校验失败，调用会被重新执行，但结果不再等同于按顺序执行
+type BatchExecutor struct {
+	vm      bridge.VirtualMachine
+	bridge  *bridge.Bridge
+	workers int
+}
+
+// NewBatchExecutor instances a BatchExecutor, workers defaults to the number of cpus if not positive
+func NewBatchExecutor(vm bridge.VirtualMachine, bridge *bridge.Bridge, workers int) *BatchExecutor {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return &BatchExecutor{
+		vm:      vm,
+		bridge:  bridge,
+		workers: workers,
+	}
+}
+
+// Execute executes the invocations and commits their rwsets in order,
+// the invocations are executed concurrently if parallel is true.
+// Nothing else may write the database until Execute returns
+func (b *BatchExecutor) Execute(invocations []*Invocation, parallel bool) []*InvocationResult {
+	results := make([]*InvocationResult, len(invocations))
+	if !parallel {
+		for i, inv := range invocations {
+			results[i] = b.executeAndCommit(inv)
+		}
+		return results
+	}
+
+	// 所有调用都不提交，没有其他写入者时数据库在这一阶段保持不变，即各个调用共同的快照
+	b.executeAll(invocations, results)
+
+	written := newKeySet()
+	for i, inv := range invocations {
+		result := results[i]
+		// 在消耗nonce之前失败的调用没有读集合，之前有调用提交过写入时无法判断是否冲突，只能重新执行
+		if result.RWSet == nil && written.len() == 0 {
+			continue
+		}
+		if result.RWSet == nil || written.conflicts(result.RWSet) {
+			result = b.executeAndCommit(inv)
+			result.Reexecuted = true
+		} else if err := b.bridge.CommitRWSet(result.RWSet); err != nil {
+			var conflict *bridge.ErrVersionConflict
+			if !errors.As(err, &conflict) {
+				if result.Err == nil {
+					result.Err = err
+				}
+				result.RWSet = nil
+				results[i] = result
+				continue
+			}
+			result = b.executeAndCommit(inv)
+			result.Reexecuted = true
+		}
+		results[i] = result
+		if result.RWSet != nil {
+			written.add(result.RWSet)
+		}
+	}
+	return results
+}
+
+func (b *BatchExecutor) executeAll(invocations []*Invocation, results []*InvocationResult) {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < b.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = b.execute(invocations[i])
+			}
+		}()
+	}
+	for i := range invocations {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+func (b *BatchExecutor) execute(inv *Invocation) *InvocationResult {
+	resp, resourceUsed, rwset, err := b.vm.InvokeContract(inv.Method, inv.Args, inv.Limits)
+	return &InvocationResult{
+		Response:     resp,
+		ResourceUsed: resourceUsed,
+		RWSet:        rwset,
+		Err:          err,
+	}
+}
+
+func (b *BatchExecutor) executeAndCommit(inv *Invocation) *InvocationResult {
+	result := b.execute(inv)
+	if result.RWSet == nil {
+		return result
+	}
+	if err := b.bridge.CommitRWSet(result.RWSet); err != nil {
+		if result.Err == nil {
+			result.Err = err
+		}
+		result.RWSet = nil
+	}
+	return result
+}
+
+// keySet 记录批量执行中已提交调用写过的key
+type keySet struct {
+	keys map[string]struct{}
+}
+
+func newKeySet() *keySet {
+	return &keySet{
+		keys: make(map[string]struct{}),
+	}
+}
+
+func (s *keySet) len() int {
+	return len(s.keys)
+}
+
+func (s *keySet) add(rwset *bridge.RWSet) {
+	for _, write := range rwset.Writes {
+		s.keys[string(write.Key)] = struct{}{}
+	}
+}
+
+// conflicts reports whether the rwset read a written key or iterated a range containing a written key
+func (s *keySet) conflicts(rwset *bridge.RWSet) bool {
+	for _, read := range rwset.Reads {
+		if _, ok := s.keys[string(read.Key)]; ok {
+			return true
+		}
+	}
+	if len(rwset.Ranges) == 0 {
+		return false
+	}
+	for key := range s.keys {
+		for _, r := range rwset.Ranges {
+			if r.Contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,253 @@
+package vm_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+)
+
+// kvVM 按调用参数读写key的虚拟机，gas由读到的值决定，读到过期数据时结果和gas都会不同
+type kvVM struct {
+	db db.Database
+}
+
+func (k *kvVM) GetName() string {
+	return "kv"
+}
+
+func (k *kvVM) NewVM(state *bridge.ContractState) (bridge.Contract, error) {
+	return nil, errors.New("not supported")
+}
+
+func (k *kvVM) DeployContract(args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *bridge.RWSet, error) {
+	return nil, gas.Limits{}, nil, errors.New("not supported")
+}
+
+func (k *kvVM) UpgradeContract(args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *bridge.RWSet, error) {
+	return nil, gas.Limits{}, nil, errors.New("not supported")
+}
+
+func (k *kvVM) QueryContract(method string, args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, error) {
+	return nil, gas.Limits{}, errors.New("not supported")
+}
+
+func (k *kvVM) InvokeContract(method string, args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *bridge.RWSet, error) {
+	ws := bridge.NewWriteSet(k.db)
+	var used gas.Limits
+	get := func(key []byte) int64 {
+		value, err := ws.Get(key)
+		if err != nil {
+			panic(err)
+		}
+		n, _ := strconv.ParseInt(string(value), 10, 64)
+		used.Cpu += n + 1
+		return n
+	}
+	put := func(key []byte, n int64) {
+		if err := ws.Put(key, []byte(strconv.FormatInt(n, 10))); err != nil {
+			panic(err)
+		}
+		used.Disk++
+	}
+
+	switch method {
+	case "add":
+		delta, _ := strconv.ParseInt(string(args["delta"]), 10, 64)
+		put(args["key"], get(args["key"])+delta)
+	case "sum":
+		// 范围读取，范围内新增的key同样构成冲突
+		iter, err := ws.NewIterator(args["start"], args["limit"])
+		if err != nil {
+			return nil, gas.Limits{}, nil, err
+		}
+		var sum int64
+		for iter.Next() {
+			n, _ := strconv.ParseInt(string(iter.Value()), 10, 64)
+			sum += n
+			used.Cpu++
+		}
+		iter.Release()
+		put(args["key"], sum)
+	case "reject":
+		// 合约返回错误状态时只保留读集合
+		if get(args["key"])%2 == 0 {
+			return &pb.Response{Status: 400, Message: "even"}, used, ws.RWSet(), nil
+		}
+		put(args["key"], 1)
+	case "fail":
+		get(args["key"])
+		return nil, used, nil, errors.New("failed")
+	default:
+		return nil, gas.Limits{}, nil, fmt.Errorf("bad method %s", method)
+	}
+	return &pb.Response{Status: 200, Body: []byte(strconv.FormatInt(used.Cpu, 10))}, used, ws.RWSet(), nil
+}
+
+// makeKVInvocations 生成读写重叠的key以及范围的调用
+func makeKVInvocations(n int) []*vm.Invocation {
+	r := rand.New(rand.NewSource(1))
+	key := func() []byte {
+		return []byte(fmt.Sprintf("kv/%c/%d", 'a'+r.Intn(2), r.Intn(6)))
+	}
+	invocations := make([]*vm.Invocation, n)
+	for i := range invocations {
+		inv := &vm.Invocation{
+			Args:   make(map[string][]byte),
+			Limits: gas.MaxLimits,
+		}
+		switch p := r.Intn(10); {
+		case p < 5:
+			inv.Method = "add"
+			inv.Args["key"] = key()
+			inv.Args["delta"] = []byte(strconv.Itoa(r.Intn(10) + 1))
+		case p < 7:
+			inv.Method = "sum"
+			prefix := fmt.Sprintf("kv/%c/", 'a'+r.Intn(2))
+			inv.Args["start"] = []byte(prefix)
+			inv.Args["limit"] = []byte(prefix + "\xff")
+			inv.Args["key"] = []byte(fmt.Sprintf("kv/sum/%d", r.Intn(2)))
+		case p < 9:
+			inv.Method = "reject"
+			inv.Args["key"] = key()
+		default:
+			inv.Method = "fail"
+			inv.Args["key"] = key()
+		}
+		invocations[i] = inv
+	}
+	return invocations
+}
+
+// dumpDB returns all the keys and values of the test database
+func dumpDB(t *testing.T) map[string]string {
+	iter := testDB.NewIterator(nil, nil)
+	defer iter.Release()
+	m := make(map[string]string)
+	for iter.Next() {
+		m[string(iter.Key())] = string(iter.Value())
+	}
+	if err := iter.Error(); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func restoreDB(t *testing.T, m map[string]string) {
+	for key := range dumpDB(t) {
+		if _, ok := m[key]; !ok {
+			testDB.Delete([]byte(key))
+		}
+	}
+	for key, value := range m {
+		testDB.Put([]byte(key), []byte(value))
+	}
+}
+
+func TestParallelBatchMatchesSerial(t *testing.T) {
+	invocations := makeKVInvocations(200)
+	kv := &kvVM{db: testDB}
+	executor := vm.NewBatchExecutor(kv, bridge.GetBridge(nil), 8)
+
+	initial := dumpDB(t)
+	serial := executor.Execute(invocations, false)
+	serialDB := dumpDB(t)
+
+	// 从相同的初始状态并行执行
+	restoreDB(t, initial)
+	parallel := executor.Execute(invocations, true)
+	parallelDB := dumpDB(t)
+
+	var reexecuted, failed int
+	for i := range invocations {
+		s, p := serial[i], parallel[i]
+		if p.Reexecuted {
+			reexecuted++
+		}
+		if s.Err != nil {
+			failed++
+		}
+		if fmt.Sprint(s.Err) != fmt.Sprint(p.Err) {
+			t.Fatalf("invocation %d: error %v, serial %v", i, p.Err, s.Err)
+		}
+		if s.Response.GetStatus() != p.Response.GetStatus() || string(s.Response.GetBody()) != string(p.Response.GetBody()) {
+			t.Fatalf("invocation %d: response %v, serial %v", i, p.Response, s.Response)
+		}
+		if s.ResourceUsed != p.ResourceUsed {
+			t.Fatalf("invocation %d: gas %+v, serial %+v", i, p.ResourceUsed, s.ResourceUsed)
+		}
+		if !reflect.DeepEqual(s.RWSet, p.RWSet) {
+			t.Fatalf("invocation %d: rwset %+v, serial %+v", i, p.RWSet, s.RWSet)
+		}
+	}
+	if !reflect.DeepEqual(serialDB, parallelDB) {
+		t.Fatal("the state after parallel execution differs from the serial execution")
+	}
+	// 负载中同时存在冲突重新执行的调用和失败的调用
+	if reexecuted == 0 || reexecuted == len(invocations) || failed == 0 {
+		t.Fatalf("bad workload, reexecuted %d failed %d", reexecuted, failed)
+	}
+}
+
+func TestParallelBatchERC20(t *testing.T) {
+	deployTestContract(t, "batchtoken")
+	b := bridge.GetBridge(nil)
+	users := []string{"batch0", "batch1", "batch2", "batch3"}
+	expect := map[string]int64{"alice": 1000000}
+	for _, user := range users {
+		args := makeTransferArgs("batchtoken", "alice", user, "1000", nextNonce(t, "alice"))
+		_, _, rwset, err := testVM.InvokeContract("transfer", args, gas.MaxLimits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = b.CommitRWSet(rwset); err != nil {
+			t.Fatal(err)
+		}
+		expect["alice"] -= 1000
+		expect[user] = 1000
+	}
+
+	// 同一账户的转账争用nonce，不同账户之间争用余额，冲突的调用在提交时重新执行
+	var invocations []*vm.Invocation
+	for round := 1; round <= 5; round++ {
+		for i, from := range users {
+			// 示例合约没有处理from和to相同的转账，收款方总是其他账户
+			to := users[(i+1+round%(len(users)-1))%len(users)]
+			amount := int64(round + i)
+			invocations = append(invocations, &vm.Invocation{
+				Method: "transfer",
+				Args:   makeTransferArgs("batchtoken", from, to, strconv.FormatInt(amount, 10), []byte(strconv.Itoa(round))),
+				Limits: gas.MaxLimits,
+			})
+			expect[from] -= amount
+			expect[to] += amount
+		}
+	}
+	results := vm.NewBatchExecutor(testVM, b, 8).Execute(invocations, true)
+
+	var reexecuted int
+	for i, result := range results {
+		if result.Err != nil || result.Response.GetStatus() != 200 {
+			t.Fatalf("invocation %d: error %v, response %v", i, result.Err, result.Response)
+		}
+		if result.Reexecuted {
+			reexecuted++
+		}
+	}
+	if reexecuted == 0 {
+		t.Fatal("expect the conflicting transfers to be executed again")
+	}
+	for account, balance := range expect {
+		if body, _ := queryBalance(t, "batchtoken", account); body != strconv.FormatInt(balance, 10) {
+			t.Errorf("balance of %s is %s, expect %d", account, body, balance)
+		}
+	}
+}
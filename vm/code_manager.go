@@ -1,58 +1,198 @@
 package vm
 
 import (
-	"errors"
+	"container/list"
 	"sync"
 
+	"github.com/BeDreamCoder/uwavm/common/db"
 	"github.com/BeDreamCoder/uwavm/wasm/exec"
 )
 
-type makeExecCodeFunc func(contractName string) (exec.WasmExec, error)
+// DefaultCodeCacheSize is the default number of compiled contract codes kept in CodeManager
+const DefaultCodeCacheSize = 64
+
+// makeExecCodeFunc 从store中读取合约代码并编译，部署和升级过程中的代码只存在于合约调用的WriteSet中
+type makeExecCodeFunc func(contractName string, store db.KVStore) (exec.WasmExec, error)
 
 type ContractCode struct {
 	ContractName string
 	ExecCode     exec.WasmExec
 }
 
+// CodeCacheStats is a snapshot of the counters of CodeManager
+type CodeCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Size      int
+	Capacity  int
+	// IdleInstances 缓存代码池中空闲的实例数，ReusedInstances 复用池中实例的次数
+	IdleInstances   int
+	ReusedInstances uint64
+}
+
+// instancePoolStats is implemented by the exec codes pooling their instances
+type instancePoolStats interface {
+	PoolStats() (idle int, reused uint64)
+}
+
+// codeKey 合约升级后代码的hash发生变化，旧代码不会再被命中
+type codeKey struct {
+	name string
+	hash string
+}
+
+type codeEntry struct {
+	key  codeKey
+	code *ContractCode
+	err  error
+	// 编译完成后关闭，同一份代码的并发请求等待同一次编译
+	ready chan struct{}
+}
+
+// CodeManager caches the compiled contract codes keyed by contract name and code hash,
+// the least recently used code is evicted when the cache is full
 type CodeManager struct {
 	makeExecCode makeExecCodeFunc
-	codes        map[string]*ContractCode
-	mutex        sync.Mutex
+	capacity     int
+
+	mutex   sync.Mutex
+	entries map[codeKey]*list.Element
+	lru     *list.List
+	stats   CodeCacheStats
 }
 
-func NewCodeManager(makeExec makeExecCodeFunc) *CodeManager {
+// NewCodeManager instances a CodeManager keeping at most capacity codes
+func NewCodeManager(makeExec makeExecCodeFunc, capacity int) *CodeManager {
+	if capacity <= 0 {
+		capacity = DefaultCodeCacheSize
+	}
 	return &CodeManager{
 		makeExecCode: makeExec,
-		codes:        make(map[string]*ContractCode),
+		capacity:     capacity,
+		entries:      make(map[codeKey]*list.Element),
+		lru:          list.New(),
 	}
 }
 
-func (c *CodeManager) GetExecCode(name string) (*ContractCode, error) {
+// GetExecCode returns the compiled code of the contract, it is read from store and compiled on a cache miss.
+// The returned code can be shared by many instances at the same time
+func (c *CodeManager) GetExecCode(name string, codeHash []byte, store db.KVStore) (*ContractCode, error) {
+	key := codeKey{name: name, hash: string(codeHash)}
+
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	if _, ok := c.codes[name]; ok {
-		return nil, errors.New("old contract code not purged")
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		c.stats.Hits++
+		entry := elem.Value.(*codeEntry)
+		c.mutex.Unlock()
+		<-entry.ready
+		return entry.code, entry.err
 	}
+	c.stats.Misses++
+	entry := &codeEntry{
+		key:   key,
+		ready: make(chan struct{}),
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	c.evict()
+	c.mutex.Unlock()
 
-	execCode, err := c.makeExecCode(name)
+	// 编译不持有锁，不阻塞其他合约的调用
+	execCode, err := c.makeExecCode(name, store)
 	if err != nil {
-		return nil, err
+		entry.err = err
+		c.mutex.Lock()
+		c.remove(entry)
+		c.mutex.Unlock()
+	} else {
+		entry.code = &ContractCode{
+			ContractName: name,
+			ExecCode:     execCode,
+		}
 	}
-	code := &ContractCode{
-		ContractName: name,
-		ExecCode:     execCode,
+	close(entry.ready)
+	return entry.code, entry.err
+}
+
+// RemoveCode removes all the cached codes of the contract, it is called when the code may be corrupted
+func (c *CodeManager) RemoveCode(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for key, elem := range c.entries {
+		if key.name == name {
+			c.remove(elem.Value.(*codeEntry))
+		}
 	}
-	c.codes[name] = code
+}
 
-	return code, nil
+// RemoveStaleCode removes the cached codes of the contract other than codeHash together with their pooled instances,
+// it is called after an upgrade of the contract is committed
+func (c *CodeManager) RemoveStaleCode(name string, codeHash []byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for key, elem := range c.entries {
+		if key.name == name && key.hash != string(codeHash) {
+			c.remove(elem.Value.(*codeEntry))
+		}
+	}
 }
 
-func (c *CodeManager) RemoveCode(name string) {
+// Stats returns a snapshot of the cache counters
+func (c *CodeManager) Stats() CodeCacheStats {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	code, ok := c.codes[name]
-	if ok {
-		code.ExecCode.Release()
+	stats := c.stats
+	stats.Size = c.lru.Len()
+	stats.Capacity = c.capacity
+	for elem := c.lru.Front(); elem != nil; elem = elem.Next() {
+		entry := elem.Value.(*codeEntry)
+		select {
+		case <-entry.ready:
+		default:
+			continue
+		}
+		if entry.code == nil {
+			continue
+		}
+		if pool, ok := entry.code.ExecCode.(instancePoolStats); ok {
+			idle, reused := pool.PoolStats()
+			stats.IdleInstances += idle
+			stats.ReusedInstances += reused
+		}
+	}
+	return stats
+}
+
+func (c *CodeManager) evict() {
+	for c.lru.Len() > c.capacity {
+		c.remove(c.lru.Back().Value.(*codeEntry))
+		c.stats.Evictions++
+	}
+}
+
+// remove 需要持有锁，正在编译的代码在编译完成后释放。
+// 已经创建的实例不依赖ContractCode，代码被淘汰后仍可以继续执行
+func (c *CodeManager) remove(entry *codeEntry) {
+	elem, ok := c.entries[entry.key]
+	if !ok || elem.Value.(*codeEntry) != entry {
+		return
+	}
+	delete(c.entries, entry.key)
+	c.lru.Remove(elem)
+
+	release := func() {
+		if entry.code != nil {
+			entry.code.ExecCode.Release()
+		}
+	}
+	select {
+	case <-entry.ready:
+		release()
+	default:
+		go func() {
+			<-entry.ready
+			release()
+		}()
 	}
-	delete(c.codes, name)
 }
@@ -0,0 +1,206 @@
+package vm_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BeDreamCoder/uwavm/common/db"
+	"github.com/BeDreamCoder/uwavm/vm"
+	"github.com/BeDreamCoder/uwavm/wasm/exec"
+)
+
+// fakeCode 模拟编译后的代码，记录是否被释放以及池的统计
+type fakeCode struct {
+	name     string
+	released int32
+}
+
+func (c *fakeCode) NewContext(cfg *exec.ContextConfig) (exec.Context, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *fakeCode) Release() {
+	atomic.AddInt32(&c.released, 1)
+}
+
+func (c *fakeCode) PoolStats() (int, uint64) {
+	return 1, 2
+}
+
+// fakeCompiler 记录每个合约的编译次数
+type fakeCompiler struct {
+	mutex    sync.Mutex
+	compiled map[string]int
+	codes    []*fakeCode
+}
+
+func newFakeCompiler() *fakeCompiler {
+	return &fakeCompiler{
+		compiled: make(map[string]int),
+	}
+}
+
+func (f *fakeCompiler) compile(name string, store db.KVStore) (exec.WasmExec, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.compiled[name]++
+	code := &fakeCode{name: name}
+	f.codes = append(f.codes, code)
+	return code, nil
+}
+
+func (f *fakeCompiler) count(name string) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.compiled[name]
+}
+
+func getCode(t *testing.T, c *vm.CodeManager, name, hash string) *fakeCode {
+	code, err := c.GetExecCode(name, []byte(hash), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code.ExecCode.(*fakeCode)
+}
+
+func TestCodeCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	compiler := newFakeCompiler()
+	c := vm.NewCodeManager(compiler.compile, 2)
+
+	a := getCode(t, c, "a", "1")
+	b := getCode(t, c, "b", "1")
+	if getCode(t, c, "a", "1") != a {
+		t.Fatal("expect the cached code of a")
+	}
+	// a刚被使用过，c进入缓存时淘汰b
+	getCode(t, c, "c", "1")
+	if atomic.LoadInt32(&b.released) != 1 || atomic.LoadInt32(&a.released) != 0 {
+		t.Fatalf("expect b to be evicted and released, released a:%d b:%d", a.released, b.released)
+	}
+	if getCode(t, c, "a", "1") != a {
+		t.Fatal("expect a to be kept")
+	}
+	getCode(t, c, "b", "1")
+	if compiler.count("b") != 2 || compiler.count("a") != 1 {
+		t.Fatalf("bad compile count a:%d b:%d", compiler.count("a"), compiler.count("b"))
+	}
+
+	stats := c.Stats()
+	expect := vm.CodeCacheStats{
+		Hits:            2,
+		Misses:          4,
+		Evictions:       2,
+		Size:            2,
+		Capacity:        2,
+		IdleInstances:   2,
+		ReusedInstances: 4,
+	}
+	if stats != expect {
+		t.Fatalf("stats %+v, expect %+v", stats, expect)
+	}
+}
+
+func TestCodeCacheKeyedByHash(t *testing.T) {
+	compiler := newFakeCompiler()
+	c := vm.NewCodeManager(compiler.compile, 0)
+
+	old := getCode(t, c, "token", "v1")
+	upgraded := getCode(t, c, "token", "v2")
+	if old == upgraded || compiler.count("token") != 2 {
+		t.Fatal("expect the code of a new hash to be compiled")
+	}
+	// 新旧代码互不影响，未提交的升级不会替换其他调用使用的旧代码
+	if getCode(t, c, "token", "v1") != old || getCode(t, c, "token", "v2") != upgraded {
+		t.Fatal("expect both versions to be cached")
+	}
+
+	c.RemoveCode("token")
+	if atomic.LoadInt32(&old.released) != 1 || atomic.LoadInt32(&upgraded.released) != 1 {
+		t.Fatal("expect RemoveCode to release all the versions")
+	}
+	if getCode(t, c, "token", "v2") == upgraded {
+		t.Fatal("expect the removed code to be compiled again")
+	}
+}
+
+func TestCodeCacheRemovesStaleCode(t *testing.T) {
+	compiler := newFakeCompiler()
+	c := vm.NewCodeManager(compiler.compile, 0)
+
+	old := getCode(t, c, "token", "v1")
+	upgraded := getCode(t, c, "token", "v2")
+	other := getCode(t, c, "other", "v1")
+	c.RemoveStaleCode("token", []byte("v2"))
+	if atomic.LoadInt32(&old.released) != 1 {
+		t.Fatal("expect the superseded code to be released")
+	}
+	if atomic.LoadInt32(&upgraded.released) != 0 || atomic.LoadInt32(&other.released) != 0 {
+		t.Fatal("expect the current code and other contracts to be kept")
+	}
+	if stats := c.Stats(); stats.Size != 2 || stats.IdleInstances != 2 {
+		t.Fatalf("stats after removing the stale code %+v", stats)
+	}
+}
+
+func TestCodeCacheCompilesOnce(t *testing.T) {
+	var compiled int32
+	start := make(chan struct{})
+	code := &fakeCode{}
+	c := vm.NewCodeManager(func(name string, store db.KVStore) (exec.WasmExec, error) {
+		atomic.AddInt32(&compiled, 1)
+		<-start
+		return code, nil
+	}, 0)
+
+	const callers = 8
+	var wg sync.WaitGroup
+	codes := make([]*vm.ContractCode, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i], _ = c.GetExecCode("token", []byte("v1"), nil)
+		}(i)
+	}
+	// 等待所有请求都进入缓存后再完成编译
+	for c.Stats().Hits+c.Stats().Misses < callers {
+		time.Sleep(time.Millisecond)
+	}
+	close(start)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&compiled); n != 1 {
+		t.Fatalf("expect the code to be compiled once, got %d", n)
+	}
+	for i, got := range codes {
+		if got == nil || got.ExecCode != code {
+			t.Fatalf("caller %d got %v", i, got)
+		}
+	}
+	if stats := c.Stats(); stats.Misses != 1 || stats.Hits != callers-1 {
+		t.Fatalf("bad stats %+v", stats)
+	}
+}
+
+func TestCodeCacheDropsCompileError(t *testing.T) {
+	fail := true
+	c := vm.NewCodeManager(func(name string, store db.KVStore) (exec.WasmExec, error) {
+		if fail {
+			return nil, errors.New("bad code")
+		}
+		return &fakeCode{}, nil
+	}, 0)
+	if _, err := c.GetExecCode("token", []byte("v1"), nil); err == nil {
+		t.Fatal("expect compile error")
+	}
+	if stats := c.Stats(); stats.Size != 0 {
+		t.Fatalf("compile error should not be cached, size %d", stats.Size)
+	}
+	fail = false
+	if _, err := c.GetExecCode("token", []byte("v1"), nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,5 +1,7 @@
 package gas
 
+import "fmt"
+
 const (
 	maxResourceLimit = 0xFFFFFFFF
 	CpuRate          = 1000
@@ -51,6 +53,28 @@ func (l Limits) Exceed(l1 Limits) bool {
 		l.Fee > l1.Fee
 }
 
+// FromGas returns the limits which allow each resource to consume up to n gas
+func FromGas(n int64) Limits {
+	return Limits{
+		Cpu:    n * CpuRate,
+		Memory: n * MemRate,
+		Disk:   n * DiskRate,
+		Fee:    n * FeeRate,
+	}
+}
+
+// ErrOutOfGas is returned when the resource used by a contract call exceeds the limits
+type ErrOutOfGas struct {
+	Limits Limits
+	Used   Limits
+}
+
+// Error implements error interface
+func (e *ErrOutOfGas) Error() string {
+	return fmt.Sprintf("out of gas, used cpu:%d memory:%d disk:%d fee:%d gas:%d",
+		e.Used.Cpu, e.Used.Memory, e.Used.Disk, e.Used.Fee, e.Used.TotalGas())
+}
+
 // MaxLimits describes the maximum limit of resources
 var MaxLimits = Limits{
 	Cpu:    maxResourceLimit,
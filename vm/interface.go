@@ -13,9 +13,15 @@ type InstanceCreator interface {
 	// CreateInstance instances a wasm virtual machine instance which can run a single contract call
 	CreateInstance(ctx *bridge.ContractState) (bridge.Instance, error)
 	RemoveCache(name string)
+	// RemoveStaleCache drops the cached codes of the contract other than codeHash
+	RemoveStaleCache(name string, codeHash []byte)
+	// CodeCacheStats returns the counters of the compiled code cache
+	CodeCacheStats() CodeCacheStats
 }
 
 type CodeHandle interface {
-	GetExecCode(name string) (*ContractCode, error)
+	GetExecCode(name string, codeHash []byte, store db.KVStore) (*ContractCode, error)
 	RemoveCode(name string)
+	RemoveStaleCode(name string, codeHash []byte)
+	Stats() CodeCacheStats
 }
@@ -18,14 +18,21 @@ type vmInstance struct {
 }
 
 func createInstance(ctx *bridge.ContractState, code *vm.ContractCode) (bridge.Instance, error) {
-	execCtx, err := code.ExecCode.NewContext(exec.DefaultContextConfig())
+	config := exec.DefaultContextConfig()
+	if ctx.Limits.Cpu < config.GasLimit {
+		config.GasLimit = ctx.Limits.Cpu
+	}
+	execCtx, err := code.ExecCode.NewContext(config)
 	if err != nil {
 		log.GetLogger().Error("create contract context error", "error", err, "contract", ctx.ContractName)
 		return nil, err
 	}
 	switch ctx.Language {
 	case "go":
-		gowasm.RegisterRuntime(execCtx)
+		// 池中保持运行的go runtime继续使用
+		if rt := gowasm.GetRuntime(execCtx); rt == nil || !rt.Live() {
+			gowasm.RegisterRuntime(execCtx)
+		}
 	case "c":
 		err = emscripten.Init(execCtx)
 		if err != nil {
@@ -46,12 +53,18 @@ func (x *vmInstance) Exec(function string) error {
 	if mem == nil {
 		return errors.New("bad contract, no memory")
 	}
-	var args []int64
-	// go's entry function expects argc and argv these two arguments
+	var err error
 	if x.bridgeCtx.Language == "go" {
-		args = []int64{0, 0}
+		err = gowasm.GetRuntime(x.execCtx).Call(function, x.bridgeCtx.Method)
+	} else {
+		_, err = x.execCtx.Exec(function, nil)
+	}
+	if trap, ok := err.(*exec.TrapError); ok && trap.Trap == exec.TrapGasExhaustion {
+		err = &gas.ErrOutOfGas{
+			Limits: x.bridgeCtx.Limits,
+			Used:   x.ResourceUsed(),
+		}
 	}
-	_, err := x.execCtx.Exec(function, args)
 	if err != nil {
 		log.GetLogger().Error("exec contract error", "error", err, "contract", x.bridgeCtx.ContractName)
 	}
@@ -66,6 +79,8 @@ func (x *vmInstance) ResourceUsed() gas.Limits {
 	if mem != nil {
 		limits.Memory = int64(len(mem))
 	}
+	limits.Add(x.bridgeCtx.SubResourceUsed)
+	limits.Add(x.bridgeCtx.SyscallResourceUsed)
 	return limits
 }
 
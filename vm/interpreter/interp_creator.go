@@ -24,12 +24,12 @@ func newInterpCreator(syscallService *bridge.SyscallService, db db.Database) (vm
 		syscallService: syscallService,
 		db:             db,
 	}
-	creator.chd = vm.NewCodeManager(creator.makeExecCode)
+	creator.chd = vm.NewCodeManager(creator.makeExecCode, vm.DefaultCodeCacheSize)
 	return creator, nil
 }
 
-func (x *interpCreator) makeExecCode(contractName string) (exec.WasmExec, error) {
-	codebuf, err := x.GetContractCode(contractName)
+func (x *interpCreator) makeExecCode(contractName string, store db.KVStore) (exec.WasmExec, error) {
+	codebuf, err := getContractCode(store, contractName)
 	if err != nil {
 		return nil, err
 	}
@@ -37,23 +37,49 @@ func (x *interpCreator) makeExecCode(contractName string) (exec.WasmExec, error)
 		gowasm.NewResolver(),
 		emscripten.NewResolver(),
 		newSyscallResolver(x.syscallService))
-	return exec.NewInterpCode(codebuf, resolver)
+	code, err := exec.NewInterpCode(codebuf, resolver)
+	if err != nil {
+		return nil, err
+	}
+	// 代码被CodeManager淘汰时释放池中的实例
+	return newInstancePool(code, DefaultInstancePoolSize), nil
 }
 
 func (x *interpCreator) CreateInstance(ctx *bridge.ContractState) (bridge.Instance, error) {
-	code, err := x.chd.GetExecCode(ctx.ContractName)
+	// 通过合约调用的WriteSet读取，部署和升级时可以看到尚未提交的代码
+	desc, err := bridge.GetContractDesc(ctx.WriteSet, ctx.ContractName)
 	if err != nil {
 		return nil, err
 	}
-	return createInstance(ctx, code)
+	// 不信任调用方传入的语言，以部署时的描述信息为准
+	ctx.Language = desc.GetLanguage()
+	code, err := x.chd.GetExecCode(ctx.ContractName, desc.GetCodeHash(), ctx.WriteSet)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := createInstance(ctx, code)
+	if err != nil {
+		// 编译后的代码无法创建实例时视为损坏，下次调用重新编译
+		x.chd.RemoveCode(ctx.ContractName)
+		return nil, err
+	}
+	return instance, nil
 }
 
 func (x *interpCreator) RemoveCache(contractName string) {
 	x.chd.RemoveCode(contractName)
 }
 
-func (x *interpCreator) GetContractCode(name string) ([]byte, error) {
-	codebuf, err := x.db.Get(util.ContractCodeKey(name))
+func (x *interpCreator) RemoveStaleCache(contractName string, codeHash []byte) {
+	x.chd.RemoveStaleCode(contractName, codeHash)
+}
+
+func (x *interpCreator) CodeCacheStats() vm.CodeCacheStats {
+	return x.chd.Stats()
+}
+
+func getContractCode(store db.KVStore, name string) ([]byte, error) {
+	codebuf, err := store.Get(util.ContractCodeKey(name))
 	if err != nil {
 		return nil, fmt.Errorf("get contract code for '%s' error:%s", name, err)
 	}
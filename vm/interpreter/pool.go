@@ -0,0 +1,136 @@
+package interpreter
+
+import (
+	"sync"
+
+	"github.com/BeDreamCoder/uwavm/common/log"
+	"github.com/BeDreamCoder/uwavm/wasm/exec"
+	gowasm "github.com/BeDreamCoder/uwavm/wasm/runtime/go"
+)
+
+// DefaultInstancePoolSize is the max number of idle contexts kept for each contract code
+const DefaultInstancePoolSize = 8
+
+// GoRuntimePoolSize is the max number of idle contexts of a go contract code,
+// go runtime的内存较大（最多约1GB），空闲时常驻内存，只保留少量context
+const GoRuntimePoolSize = 2
+
+// instancePool 缓存同一份合约代码创建的执行上下文，调用结束后放回池中，
+// 再次使用前恢复内存和全局变量，热点合约不必每次重新实例化。
+// 保持运行的go runtime恢复到main注册完方法回调后的快照，不需要重新运行main
+type instancePool struct {
+	code exec.WasmExec
+	size int
+
+	mutex  sync.Mutex
+	idle   []exec.Context
+	reused uint64
+	closed bool
+}
+
+func newInstancePool(code exec.WasmExec, size int) *instancePool {
+	if size <= 0 {
+		size = DefaultInstancePoolSize
+	}
+	return &instancePool{
+		code: code,
+		size: size,
+	}
+}
+
+// NewContext reuses an idle context if there is one, otherwise a new context is created
+func (p *instancePool) NewContext(cfg *exec.ContextConfig) (exec.Context, error) {
+	for {
+		ctx := p.pop()
+		if ctx == nil {
+			break
+		}
+		if err := ctx.Reset(cfg); err != nil {
+			log.GetLogger().Debug("drop pooled context", "error", err)
+			ctx.Release()
+			continue
+		}
+		// 有快照的context在Reset后保留了go runtime，宿主状态同样恢复到快照
+		if rt := gowasm.GetRuntime(ctx); rt != nil {
+			rt.Restore()
+		}
+		return p.reuse(ctx), nil
+	}
+	ctx, err := p.code.NewContext(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &pooledContext{Context: ctx, pool: p}, nil
+}
+
+// Release releases the idle contexts and the code, contexts in use are released when they are put back
+func (p *instancePool) Release() {
+	p.mutex.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.closed = true
+	p.mutex.Unlock()
+
+	for _, ctx := range idle {
+		ctx.Release()
+	}
+	p.code.Release()
+}
+
+func (p *instancePool) pop() exec.Context {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	n := len(p.idle)
+	if n == 0 {
+		return nil
+	}
+	ctx := p.idle[n-1]
+	p.idle = p.idle[:n-1]
+	return ctx
+}
+
+func (p *instancePool) reuse(ctx exec.Context) exec.Context {
+	p.mutex.Lock()
+	p.reused++
+	p.mutex.Unlock()
+	return &pooledContext{Context: ctx, pool: p}
+}
+
+// PoolStats returns the number of idle contexts and how many times an idle context was reused
+func (p *instancePool) PoolStats() (int, uint64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.idle), p.reused
+}
+
+func (p *instancePool) put(ctx exec.Context) {
+	size := p.size
+	if gowasm.GetRuntime(ctx) != nil && size > GoRuntimePoolSize {
+		size = GoRuntimePoolSize
+	}
+	p.mutex.Lock()
+	if !p.closed && len(p.idle) < size {
+		p.idle = append(p.idle, ctx)
+		ctx = nil
+	}
+	p.mutex.Unlock()
+
+	if ctx != nil {
+		ctx.Release()
+	}
+}
+
+// pooledContext 释放时放回池中而不是销毁
+type pooledContext struct {
+	exec.Context
+	pool     *instancePool
+	released bool
+}
+
+func (c *pooledContext) Release() {
+	if c.released {
+		return
+	}
+	c.released = true
+	c.pool.put(c.Context)
+}
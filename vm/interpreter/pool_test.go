@@ -0,0 +1,92 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/wasm/exec"
+	gowasm "github.com/BeDreamCoder/uwavm/wasm/runtime/go"
+)
+
+// bumpModule 导出函数bump，每次调用将全局变量和内存地址0处的i32各加1，返回两者之和
+var bumpModule = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+	// type: () -> i32
+	0x01, 0x05, 0x01, 0x60, 0x00, 0x01, 0x7f,
+	0x03, 0x02, 0x01, 0x00,
+	// memory: 1 page
+	0x05, 0x03, 0x01, 0x00, 0x01,
+	// global: mut i32 = 0
+	0x06, 0x06, 0x01, 0x7f, 0x01, 0x41, 0x00, 0x0b,
+	0x07, 0x08, 0x01, 0x04, 'b', 'u', 'm', 'p', 0x00, 0x00,
+	0x0a, 0x20, 0x01, 0x1e, 0x00,
+	0x23, 0x00, 0x41, 0x01, 0x6a, 0x24, 0x00, // global += 1
+	0x41, 0x00, 0x41, 0x00, 0x28, 0x02, 0x00, 0x41, 0x01, 0x6a, 0x36, 0x02, 0x00, // mem[0] += 1
+	0x23, 0x00, 0x41, 0x00, 0x28, 0x02, 0x00, 0x6a, // global + mem[0]
+	0x0b,
+}
+
+func TestInstancePoolRestoresContext(t *testing.T) {
+	code, err := exec.NewInterpCode(bumpModule, exec.NewMultiResolver())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := newInstancePool(code, 1)
+	defer pool.Release()
+
+	var first exec.Context
+	var gasUsed int64
+	for i := 0; i < 3; i++ {
+		ctx, err := pool.NewContext(exec.DefaultContextConfig())
+		if err != nil {
+			t.Fatal(err)
+		}
+		inner := ctx.(*pooledContext).Context
+		if first == nil {
+			first = inner
+		} else if inner != first {
+			t.Fatalf("call %d: pooled context not reused", i)
+		}
+		ret, err := ctx.Exec("bump", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ret != 2 {
+			t.Fatalf("call %d: expect 2 with clean memory and globals, got %d", i, ret)
+		}
+		if i == 0 {
+			gasUsed = ctx.GasUsed()
+		} else if ctx.GasUsed() != gasUsed {
+			t.Fatalf("call %d: gas %d, expect %d", i, ctx.GasUsed(), gasUsed)
+		}
+		ctx.Release()
+	}
+	if idle, reused := pool.PoolStats(); idle != 1 || reused != 2 {
+		t.Fatalf("expect 1 idle context reused twice, got idle:%d reused:%d", idle, reused)
+	}
+}
+
+func TestInstancePoolCapsGoRuntime(t *testing.T) {
+	code, err := exec.NewInterpCode(bumpModule, exec.NewMultiResolver())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := newInstancePool(code, DefaultInstancePoolSize)
+	defer pool.Release()
+
+	var contexts []exec.Context
+	for i := 0; i < DefaultInstancePoolSize; i++ {
+		ctx, err := pool.NewContext(exec.DefaultContextConfig())
+		if err != nil {
+			t.Fatal(err)
+		}
+		gowasm.RegisterRuntime(ctx.(*pooledContext).Context)
+		contexts = append(contexts, ctx)
+	}
+	for _, ctx := range contexts {
+		ctx.Release()
+	}
+	// go runtime的context只保留GoRuntimePoolSize个，其余直接释放
+	if idle, _ := pool.PoolStats(); idle != GoRuntimePoolSize {
+		t.Fatalf("expect %d idle go contexts, got %d", GoRuntimePoolSize, idle)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 
 	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -15,16 +16,29 @@ var (
 	ErrMethodNotFound = errors.New("syscall method not found")
 )
 
+const (
+	// 每次系统调用的固定cpu消耗
+	syscallCost = 1000
+	// 系统调用请求和响应每字节的cpu消耗
+	syscallByteCost = 10
+)
+
 var (
 	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
 	messageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
 	errorType   = reflect.TypeOf((*error)(nil)).Elem()
 )
 
+// resourceCharger charges the resource used by syscalls to the calling contract
+type resourceCharger interface {
+	ChargeResource(ctxid int64, limits gas.Limits) error
+}
+
 // Server represents memory RPC server
 type Server struct {
 	methods map[string]*reflect.Method
 	syscall reflect.Value
+	charger resourceCharger
 }
 
 func isContextType(tp reflect.Type) bool {
@@ -62,9 +76,11 @@ func parseMethods(syscall interface{}) map[string]*reflect.Method {
 
 // NewServer instances a new Server
 func NewServer(syscall interface{}) *Server {
+	charger, _ := syscall.(resourceCharger)
 	return &Server{
 		methods: parseMethods(syscall),
 		syscall: reflect.ValueOf(syscall),
+		charger: charger,
 	}
 }
 
@@ -102,6 +118,11 @@ func (s *Server) CallMethod(ctx context.Context, ctxid int64, method string, req
 	})
 	retErr := ret[1].Interface()
 	if retErr != nil {
+		if err = s.charge(ctxid, gas.Limits{
+			Cpu: syscallCost + syscallByteCost*int64(len(requestBuf)),
+		}); err != nil {
+			return nil, err
+		}
 		return nil, retErr.(error)
 	}
 	response := ret[0].Interface().(proto.Message)
@@ -109,5 +130,31 @@ func (s *Server) CallMethod(ctx context.Context, ctxid int64, method string, req
 	if err != nil {
 		return nil, fmt.Errorf("marshal response error:%s", err)
 	}
+	if err = s.charge(ctxid, gas.Limits{
+		Cpu:  syscallCost + syscallByteCost*int64(len(requestBuf)+len(responseBuf)),
+		Disk: diskUsage(reqmsg),
+	}); err != nil {
+		return nil, err
+	}
 	return responseBuf, nil
 }
+
+// charge 计入系统调用的资源消耗，超出合约的资源上限时系统调用失败
+func (s *Server) charge(ctxid int64, limits gas.Limits) error {
+	if s.charger == nil {
+		return nil
+	}
+	return s.charger.ChargeResource(ctxid, limits)
+}
+
+// diskUsage returns the bytes a successful syscall adds to the storage
+func diskUsage(request proto.Message) int64 {
+	switch req := request.(type) {
+	case *pb.PutRequest:
+		return int64(len(req.GetKey()) + len(req.GetValue()))
+	case *pb.EmitEventRequest:
+		return int64(len(req.GetName()) + len(req.GetBody()))
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,82 @@
+package interpreter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+	"github.com/golang/protobuf/proto"
+)
+
+// chargedSyscall 记录系统调用的资源消耗，消耗超出limits时返回out of gas
+type chargedSyscall struct {
+	used   gas.Limits
+	limits gas.Limits
+}
+
+func (c *chargedSyscall) ChargeResource(ctxid int64, limits gas.Limits) error {
+	c.used.Add(limits)
+	if c.used.Exceed(c.limits) {
+		return &gas.ErrOutOfGas{Limits: c.limits, Used: c.used}
+	}
+	return nil
+}
+
+func (c *chargedSyscall) PutObject(ctx context.Context, in *pb.PutRequest) (*pb.PutResponse, error) {
+	return &pb.PutResponse{}, nil
+}
+
+func (c *chargedSyscall) GetObject(ctx context.Context, in *pb.GetRequest) (*pb.GetResponse, error) {
+	if string(in.GetKey()) == "missing" {
+		return nil, errors.New("not found")
+	}
+	return &pb.GetResponse{Value: []byte("value")}, nil
+}
+
+func TestSyscallCharge(t *testing.T) {
+	put, _ := proto.Marshal(&pb.PutRequest{Key: []byte("key"), Value: []byte("value")})
+	get, _ := proto.Marshal(&pb.GetRequest{Key: []byte("key")})
+	missing, _ := proto.Marshal(&pb.GetRequest{Key: []byte("missing")})
+	getResp, _ := proto.Marshal(&pb.GetResponse{Value: []byte("value")})
+
+	cases := []struct {
+		method  string
+		request []byte
+		expect  gas.Limits
+		err     bool
+	}{
+		// 写操作按key和value的字节数计入disk
+		{"PutObject", put, gas.Limits{Cpu: syscallCost + syscallByteCost*int64(len(put)), Disk: 8}, false},
+		{"GetObject", get, gas.Limits{Cpu: syscallCost + syscallByteCost*int64(len(get)+len(getResp))}, false},
+		// 失败的系统调用只按请求计费
+		{"GetObject", missing, gas.Limits{Cpu: syscallCost + syscallByteCost*int64(len(missing))}, true},
+	}
+	for _, c := range cases {
+		syscall := &chargedSyscall{limits: gas.MaxLimits}
+		server := NewServer(syscall)
+		_, err := server.CallMethod(context.Background(), 1, c.method, c.request)
+		if (err != nil) != c.err {
+			t.Errorf("%s: unexpected error %v", c.method, err)
+		}
+		if syscall.used != c.expect {
+			t.Errorf("%s: charged %+v, expect %+v", c.method, syscall.used, c.expect)
+		}
+	}
+}
+
+func TestSyscallOutOfGas(t *testing.T) {
+	put, _ := proto.Marshal(&pb.PutRequest{Key: []byte("key"), Value: []byte("value")})
+	syscall := &chargedSyscall{limits: gas.Limits{Cpu: syscallCost * 10, Disk: 7}}
+	server := NewServer(syscall)
+	_, err := server.CallMethod(context.Background(), 1, "PutObject", put)
+	var outOfGas *gas.ErrOutOfGas
+	if !errors.As(err, &outOfGas) {
+		t.Fatalf("expect out of gas, got %v", err)
+	}
+
+	if _, err = server.CallMethod(context.Background(), 1, "DeleteObject", put); err != ErrMethodNotFound {
+		t.Fatalf("expect method not found, got %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+//go:build race
+// +build race
+
+package vm_test
+
+func init() {
+	raceEnabled = true
+}
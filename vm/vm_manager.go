@@ -1,14 +1,20 @@
 package vm
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/BeDreamCoder/uwavm/bridge"
 	"github.com/BeDreamCoder/uwavm/common/db"
 	"github.com/BeDreamCoder/uwavm/common/util"
 	"github.com/BeDreamCoder/uwavm/contract/go/pb"
 	"github.com/BeDreamCoder/uwavm/vm/gas"
+	"github.com/BeDreamCoder/uwavm/wasm/exec"
 	log "github.com/inconshreveable/log15"
 )
 
@@ -48,131 +54,391 @@ func (v *VMManager) NewCreatorInstance(ctx *bridge.ContractState) (bridge.Instan
 	}, nil
 }
 
-// TODO:校验名字
+// RemoveCache drops the compiled code and the pooled instances of the contract,
+// the next call of the contract compiles the code and starts a new instance
+func (v *VMManager) RemoveCache(name string) {
+	v.vmimpl.RemoveCache(name)
+}
+
+// ContractCodeCommitted implements bridge.CodeListener, the codes replaced by the committed upgrade
+// and their pooled instances are released
+func (v *VMManager) ContractCodeCommitted(name string, codeHash []byte) {
+	v.vmimpl.RemoveStaleCache(name, codeHash)
+}
+
+// CodeCacheStats returns the counters of the compiled contract code cache
+func (v *VMManager) CodeCacheStats() CodeCacheStats {
+	return v.vmimpl.CodeCacheStats()
+}
+
+const (
+	minContractNameLen = 4
+	maxContractNameLen = 64
+)
+
+var (
+	// ErrContractExists is returned when deploying a contract whose name has been used
+	ErrContractExists = errors.New("contract already exists")
+
+	// 合约名以字母开头，只能包含字母、数字、下划线和点
+	contractNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.]*$`)
+	// 保留给系统合约的名字前缀，不区分大小写
+	reservedContractPrefixes = []string{"uwavm", "system"}
+)
+
+// verifyContractName checks the contract name against the naming policy
 func (v *VMManager) verifyContractName(name string) error {
+	if len(name) < minContractNameLen || len(name) > maxContractNameLen {
+		return fmt.Errorf("contract name %q length should be between %d and %d", name, minContractNameLen, maxContractNameLen)
+	}
+	if !contractNamePattern.MatchString(name) {
+		return fmt.Errorf("contract name %q should start with a letter and contain only letters, digits, '_' and '.'", name)
+	}
+	for _, prefix := range reservedContractPrefixes {
+		if strings.HasPrefix(strings.ToLower(name), prefix) {
+			return fmt.Errorf("contract name %q uses reserved prefix %q", name, prefix)
+		}
+	}
 	return nil
 }
 
+func (v *VMManager) contractExists(store db.KVStore, name string) (bool, error) {
+	desc, err := store.Get(util.ContractCodeDescKey(name))
+	if err != nil {
+		return false, err
+	}
+	return len(desc) != 0, nil
+}
+
 // DeployContract deploy contract and initialize contract
-func (v *VMManager) DeployContract(args map[string][]byte) (*pb.Response, gas.Limits, error) {
+func (v *VMManager) DeployContract(args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *bridge.RWSet, error) {
 	name := args["contract_name"]
 	if name == nil {
-		return nil, gas.Limits{}, errors.New("bad contract name")
+		return nil, gas.Limits{}, nil, errors.New("bad contract name")
 	}
 	contractName := string(name)
 	err := v.verifyContractName(contractName)
 	if err != nil {
-		return nil, gas.Limits{}, err
+		return nil, gas.Limits{}, nil, err
+	}
+	// 代码和描述信息与初始化的写集合一同提交，初始化失败时不会留下部署记录
+	root := bridge.NewWriteSet(v.db)
+	exists, err := v.contractExists(root, contractName)
+	if err != nil {
+		return nil, gas.Limits{}, nil, err
+	}
+	if exists {
+		return nil, gas.Limits{}, nil, fmt.Errorf("%w: %s, use upgrade to replace its code", ErrContractExists, contractName)
 	}
 
 	code := args["contract_code"]
 	if code == nil {
-		return nil, gas.Limits{}, errors.New("missing contract code")
+		return nil, gas.Limits{}, nil, errors.New("missing contract code")
 	}
 
 	language := args["language"]
 	if language == nil {
-		return nil, gas.Limits{}, errors.New("missing contract language")
+		return nil, gas.Limits{}, nil, errors.New("missing contract language")
 	}
 
 	initArgsBuf := args["args"]
 	if initArgsBuf == nil {
-		return nil, gas.Limits{}, errors.New("missing args field in args")
+		return nil, gas.Limits{}, nil, errors.New("missing args field in args")
 	}
 	var initArgs map[string][]byte
 	if err = json.Unmarshal(initArgsBuf, &initArgs); err != nil {
-		return nil, gas.Limits{}, err
+		return nil, gas.Limits{}, nil, err
 	}
 
 	caller := args["caller"]
 	if caller == nil {
-		return nil, gas.Limits{}, errors.New("missing contract caller")
+		return nil, gas.Limits{}, nil, errors.New("missing contract caller")
+	}
+
+	env, err := parseEnvironment(args, string(caller))
+	if err != nil {
+		return nil, gas.Limits{}, nil, err
 	}
 
-	if err = v.db.Put(util.ContractCodeKey(contractName), code); err != nil {
-		return nil, gas.Limits{}, err
+	codeHash := sha256.Sum256(code)
+	desc := &pb.ContractDesc{
+		Name:         contractName,
+		Language:     string(language),
+		CodeHash:     codeHash[:],
+		CodeSize:     int64(len(code)),
+		Deployer:     string(caller),
+		DeployTime:   env.Timestamp,
+		Version:      1,
+		Abi:          args["abi"],
+		NonReentrant: string(args["non_reentrant"]) == "true",
+	}
+	if err = root.Put(util.ContractCodeKey(contractName), code); err != nil {
+		return nil, gas.Limits{}, nil, err
 	}
-	if err = v.db.Put(util.ContractCodeDescKey(contractName), language); err != nil {
-		return nil, gas.Limits{}, err
+	if err = bridge.PutContractDesc(root, desc); err != nil {
+		return nil, gas.Limits{}, nil, err
 	}
 
 	state := &bridge.ContractState{
 		ContractName: contractName,
 		Language:     string(language),
 		Caller:       string(caller),
+		Env:          env,
+		Limits:       limits,
+		WriteSet:     root,
 	}
 
-	out, resourceUsed, err := v.invokeContract(state, util.InitContractMethod, initArgs)
+	out, resourceUsed, rwset, err := v.invokeContract(state, util.InitContractMethod, initArgs)
+	if err != nil || out.GetStatus() >= bridge.StatusErrorThreshold {
+		// 初始化失败时丢弃整个写集合，合约名可以重新使用
+		v.vmimpl.RemoveCache(contractName)
+	}
 	if err != nil {
-		if _, ok := err.(*bridge.ContractError); !ok {
-			v.vmimpl.RemoveCache(contractName)
-		}
 		log.Error("call contract initialize method error", "error", err, "contract", contractName)
-		return nil, gas.Limits{}, err
+		return nil, resourceUsed, nil, err
 	}
-	return out, resourceUsed, nil
+	if out.GetStatus() >= bridge.StatusErrorThreshold {
+		return out, resourceUsed, nil, nil
+	}
+	return out, resourceUsed, rwset, nil
 }
 
-func (v *VMManager) InvokeContract(method string, args map[string][]byte) (*pb.Response, gas.Limits, error) {
+// UpgradeContract replaces the code of a deployed contract and calls the optional migrate method,
+// the contract state is kept and only the deployer can upgrade the contract.
+// The upgrade consumes the next nonce of the caller like an invocation
+func (v *VMManager) UpgradeContract(args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *bridge.RWSet, error) {
 	name := args["contract_name"]
 	if name == nil {
-		return nil, gas.Limits{}, errors.New("bad contract name")
+		return nil, gas.Limits{}, nil, errors.New("bad contract name")
 	}
 	contractName := string(name)
-	err := v.verifyContractName(contractName)
+
+	code := args["contract_code"]
+	if code == nil {
+		return nil, gas.Limits{}, nil, errors.New("missing contract code")
+	}
+
+	migrateArgsBuf := args["args"]
+	if migrateArgsBuf == nil {
+		return nil, gas.Limits{}, nil, errors.New("missing args field in args")
+	}
+	var migrateArgs map[string][]byte
+	if err := json.Unmarshal(migrateArgsBuf, &migrateArgs); err != nil {
+		return nil, gas.Limits{}, nil, err
+	}
+
+	caller := args["caller"]
+	if caller == nil {
+		return nil, gas.Limits{}, nil, errors.New("missing contract caller")
+	}
+
+	// 升级同样消耗caller的下一个nonce，升级失败时只提交nonce，旧的升级请求不能被重放
+	root := bridge.NewWriteSet(v.db)
+	nonce, err := bridge.ParseNonce(args)
+	if err != nil {
+		return nil, gas.Limits{}, nil, err
+	}
+	if err = bridge.UseNonce(root, string(caller), nonce); err != nil {
+		return nil, gas.Limits{}, nil, err
+	}
+
+	// 新的代码和描述信息与迁移的写集合一同提交，迁移失败时旧版本保持不变
+	upgrade := root.Fork()
+	oldDesc, err := bridge.GetContractDesc(upgrade, contractName)
+	if err != nil {
+		return nil, gas.Limits{}, root.RWSet(), err
+	}
+	if oldDesc.GetDeployer() != string(caller) {
+		return nil, gas.Limits{}, root.RWSet(), fmt.Errorf("only the deployer %s can upgrade contract %s", oldDesc.GetDeployer(), contractName)
+	}
+
+	env, err := parseEnvironment(args, string(caller))
 	if err != nil {
-		return nil, gas.Limits{}, err
+		return nil, gas.Limits{}, root.RWSet(), err
+	}
+
+	// 语言、ABI以及重入设置未指定时沿用旧版本
+	language := oldDesc.GetLanguage()
+	if args["language"] != nil {
+		language = string(args["language"])
+	}
+	abi := oldDesc.GetAbi()
+	if args["abi"] != nil {
+		abi = args["abi"]
+	}
+	nonReentrant := oldDesc.GetNonReentrant()
+	if args["non_reentrant"] != nil {
+		nonReentrant = string(args["non_reentrant"]) == "true"
+	}
+	codeHash := sha256.Sum256(code)
+	desc := &pb.ContractDesc{
+		Name:         contractName,
+		Language:     language,
+		CodeHash:     codeHash[:],
+		CodeSize:     int64(len(code)),
+		Deployer:     oldDesc.GetDeployer(),
+		DeployTime:   env.Timestamp,
+		Version:      oldDesc.GetVersion() + 1,
+		Abi:          abi,
+		NonReentrant: nonReentrant,
+	}
+	if err = upgrade.Put(util.ContractCodeKey(contractName), code); err != nil {
+		return nil, gas.Limits{}, root.RWSet(), err
+	}
+	if err = bridge.PutContractDesc(upgrade, desc); err != nil {
+		return nil, gas.Limits{}, root.RWSet(), err
+	}
+
+	// 编译后的代码以代码hash区分，迁移使用新代码，提交前的其他调用仍使用旧代码
+	state := &bridge.ContractState{
+		ContractName: contractName,
+		Caller:       string(caller),
+		Env:          env,
+		Limits:       limits,
+		WriteSet:     upgrade,
+	}
+	out, resourceUsed, _, err := v.invokeContract(state, util.MigrateContractMethod, migrateArgs)
+	if _, ok := err.(*exec.ErrFuncNotFound); ok {
+		// 合约没有实现migrate方法，不需要迁移状态
+		upgrade.Commit()
+		return &pb.Response{
+			Status: 200,
+			Body:   []byte("upgrade success"),
+		}, resourceUsed, root.RWSet(), nil
+	}
+	if err == nil && out.GetStatus() >= bridge.StatusErrorThreshold {
+		err = &bridge.ContractError{
+			Status:  int(out.GetStatus()),
+			Message: out.GetMessage(),
+		}
+	}
+	if err != nil {
+		log.Error("call contract migrate method error", "error", err, "contract", contractName)
+		return nil, resourceUsed, root.RWSet(), err
+	}
+	upgrade.Commit()
+	return out, resourceUsed, root.RWSet(), nil
+}
+
+func (v *VMManager) InvokeContract(method string, args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, *bridge.RWSet, error) {
+	return v.callContract(method, args, limits, false)
+}
+
+// QueryContract invokes the contract in read-only mode, any write syscall fails
+func (v *VMManager) QueryContract(method string, args map[string][]byte, limits gas.Limits) (*pb.Response, gas.Limits, error) {
+	out, resourceUsed, _, err := v.callContract(method, args, limits, true)
+	return out, resourceUsed, err
+}
+
+func (v *VMManager) callContract(method string, args map[string][]byte, limits gas.Limits, readOnly bool) (*pb.Response, gas.Limits, *bridge.RWSet, error) {
+	name := args["contract_name"]
+	if name == nil {
+		return nil, gas.Limits{}, nil, errors.New("bad contract name")
 	}
+	// 命名规则只在部署时校验，之前部署的不符合规则的合约仍然可以调用
+	contractName := string(name)
 
 	caller := args["caller"]
 	if caller == nil {
-		return nil, gas.Limits{}, errors.New("missing contract caller")
+		return nil, gas.Limits{}, nil, errors.New("missing contract caller")
 	}
 
-	language := args["language"]
-	if language == nil {
-		return nil, gas.Limits{}, errors.New("missing contract language")
+	// 调用必须携带caller的下一个nonce，nonce与合约的写集合一同提交，防止请求被重放。
+	// 之后的任何失败都返回只包含nonce的读写集合，失败的请求同样消耗nonce
+	root := bridge.NewWriteSet(v.db)
+	if !readOnly {
+		nonce, err := bridge.ParseNonce(args)
+		if err != nil {
+			return nil, gas.Limits{}, nil, err
+		}
+		if err = bridge.UseNonce(root, string(caller), nonce); err != nil {
+			return nil, gas.Limits{}, nil, err
+		}
+	}
+	nonceRWSet := func() *bridge.RWSet {
+		if readOnly {
+			return nil
+		}
+		return root.RWSet()
 	}
 
 	argsBuf := args["args"]
 	if argsBuf == nil {
-		return nil, gas.Limits{}, errors.New("missing args field in args")
+		return nil, gas.Limits{}, nonceRWSet(), errors.New("missing args field in args")
 	}
 	var invokeArgs map[string][]byte
-	if err = json.Unmarshal(argsBuf, &invokeArgs); err != nil {
-		return nil, gas.Limits{}, err
+	if err := json.Unmarshal(argsBuf, &invokeArgs); err != nil {
+		return nil, gas.Limits{}, nonceRWSet(), err
+	}
+
+	env, err := parseEnvironment(args, string(caller))
+	if err != nil {
+		return nil, gas.Limits{}, nonceRWSet(), err
 	}
 
+	// 合约的语言以部署时保存的描述信息为准
 	state := &bridge.ContractState{
 		ContractName: contractName,
-		Language:     string(language),
 		Caller:       string(caller),
+		Env:          env,
+		Limits:       limits,
+		ReadOnly:     readOnly,
+		WriteSet:     root,
 	}
-
-	out, resourceUsed, err := v.invokeContract(state, method, invokeArgs)
+	out, resourceUsed, rwset, err := v.invokeContract(state, method, invokeArgs)
+	// 陷入和gas耗尽不会破坏缓存的代码，池中的实例在复用前恢复初始状态，不需要淘汰缓存
 	if err != nil {
-		if _, ok := err.(*bridge.ContractError); !ok {
-			v.vmimpl.RemoveCache(contractName)
-		}
-		log.Error("call contract initialize method error", "error", err, "contract", contractName)
-		return nil, gas.Limits{}, err
+		log.Error("call contract method error", "error", err, "contract", contractName, "method", method)
+		return nil, resourceUsed, nonceRWSet(), err
 	}
-	return out, resourceUsed, nil
+	return out, resourceUsed, rwset, nil
 }
 
-func (v *VMManager) invokeContract(state *bridge.ContractState, method string, args map[string][]byte) (*pb.Response, gas.Limits, error) {
+func (v *VMManager) invokeContract(state *bridge.ContractState, method string, args map[string][]byte) (*pb.Response, gas.Limits, *bridge.RWSet, error) {
 	vm, ok := v.bridge.GetVirtualMachine("wasm")
 	if !ok {
-		return nil, gas.Limits{}, errors.New("wasm vm not registered")
+		return nil, gas.Limits{}, nil, errors.New("wasm vm not registered")
 	}
 
+	// 合约在派生的WriteSet上执行，合约失败时丢弃的写操作不包括调用方预先写入的数据
+	root := state.WriteSet
+	if root == nil {
+		root = bridge.NewWriteSet(v.db)
+	}
+	state.Method = method
+	state.WriteSet = root.Fork()
 	ctx, err := vm.NewVM(state)
 	if err != nil {
-		return nil, gas.Limits{}, err
+		return nil, gas.Limits{}, nil, err
 	}
+	// 调用结束后实例放回池中，同时销毁ContractState
+	defer ctx.ReleaseCache()
 	out, err := ctx.Invoke(method, args)
 	if err != nil {
-		return nil, gas.Limits{}, err
+		return nil, ctx.ResourceUsed(), nil, err
+	}
+	return out, ctx.ResourceUsed(), root.RWSet(), nil
+}
+
+// parseEnvironment 从调用参数中解析执行环境，initiator缺省为caller
+func parseEnvironment(args map[string][]byte, caller string) (*pb.Environment, error) {
+	env := &pb.Environment{
+		Txid:      string(args["txid"]),
+		Initiator: string(args["initiator"]),
+	}
+	if env.Initiator == "" {
+		env.Initiator = caller
+	}
+	var err error
+	if height := args["height"]; height != nil {
+		if env.Height, err = strconv.ParseInt(string(height), 10, 64); err != nil {
+			return nil, fmt.Errorf("bad height:%s", height)
+		}
+	}
+	if timestamp := args["timestamp"]; timestamp != nil {
+		if env.Timestamp, err = strconv.ParseInt(string(timestamp), 10, 64); err != nil {
+			return nil, fmt.Errorf("bad timestamp:%s", timestamp)
+		}
 	}
-	return out, ctx.ResourceUsed(), nil
+	return env, nil
 }
@@ -0,0 +1,819 @@
+package vm_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/BeDreamCoder/uwavm/bridge"
+	"github.com/BeDreamCoder/uwavm/common/db/memorydb"
+	"github.com/BeDreamCoder/uwavm/common/util"
+	"github.com/BeDreamCoder/uwavm/contract/go/pb"
+	"github.com/BeDreamCoder/uwavm/vm"
+	"github.com/BeDreamCoder/uwavm/vm/gas"
+	_ "github.com/BeDreamCoder/uwavm/vm/interpreter"
+)
+
+var (
+	// raceEnabled is true when testing with the race detector
+	raceEnabled bool
+
+	testDB      *memorydb.MemDB
+	testVM      bridge.VirtualMachine
+	testManager *vm.VMManager
+)
+
+func init() {
+	database := memorydb.NewMemDB()
+	testDB = database
+	b := bridge.GetBridge(database)
+	testManager = vm.NewVMManager(database, b)
+	testVM = b.RegisterExecutor("wasm", testManager)
+}
+
+func makeDeployArgs(t *testing.T, name, caller string) map[string][]byte {
+	code, err := ioutil.ReadFile("../testdata/erc20_c.wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	initArgs, _ := json.Marshal(map[string][]byte{
+		"totalSupply": []byte("1000000"),
+	})
+	return map[string][]byte{
+		"contract_name": []byte(name),
+		"contract_code": code,
+		"language":      []byte("c"),
+		"args":          initArgs,
+		"caller":        []byte(caller),
+	}
+}
+
+func TestDeployInvalidContractName(t *testing.T) {
+	cases := []struct {
+		name   string
+		reason string
+	}{
+		{"abc", "length"},
+		{strings.Repeat("a", 65), "length"},
+		{"1erc20", "start with a letter"},
+		{"_erc20", "start with a letter"},
+		{"erc-20", "start with a letter"},
+		{"erc 20", "start with a letter"},
+		{"token/a", "start with a letter"},
+		{"uwavm.token", "reserved prefix"},
+		{"SystemToken", "reserved prefix"},
+	}
+	for _, c := range cases {
+		_, _, _, err := testVM.DeployContract(makeDeployArgs(t, c.name, "alice"), gas.MaxLimits)
+		if err == nil {
+			t.Errorf("deploy %q: expect error", c.name)
+			continue
+		}
+		if !strings.Contains(err.Error(), c.reason) {
+			t.Errorf("deploy %q: expect %q error, got %v", c.name, c.reason, err)
+		}
+	}
+}
+
+func TestDeployValidContractName(t *testing.T) {
+	for _, name := range []string{"erc20", "Token.v2", "my_token", strings.Repeat("a", 64)} {
+		resp, _, rwset, err := testVM.DeployContract(makeDeployArgs(t, name, "alice"), gas.MaxLimits)
+		if err != nil {
+			t.Fatalf("deploy %q: %v", name, err)
+		}
+		if resp.GetStatus() != 200 {
+			t.Fatalf("deploy %q: status %d %s", name, resp.GetStatus(), resp.GetMessage())
+		}
+		if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCallLegacyContractName(t *testing.T) {
+	// 命名规则之前部署的合约直接写入存储
+	args := makeDeployArgs(t, "abc", "alice")
+	code := args["contract_code"]
+	hash := sha256.Sum256(code)
+	ws := bridge.NewWriteSet(testDB)
+	if err := ws.Put(util.ContractCodeKey("abc"), code); err != nil {
+		t.Fatal(err)
+	}
+	err := bridge.PutContractDesc(ws, &pb.ContractDesc{
+		Name:     "abc",
+		Language: "c",
+		CodeHash: hash[:],
+		Deployer: "alice",
+		Version:  1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(ws.RWSet()); err != nil {
+		t.Fatal(err)
+	}
+
+	queryBalance(t, "abc", "alice")
+	if _, _, _, err = testVM.DeployContract(args, gas.MaxLimits); err == nil || !strings.Contains(err.Error(), "length") {
+		t.Fatalf("expect the naming policy on deploy, got %v", err)
+	}
+}
+
+func TestDeployDuplicateContract(t *testing.T) {
+	_, _, rwset, err := testVM.DeployContract(makeDeployArgs(t, "duplicate", "alice"), gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, caller := range []string{"alice", "bob"} {
+		_, _, _, err = testVM.DeployContract(makeDeployArgs(t, "duplicate", caller), gas.MaxLimits)
+		if !errors.Is(err, vm.ErrContractExists) {
+			t.Errorf("deploy by %s: expect ErrContractExists, got %v", caller, err)
+		}
+	}
+
+	desc, err := bridge.GetBridge(nil).GetContractDesc("duplicate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.GetDeployer() != "alice" || desc.GetVersion() != 1 {
+		t.Errorf("contract overwritten, deployer:%s version:%d", desc.GetDeployer(), desc.GetVersion())
+	}
+}
+
+func TestDeployFailedInitializeReleasesName(t *testing.T) {
+	args := makeDeployArgs(t, "badinit", "alice")
+	args["args"] = []byte("{}")
+	resp, _, _, err := testVM.DeployContract(args, gas.MaxLimits)
+	if err == nil && resp.GetStatus() < bridge.StatusErrorThreshold {
+		t.Fatal("expect initialize to fail without totalSupply")
+	}
+	if _, err = bridge.GetBridge(nil).GetContractDesc("badinit"); err == nil {
+		t.Fatal("failed deployment should be removed")
+	}
+
+	history, err := bridge.GetBridge(nil).GetContractHistory("badinit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 0 {
+		t.Fatalf("failed deployment left %d history entries", len(history))
+	}
+
+	resp, _, _, err = testVM.DeployContract(makeDeployArgs(t, "badinit", "alice"), gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != 200 {
+		t.Fatalf("redeploy: status %d %s", resp.GetStatus(), resp.GetMessage())
+	}
+}
+
+func TestDeployCommitsWithRWSet(t *testing.T) {
+	resp, _, rwset, err := testVM.DeployContract(makeDeployArgs(t, "atomicdeploy", "alice"), gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != 200 {
+		t.Fatalf("deploy: status %d %s", resp.GetStatus(), resp.GetMessage())
+	}
+	// 提交之前代码、描述信息和历史记录都不可见
+	if _, err = bridge.GetBridge(nil).GetContractDesc("atomicdeploy"); err == nil {
+		t.Fatal("contract visible before the rwset is committed")
+	}
+	history, err := bridge.GetBridge(nil).GetContractHistory("atomicdeploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 0 {
+		t.Fatal("history visible before the rwset is committed")
+	}
+	for _, key := range [][]byte{util.ContractCodeKey("atomicdeploy"), util.ContractCodeDescKey("atomicdeploy")} {
+		if !rwsetWrites(rwset, key) {
+			t.Errorf("rwset does not write %s", key)
+		}
+	}
+
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := bridge.GetBridge(nil).GetContractDesc("atomicdeploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.GetDeployer() != "alice" || desc.GetVersion() != 1 || desc.GetLanguage() != "c" {
+		t.Fatalf("bad desc %+v", desc)
+	}
+	if body, _ := queryBalance(t, "atomicdeploy", "alice"); body != "1000000" {
+		t.Fatalf("initialized balance %s", body)
+	}
+}
+
+func TestDeployEnvironment(t *testing.T) {
+	for _, name := range []string{"height", "timestamp"} {
+		args := makeDeployArgs(t, "badenv", "alice")
+		args[name] = []byte("abc")
+		if _, _, _, err := testVM.DeployContract(args, gas.MaxLimits); err == nil {
+			t.Errorf("expect a bad %s to fail", name)
+		}
+	}
+
+	// 部署时间取自执行环境
+	args := makeDeployArgs(t, "envdeploy", "alice")
+	args["height"] = []byte("10")
+	args["timestamp"] = []byte("1600000000")
+	resp, _, rwset, err := testVM.DeployContract(args, gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != 200 {
+		t.Fatalf("deploy: status %d %s", resp.GetStatus(), resp.GetMessage())
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := bridge.GetBridge(nil).GetContractDesc("envdeploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.GetDeployTime() != 1600000000 {
+		t.Fatalf("deploy time %d", desc.GetDeployTime())
+	}
+}
+
+func TestConcurrentDeployConflicts(t *testing.T) {
+	_, _, first, err := testVM.DeployContract(makeDeployArgs(t, "racedeploy", "alice"), gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, second, err := testVM.DeployContract(makeDeployArgs(t, "racedeploy", "bob"), gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(first); err != nil {
+		t.Fatal(err)
+	}
+	var conflict *bridge.ErrVersionConflict
+	if err = bridge.GetBridge(nil).CommitRWSet(second); !errors.As(err, &conflict) {
+		t.Fatalf("expect version conflict, got %v", err)
+	}
+	desc, err := bridge.GetBridge(nil).GetContractDesc("racedeploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.GetDeployer() != "alice" {
+		t.Fatalf("deployer %s, expect alice", desc.GetDeployer())
+	}
+}
+
+func makeUpgradeArgs(t *testing.T, name, caller string) map[string][]byte {
+	args := makeDeployArgs(t, name, caller)
+	args["args"] = []byte("{}")
+	args[bridge.NonceArg] = nextNonce(t, caller)
+	delete(args, "language")
+	return args
+}
+
+// nextNonce returns the next nonce of the account in the format of the request args
+func nextNonce(t *testing.T, account string) []byte {
+	nonce, err := bridge.GetBridge(nil).GetNonce(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []byte(strconv.FormatUint(nonce+1, 10))
+}
+
+// onlyWritesNonce reports whether the rwset writes nothing but the nonce of the account
+func onlyWritesNonce(rwset *bridge.RWSet, account string) bool {
+	return rwset != nil && len(rwset.Writes) == 1 && rwsetWrites(rwset, util.NonceKey(account))
+}
+
+func TestUpgradeCommitsWithRWSet(t *testing.T) {
+	deployTestContract(t, "upgradable")
+	oldDesc, err := bridge.GetBridge(nil).GetContractDesc("upgradable")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, _, err = testVM.UpgradeContract(makeUpgradeArgs(t, "upgradable", "bob"), gas.MaxLimits); err == nil {
+		t.Fatal("expect only the deployer to upgrade")
+	}
+
+	args := makeUpgradeArgs(t, "upgradable", "alice")
+	args["abi"] = []byte("v2")
+	resp, _, rwset, err := testVM.UpgradeContract(args, gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != 200 {
+		t.Fatalf("upgrade: status %d %s", resp.GetStatus(), resp.GetMessage())
+	}
+	if desc, _ := bridge.GetBridge(nil).GetContractDesc("upgradable"); desc.GetVersion() != 1 {
+		t.Fatalf("upgrade visible before the rwset is committed, version %d", desc.GetVersion())
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := bridge.GetBridge(nil).GetContractHistory("upgradable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].GetVersion() != 1 || history[1].GetVersion() != 2 {
+		t.Fatalf("bad history %v", history)
+	}
+	desc, err := bridge.GetBridge(nil).GetContractDesc("upgradable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.GetVersion() != 2 || string(desc.GetAbi()) != "v2" || desc.GetDeployer() != "alice" || desc.GetLanguage() != oldDesc.GetLanguage() {
+		t.Fatalf("bad desc after upgrade %+v", desc)
+	}
+	// 状态在升级后保留
+	if body, _ := queryBalance(t, "upgradable", "alice"); body != "1000000" {
+		t.Fatalf("balance after upgrade %s", body)
+	}
+}
+
+func TestFailedUpgradeKeepsOldVersion(t *testing.T) {
+	deployTestContract(t, "badupgrade")
+	args := makeUpgradeArgs(t, "badupgrade", "alice")
+	args["contract_code"] = []byte("not a wasm module")
+	_, _, rwset, err := testVM.UpgradeContract(args, gas.MaxLimits)
+	if err == nil {
+		t.Fatal("expect upgrade with bad code to fail")
+	}
+	// 失败的升级只提交消耗的nonce
+	if !onlyWritesNonce(rwset, "alice") {
+		t.Fatalf("failed upgrade should only consume the nonce, rwset %+v", rwset)
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err = testVM.UpgradeContract(args, gas.MaxLimits); !errors.Is(err, bridge.ErrBadNonce) {
+		t.Fatalf("expect the failed upgrade not to be replayed, got %v", err)
+	}
+
+	desc, err := bridge.GetBridge(nil).GetContractDesc("badupgrade")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.GetVersion() != 1 {
+		t.Fatalf("failed upgrade changed version to %d", desc.GetVersion())
+	}
+	history, err := bridge.GetBridge(nil).GetContractHistory("badupgrade")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("failed upgrade left %d history entries", len(history))
+	}
+	if body, _ := queryBalance(t, "badupgrade", "alice"); body != "1000000" {
+		t.Fatalf("balance after failed upgrade %s", body)
+	}
+}
+
+func TestUpgradeRequiresNonce(t *testing.T) {
+	deployTestContract(t, "nonceupgrade")
+	args := makeUpgradeArgs(t, "nonceupgrade", "alice")
+	delete(args, bridge.NonceArg)
+	if _, _, _, err := testVM.UpgradeContract(args, gas.MaxLimits); !errors.Is(err, bridge.ErrBadNonce) {
+		t.Fatalf("expect upgrade without nonce to fail, got %v", err)
+	}
+
+	// 非部署者的升级失败，但同样消耗nonce
+	_, _, rwset, err := testVM.UpgradeContract(makeUpgradeArgs(t, "nonceupgrade", "bob"), gas.MaxLimits)
+	if err == nil || !onlyWritesNonce(rwset, "bob") {
+		t.Fatalf("expect the denied upgrade to only consume the nonce, err %v rwset %+v", err, rwset)
+	}
+
+	args = makeUpgradeArgs(t, "nonceupgrade", "alice")
+	_, _, rwset, err = testVM.UpgradeContract(args, gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rwsetWrites(rwset, util.NonceKey("alice")) || !rwsetWrites(rwset, util.ContractCodeKey("nonceupgrade")) {
+		t.Fatalf("upgrade should write the nonce with the code, rwset %+v", rwset)
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err = testVM.UpgradeContract(args, gas.MaxLimits); !errors.Is(err, bridge.ErrBadNonce) {
+		t.Fatalf("expect the upgrade not to be replayed, got %v", err)
+	}
+	if desc, _ := bridge.GetBridge(nil).GetContractDesc("nonceupgrade"); desc.GetVersion() != 2 {
+		t.Fatalf("expect version 2, got %d", desc.GetVersion())
+	}
+}
+
+func makeTransferArgs(name, from, to, amount string, nonce []byte) map[string][]byte {
+	args, _ := json.Marshal(map[string][]byte{
+		"from":   []byte(from),
+		"to":     []byte(to),
+		"amount": []byte(amount),
+	})
+	return map[string][]byte{
+		"contract_name": []byte(name),
+		"args":          args,
+		"caller":        []byte(from),
+		bridge.NonceArg: nonce,
+	}
+}
+
+func TestInvokeNonceNotReplayed(t *testing.T) {
+	deployTestContract(t, "noncetoken")
+	args := makeTransferArgs("noncetoken", "alice", "bob", "10", nextNonce(t, "alice"))
+	resp, _, rwset, err := testVM.InvokeContract("transfer", args, gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != 200 {
+		t.Fatalf("transfer: status %d %s", resp.GetStatus(), resp.GetMessage())
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, rwset, err = testVM.InvokeContract("transfer", args, gas.MaxLimits); !errors.Is(err, bridge.ErrBadNonce) || rwset != nil {
+		t.Fatalf("expect the replayed call to fail without rwset, got %v", err)
+	}
+	if body, _ := queryBalance(t, "noncetoken", "bob"); body != "10" {
+		t.Fatalf("balance of bob %s, expect 10", body)
+	}
+}
+
+func TestFailedInvokeConsumesNonce(t *testing.T) {
+	deployTestContract(t, "failtoken")
+	before, err := bridge.GetBridge(nil).GetNonce("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := makeTransferArgs("failtoken", "alice", "bob", "10", nextNonce(t, "alice"))
+	_, _, rwset, err := testVM.InvokeContract("transfer", args, gas.FromGas(1))
+	var outOfGas *gas.ErrOutOfGas
+	if !errors.As(err, &outOfGas) {
+		t.Fatalf("expect out of gas, got %v", err)
+	}
+	if !onlyWritesNonce(rwset, "alice") {
+		t.Fatalf("failed call should only consume the nonce, rwset %+v", rwset)
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+	if nonce, _ := bridge.GetBridge(nil).GetNonce("alice"); nonce != before+1 {
+		t.Fatalf("expect nonce %d after the failed call, got %d", before+1, nonce)
+	}
+	// 失败的调用不能以更多的gas重放
+	if _, _, _, err = testVM.InvokeContract("transfer", args, gas.MaxLimits); !errors.Is(err, bridge.ErrBadNonce) {
+		t.Fatalf("expect the failed call not to be replayed, got %v", err)
+	}
+	if body, _ := queryBalance(t, "failtoken", "bob"); body != "" {
+		t.Fatalf("failed call changed the balance of bob to %s", body)
+	}
+}
+
+func rwsetWrites(rwset *bridge.RWSet, key []byte) bool {
+	for _, write := range rwset.Writes {
+		if string(write.Key) == string(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// deployGoContract deploys the go erc20 contract once, the benchmark function is called many times.
+// testdata/erc20_go.wasm is built from contract/go/example with testdata/gen_erc20_go.sh
+func deployGoContract(tb testing.TB, name string) {
+	if desc, _ := bridge.GetBridge(nil).GetContractDesc(name); desc != nil {
+		return
+	}
+	code, err := ioutil.ReadFile("../testdata/erc20_go.wasm")
+	if err != nil {
+		tb.Fatalf("go contract not built, run testdata/gen_erc20_go.sh: %v", err)
+	}
+	initArgs, _ := json.Marshal(map[string][]byte{
+		"totalSupply": []byte("1000000"),
+	})
+	resp, _, rwset, err := testVM.DeployContract(map[string][]byte{
+		"contract_name": []byte(name),
+		"contract_code": code,
+		"language":      []byte("go"),
+		"args":          initArgs,
+		"caller":        []byte("alice"),
+	}, gas.MaxLimits)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if resp.GetStatus() != 200 {
+		tb.Fatalf("deploy: status %d %s", resp.GetStatus(), resp.GetMessage())
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		tb.Fatal(err)
+	}
+}
+
+// callGoContract calls the method of the go erc20 contract and commits the rwset of invoke
+func callGoContract(tb testing.TB, name, method string, args map[string][]byte) (string, gas.Limits) {
+	argsBuf, _ := json.Marshal(args)
+	callArgs := map[string][]byte{
+		"contract_name": []byte(name),
+		"args":          argsBuf,
+		"caller":        []byte("alice"),
+	}
+	if method == "query" {
+		resp, used, err := testVM.QueryContract(method, callArgs, gas.MaxLimits)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		if resp.GetStatus() != 200 {
+			tb.Fatalf("query: status %d %s", resp.GetStatus(), resp.GetMessage())
+		}
+		return string(resp.GetBody()), used
+	}
+	nonce, err := bridge.GetBridge(nil).GetNonce("alice")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	callArgs[bridge.NonceArg] = []byte(strconv.FormatUint(nonce+1, 10))
+	resp, used, rwset, err := testVM.InvokeContract(method, callArgs, gas.MaxLimits)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if resp.GetStatus() != 200 {
+		tb.Fatalf("%s: status %d %s", method, resp.GetStatus(), resp.GetMessage())
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		tb.Fatal(err)
+	}
+	return string(resp.GetBody()), used
+}
+
+// BenchmarkGoContractCall compares the gas of a go contract call on a new instance, which starts the go runtime
+// and runs main, with the gas of a call dispatched to the persistent instance
+func BenchmarkGoContractCall(b *testing.B) {
+	deployGoContract(b, "gobench")
+	args := map[string][]byte{
+		"action": []byte("totalSupply"),
+	}
+	call := func() int64 {
+		body, used := callGoContract(b, "gobench", "query", args)
+		if body != "1000000" {
+			b.Fatalf("query: body %s", body)
+		}
+		return used.Cpu
+	}
+
+	testManager.RemoveCache("gobench")
+	cold := call()
+	var warm int64
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		warm = call()
+	}
+	b.ReportMetric(float64(cold), "cold-gas/op")
+	b.ReportMetric(float64(warm), "warm-gas/op")
+	b.ReportMetric(float64(cold-warm), "saved-gas/op")
+}
+
+func TestGoContractReusedCall(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the go runtime grows the memory to 1GB, too large for the race detector")
+	}
+	deployGoContract(t, "golive")
+	testManager.RemoveCache("golive")
+	transfer := map[string][]byte{
+		"action": []byte("transfer"),
+		"to":     []byte("bob"),
+		"amount": []byte("10"),
+	}
+	balanceOf := map[string][]byte{
+		"action":  []byte("balanceOf"),
+		"address": []byte("bob"),
+	}
+
+	// 第一次调用启动go runtime并运行main，之后的调用复用池中恢复到快照的实例
+	callGoContract(t, "golive", "invoke", transfer)
+	before := testManager.CodeCacheStats()
+	_, used1 := callGoContract(t, "golive", "invoke", transfer)
+	body1, queryUsed1 := callGoContract(t, "golive", "query", balanceOf)
+	_, used2 := callGoContract(t, "golive", "invoke", transfer)
+	body2, queryUsed2 := callGoContract(t, "golive", "query", balanceOf)
+	if got := testManager.CodeCacheStats().ReusedInstances - before.ReusedInstances; got != 4 {
+		t.Fatalf("expect all the calls to reuse the live instance, reused %d", got)
+	}
+
+	// 复用的实例不保留上次调用的内存，状态通过GetObject读取
+	if body1 != "20" || body2 != "30" {
+		t.Fatalf("balance of bob %s and %s, expect 20 and 30", body1, body2)
+	}
+	if used1 != used2 || queryUsed1 != queryUsed2 {
+		t.Fatalf("gas mismatch, invoke %+v %+v query %+v %+v", used1, used2, queryUsed1, queryUsed2)
+	}
+
+	// 在新的实例上第一次调用的结果和复用实例的结果相同
+	testManager.RemoveCache("golive")
+	if body, _ := callGoContract(t, "golive", "query", balanceOf); body != body2 {
+		t.Fatalf("balance of bob on a new instance %s, expect %s", body, body2)
+	}
+	if body, used := callGoContract(t, "golive", "query", balanceOf); body != body2 || used != queryUsed2 {
+		t.Fatalf("reused call after restart: body %s gas %+v, expect %s %+v", body, used, body2, queryUsed2)
+	}
+}
+
+func deployTestContract(t *testing.T, name string) {
+	resp, _, rwset, err := testVM.DeployContract(makeDeployArgs(t, name, "alice"), gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != 200 {
+		t.Fatalf("deploy %q: status %d %s", name, resp.GetStatus(), resp.GetMessage())
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func queryBalance(t *testing.T, name, caller string) (string, gas.Limits) {
+	args, _ := json.Marshal(map[string][]byte{
+		"caller": []byte(caller),
+	})
+	resp, used, err := testVM.QueryContract("balance", map[string][]byte{
+		"contract_name": []byte(name),
+		"args":          args,
+		"caller":        []byte(caller),
+	}, gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != 200 {
+		t.Fatalf("query %q: status %d %s", name, resp.GetStatus(), resp.GetMessage())
+	}
+	return string(resp.GetBody()), used
+}
+
+func TestQueryGasLimit(t *testing.T) {
+	deployTestContract(t, "limittoken")
+	args, _ := json.Marshal(map[string][]byte{
+		"caller": []byte("alice"),
+	})
+	query := func(limits gas.Limits) (gas.Limits, error) {
+		resp, used, err := testVM.QueryContract("balance", map[string][]byte{
+			"contract_name": []byte("limittoken"),
+			"args":          args,
+			"caller":        []byte("alice"),
+		}, limits)
+		if err == nil && string(resp.GetBody()) != "1000000" {
+			t.Fatalf("query: body %s", resp.GetBody())
+		}
+		return used, err
+	}
+	used, err := query(gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used.Cpu == 0 || used.Memory == 0 {
+		t.Fatalf("resource not metered %+v", used)
+	}
+	// 上限恰好等于消耗时调用成功
+	if _, err = query(used); err != nil {
+		t.Fatalf("query with the exact limits: %v", err)
+	}
+
+	for _, limits := range []gas.Limits{
+		{Cpu: used.Cpu - 1, Memory: used.Memory},
+		{Cpu: used.Cpu, Memory: used.Memory - 1},
+	} {
+		outUsed, err := query(limits)
+		var outOfGas *gas.ErrOutOfGas
+		if !errors.As(err, &outOfGas) {
+			t.Fatalf("limits %+v: expect out of gas, got %v", limits, err)
+		}
+		// 错误中包含实际的消耗
+		if !outOfGas.Used.Exceed(limits) || outOfGas.Limits != limits || outUsed != outOfGas.Used {
+			t.Fatalf("limits %+v: bad out of gas error %+v, used %+v", limits, outOfGas, outUsed)
+		}
+	}
+}
+
+func TestQueryCanNotWrite(t *testing.T) {
+	deployTestContract(t, "querytoken")
+	// 查询不需要nonce，示例合约忽略了写操作的错误，但余额不会改变
+	args := makeTransferArgs("querytoken", "alice", "bob", "10", nil)
+	if _, _, err := testVM.QueryContract("transfer", args, gas.MaxLimits); err != nil {
+		t.Fatal(err)
+	}
+	if body, _ := queryBalance(t, "querytoken", "bob"); body != "" {
+		t.Fatalf("query changed the balance of bob to %s", body)
+	}
+	if body, _ := queryBalance(t, "querytoken", "alice"); body != "1000000" {
+		t.Fatalf("query changed the balance of alice to %s", body)
+	}
+}
+
+func TestCallReusesPooledInstance(t *testing.T) {
+	deployTestContract(t, "pooled")
+	before := testManager.CodeCacheStats()
+	if before.IdleInstances == 0 {
+		t.Fatal("instance of the initialize call is not put back to the pool")
+	}
+
+	body1, used1 := queryBalance(t, "pooled", "alice")
+	stats := testManager.CodeCacheStats()
+	if stats.ReusedInstances != before.ReusedInstances+1 {
+		t.Fatalf("expect the first call to reuse the pooled instance, reused %d -> %d", before.ReusedInstances, stats.ReusedInstances)
+	}
+	if stats.IdleInstances != before.IdleInstances {
+		t.Fatalf("instance not put back to the pool, idle %d -> %d", before.IdleInstances, stats.IdleInstances)
+	}
+
+	// 复用的实例恢复了内存和全局变量，结果与消耗的gas都相同
+	body2, used2 := queryBalance(t, "pooled", "alice")
+	if got := testManager.CodeCacheStats().ReusedInstances; got != stats.ReusedInstances+1 {
+		t.Fatalf("expect the second call to reuse the pooled instance, reused %d -> %d", stats.ReusedInstances, got)
+	}
+	if body1 != "1000000" || body2 != body1 {
+		t.Fatalf("balance mismatch, first:%s second:%s", body1, body2)
+	}
+	if used1 != used2 {
+		t.Fatalf("gas mismatch, first:%+v second:%+v", used1, used2)
+	}
+}
+
+func TestOutOfGasKeepsCodeCache(t *testing.T) {
+	deployTestContract(t, "gastoken")
+	queryBalance(t, "gastoken", "alice")
+	before := testManager.CodeCacheStats()
+
+	args := makeTransferArgs("gastoken", "alice", "bob", "10", nextNonce(t, "alice"))
+	_, _, rwset, err := testVM.InvokeContract("transfer", args, gas.FromGas(1))
+	var outOfGas *gas.ErrOutOfGas
+	if !errors.As(err, &outOfGas) {
+		t.Fatalf("expect out of gas, got %v", err)
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+
+	// gas耗尽的调用不淘汰缓存，之后的调用直接命中
+	body, _ := queryBalance(t, "gastoken", "alice")
+	stats := testManager.CodeCacheStats()
+	if stats.Misses != before.Misses {
+		t.Fatalf("code recompiled after out of gas, misses %d -> %d", before.Misses, stats.Misses)
+	}
+	if body != "1000000" {
+		t.Fatalf("balance after out of gas %s", body)
+	}
+}
+
+func TestUpgradeCompilesNewCode(t *testing.T) {
+	deployTestContract(t, "cachetoken")
+	queryBalance(t, "cachetoken", "alice")
+	before := testManager.CodeCacheStats()
+
+	args := makeUpgradeArgs(t, "cachetoken", "alice")
+	args["abi"] = []byte("v2")
+	// 代码不变时hash相同，修改代码使升级产生新的hash
+	args["contract_code"] = append(args["contract_code"], makeCustomSection("upgrade")...)
+	_, _, rwset, err := testVM.UpgradeContract(args, gas.MaxLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	afterUpgrade := testManager.CodeCacheStats()
+	if afterUpgrade.Misses != before.Misses+1 {
+		t.Fatalf("expect the migrate call to compile the new code, misses %d -> %d", before.Misses, afterUpgrade.Misses)
+	}
+
+	// 提交前其他调用仍命中旧代码
+	queryBalance(t, "cachetoken", "alice")
+	if stats := testManager.CodeCacheStats(); stats.Misses != afterUpgrade.Misses || stats.Hits != afterUpgrade.Hits+1 {
+		t.Fatalf("expect the old code to be hit before commit, stats %+v", stats)
+	}
+	if err = bridge.GetBridge(nil).CommitRWSet(rwset); err != nil {
+		t.Fatal(err)
+	}
+	// 提交后命中升级时编译的新代码，不需要重新编译
+	if body, _ := queryBalance(t, "cachetoken", "alice"); body != "1000000" {
+		t.Fatalf("balance after upgrade %s", body)
+	}
+	stats := testManager.CodeCacheStats()
+	if stats.Misses != afterUpgrade.Misses {
+		t.Fatalf("expect the upgraded code to be hit, misses %d -> %d", afterUpgrade.Misses, stats.Misses)
+	}
+	// 提交时淘汰旧代码以及池中的实例，缓存中只剩新代码
+	if stats.Size != before.Size || stats.IdleInstances != before.IdleInstances {
+		t.Fatalf("expect the old code to be evicted, stats %+v before upgrade %+v", stats, before)
+	}
+}
+
+// makeCustomSection returns a wasm custom section, appending it to a module changes the code
+// hash without changing the behavior
+func makeCustomSection(name string) []byte {
+	payload := append([]byte{byte(len(name))}, name...)
+	return append([]byte{0, byte(len(payload))}, payload...)
+}
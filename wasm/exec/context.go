@@ -1,6 +1,7 @@
 package exec
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -9,6 +10,9 @@ const (
 	MaxGasLimit = 0xFFFFFFFF
 )
 
+// ErrNotResettable is returned by Context.Reset when the context can not be restored to its initial state
+var ErrNotResettable = errors.New("context not resettable")
+
 type ErrFuncNotFound struct {
 	Name string
 }
@@ -34,10 +38,17 @@ type Context interface {
 	Exec(name string, param []int64) (ret int64, err error)
 	GasUsed() int64
 	ResetGasUsed()
+	// SetGasLimit changes the gas limit of the following executions
+	SetGasLimit(limit int64)
 	Memory() []byte
 	StaticTop() uint32
 	SetUserData(key string, value interface{})
 	GetUserData(key string) interface{}
+	// Reset 将内存和全局变量恢复到context刚创建时的状态并清空用户数据，
+	// 使用新的配置重新计量gas，用于复用context执行下一次调用
+	Reset(cfg *ContextConfig) error
+	// Snapshot 保存当前的内存、全局变量和用户数据，之后的Reset恢复到快照而不是初始状态
+	Snapshot()
 	Release()
 }
 
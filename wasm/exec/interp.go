@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sync"
 
 	"github.com/go-interpreter/wagon/exec"
 	"github.com/go-interpreter/wagon/wasm"
@@ -135,9 +136,17 @@ func makeWagonModule(resolver Resolver) wasm.ResolveModuleFunc {
 	}
 }
 
+// vmGasLimit 是wagon VM内部的gas上限，VM创建后无法修改上限，
+// 通过设置GasUsed的起始值来实现每次调用不同的gas限制
+const vmGasLimit = math.MaxInt64 >> 1
+
 // InterpCode is the WasmExec interface of interpreter mode
 type InterpCode struct {
 	module *wasm.Module
+
+	// 没有start函数的模块创建VM后的内存只由数据段决定，所有context共享同一份快照
+	snapshotOnce sync.Once
+	memSnapshot  []byte
 }
 
 // NewInterpCode instance a WasmExec based on the wasm code and resolver
@@ -160,16 +169,23 @@ func (code *InterpCode) NewContext(cfg *ContextConfig) (ictx Context, err error)
 	vm, err := exec.NewVM(code.module,
 		exec.WithLazyCompile(true),
 		exec.WithGasMapper(new(GasMapper)),
-		exec.WithGasLimit(cfg.GasLimit))
+		exec.WithGasLimit(vmGasLimit))
 	if err != nil {
 		return nil, err
 	}
+	if code.module.Start == nil {
+		code.snapshotOnce.Do(func() {
+			code.memSnapshot = append([]byte(nil), vm.Memory()...)
+		})
+	}
 	vm.RecoverPanic = true
 	ctx := &wagonContext{
+		code:     code,
 		module:   code.module,
 		vm:       vm,
 		userData: make(map[string]interface{}),
 	}
+	ctx.SetGasLimit(cfg.GasLimit)
 	vm.UserData = ctx
 	ictx = ctx
 	return
@@ -180,9 +196,19 @@ func (code *InterpCode) Release() {
 }
 
 type wagonContext struct {
+	code     *InterpCode
 	module   *wasm.Module
 	vm       *exec.VM
 	userData map[string]interface{}
+	gasBase  int64
+	snapshot *contextSnapshot
+}
+
+// contextSnapshot 为Snapshot保存的context状态
+type contextSnapshot struct {
+	memory   *memorySnapshot
+	globals  []uint64
+	userData map[string]interface{}
 }
 
 func (c *wagonContext) Exec(name string, param []int64) (ret int64, err error) {
@@ -199,6 +225,10 @@ func (c *wagonContext) Exec(name string, param []int64) (ret int64, err error) {
 	}
 	iret, err := c.vm.ExecCode(int64(idx), args...)
 	if err != nil {
+		// wagon以panic(exec.ErrOutOfGas)的方式中断执行
+		if err == exec.ErrOutOfGas {
+			return 0, &TrapError{Trap: TrapGasExhaustion}
+		}
 		return 0, err
 	}
 	if iret == nil {
@@ -219,11 +249,20 @@ func (c *wagonContext) Exec(name string, param []int64) (ret int64, err error) {
 }
 
 func (c *wagonContext) GasUsed() int64 {
-	return c.vm.GasUsed
+	return c.vm.GasUsed - c.gasBase
 }
 
 func (c *wagonContext) ResetGasUsed() {
-	c.vm.GasUsed = 0
+	c.vm.GasUsed = c.gasBase
+}
+
+func (c *wagonContext) SetGasLimit(limit int64) {
+	if limit > vmGasLimit {
+		limit = vmGasLimit
+	}
+	used := c.GasUsed()
+	c.gasBase = vmGasLimit - limit
+	c.vm.GasUsed = c.gasBase + used
 }
 
 func (c *wagonContext) Memory() []byte {
@@ -234,6 +273,52 @@ func (c *wagonContext) StaticTop() uint32 {
 	return uint32(c.vm.StaticTop)
 }
 
+// Reset restores the memory snapshot and the initial globals, the context can not be reset
+// if the module has a start function or the memory has grown.
+// The context is restored to the state saved by Snapshot if there is one
+func (c *wagonContext) Reset(cfg *ContextConfig) error {
+	mem := c.vm.Memory()
+	if c.snapshot != nil {
+		if len(mem) != c.snapshot.memory.size {
+			return ErrNotResettable
+		}
+		c.snapshot.memory.restore(mem)
+		c.vm.Restart()
+		if err := c.vm.SetGlobals(c.snapshot.globals); err != nil {
+			return err
+		}
+		c.userData = make(map[string]interface{}, len(c.snapshot.userData))
+		for key, value := range c.snapshot.userData {
+			c.userData[key] = value
+		}
+	} else {
+		if c.module.Start != nil || len(mem) != len(c.code.memSnapshot) {
+			return ErrNotResettable
+		}
+		copy(mem, c.code.memSnapshot)
+		// Restart按初始化表达式重置全局变量，没有start函数时与创建VM后的状态一致
+		c.vm.Restart()
+		c.userData = make(map[string]interface{})
+	}
+	c.vm.GasUsed = 0
+	c.gasBase = 0
+	c.SetGasLimit(cfg.GasLimit)
+	return nil
+}
+
+// Snapshot saves the memory, the globals and the user data, the following Reset restores them
+func (c *wagonContext) Snapshot() {
+	snapshot := &contextSnapshot{
+		memory:   takeMemorySnapshot(c.vm.Memory()),
+		globals:  c.vm.Globals(),
+		userData: make(map[string]interface{}, len(c.userData)),
+	}
+	for key, value := range c.userData {
+		snapshot.userData[key] = value
+	}
+	c.snapshot = snapshot
+}
+
 func (c *wagonContext) Release() {
 	c.vm.Close()
 }
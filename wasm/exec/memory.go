@@ -0,0 +1,66 @@
+package exec
+
+import "bytes"
+
+// snapshotPageSize 快照以wasm的内存页为单位保存
+const snapshotPageSize = 64 << 10
+
+var zeroPage = make([]byte, snapshotPageSize)
+
+// memoryPage is a non-zero page of the memory snapshot
+type memoryPage struct {
+	offset int
+	data   []byte
+}
+
+// memorySnapshot 只保存非零的内存页，go runtime预留的大块内存大部分从未写过
+type memorySnapshot struct {
+	size  int
+	pages []memoryPage
+}
+
+func takeMemorySnapshot(mem []byte) *memorySnapshot {
+	snapshot := &memorySnapshot{
+		size: len(mem),
+	}
+	for offset := 0; offset < len(mem); offset += snapshotPageSize {
+		page := mem[offset:minInt(offset+snapshotPageSize, len(mem))]
+		if isZeroPage(page) {
+			continue
+		}
+		snapshot.pages = append(snapshot.pages, memoryPage{
+			offset: offset,
+			data:   append([]byte(nil), page...),
+		})
+	}
+	return snapshot
+}
+
+// restore 先清零整块内存，再写回快照中的非零页
+func (s *memorySnapshot) restore(mem []byte) {
+	zeroMemory(mem)
+	for _, page := range s.pages {
+		copy(mem[page.offset:], page.data)
+	}
+}
+
+func isZeroPage(page []byte) bool {
+	return bytes.Equal(page, zeroPage[:len(page)])
+}
+
+// zeroPages clears the non-zero pages of the memory
+func zeroPages(mem []byte) {
+	for offset := 0; offset < len(mem); offset += snapshotPageSize {
+		page := mem[offset:minInt(offset+snapshotPageSize, len(mem))]
+		if !isZeroPage(page) {
+			copy(page, zeroPage)
+		}
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,17 @@
+//go:build linux
+// +build linux
+
+package exec
+
+import "syscall"
+
+// zeroMemory 通过madvise释放匿名映射的内存页，之后访问时内核按需分配清零的页，
+// 不需要遍历未写过的内存
+func zeroMemory(mem []byte) {
+	if len(mem) == 0 {
+		return
+	}
+	if err := syscall.Madvise(mem, syscall.MADV_DONTNEED); err != nil {
+		zeroPages(mem)
+	}
+}
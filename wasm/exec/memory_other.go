@@ -0,0 +1,8 @@
+//go:build !linux
+// +build !linux
+
+package exec
+
+func zeroMemory(mem []byte) {
+	zeroPages(mem)
+}
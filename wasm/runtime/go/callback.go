@@ -0,0 +1,208 @@
+package gowasm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/BeDreamCoder/uwavm/wasm/runtime/go/js"
+)
+
+const (
+	resumeFunc = "resume"
+
+	// DefaultHandler is the handler called for the methods without their own handler
+	DefaultHandler = "*"
+)
+
+var (
+	// ErrRuntimeExited is returned when calling a method of a runtime which has exited
+	ErrRuntimeExited = errors.New("go runtime exited")
+	// ErrEventNotHandled is returned when the go runtime does not handle the pending event
+	ErrEventNotHandled = errors.New("event not handled by go runtime")
+)
+
+// event simulates the _pendingEvent object of wasm_exec.js
+type event struct {
+	id     int64
+	args   []interface{}
+	result interface{}
+}
+
+// GetProperty implements the js.PropertyGetter interface
+func (e *event) GetProperty(name string) (interface{}, bool) {
+	switch name {
+	case "Id":
+		return e.id, true
+	case "This":
+		return nil, true
+	case "Args":
+		return e.args, true
+	case "Result":
+		return e.result, true
+	default:
+		return nil, false
+	}
+}
+
+// SetProperty implements the js.PropertySetter interface
+func (e *event) SetProperty(name string, value interface{}) bool {
+	if name != "Result" {
+		return false
+	}
+	e.result = value
+	return true
+}
+
+// goFunc is the js function wrapping a go function created by syscall/js.FuncOf
+type goFunc struct {
+	id int64
+}
+
+// goObject simulates the instance of Go class in wasm_exec.js
+type goObject struct {
+	rt *Runtime
+}
+
+// GetProperty implements the js.PropertyGetter interface
+func (g *goObject) GetProperty(name string) (interface{}, bool) {
+	switch name {
+	case "_makeFuncWrapper":
+		return g.makeFuncWrapper, true
+	case "_pendingEvent":
+		if g.rt.pendingEvent == nil {
+			return nil, true
+		}
+		return g.rt.pendingEvent, true
+	default:
+		return nil, false
+	}
+}
+
+// SetProperty implements the js.PropertySetter interface, go runtime clears the pending event after fetching it
+func (g *goObject) SetProperty(name string, value interface{}) bool {
+	if name != "_pendingEvent" {
+		return false
+	}
+	e, _ := value.(*event)
+	g.rt.pendingEvent = e
+	return true
+}
+
+func (g *goObject) makeFuncWrapper(args []interface{}) interface{} {
+	if len(args) != 1 {
+		js.ThrowException(js.ExceptionInvalidArgument)
+	}
+	id, ok := args[0].(int64)
+	if !ok {
+		js.ThrowException(js.ExceptionInvalidArgument)
+	}
+	return &goFunc{id: id}
+}
+
+// contractObject 注册为js的全局对象contract，go合约在main中通过
+// js.Global().Get("contract").Call("register", method, js.FuncOf(handler))注册方法回调
+type contractObject struct {
+	rt *Runtime
+}
+
+// Register binds the method to the go function
+func (c *contractObject) Register(args []interface{}) interface{} {
+	if len(args) != 2 {
+		js.ThrowException(js.ExceptionInvalidArgument)
+	}
+	method, ok := args[0].(string)
+	if !ok || method == "" {
+		js.ThrowException(js.ExceptionInvalidArgument)
+	}
+	fn, ok := args[1].(*goFunc)
+	if !ok {
+		js.ThrowException(js.ExceptionInvalidArgument)
+	}
+	c.rt.handlers[method] = fn.id
+	return nil
+}
+
+// Call calls the method of the contract. The go runtime is started through entry on the first call,
+// contracts registering method handlers in main keep running and the later calls are dispatched
+// to the handlers through the event loop of go runtime. Contracts without handlers run the method in main and exit.
+func (rt *Runtime) Call(entry, method string) error {
+	if !rt.started {
+		rt.started = true
+		// go's entry function expects argc and argv these two arguments
+		if _, err := rt.ctx.Exec(entry, []int64{0, 0}); err != nil {
+			rt.failed = true
+			return err
+		}
+		if rt.exited {
+			return nil
+		}
+		rt.saveSnapshot()
+	}
+	if rt.exited {
+		return ErrRuntimeExited
+	}
+	id, ok := rt.handlers[method]
+	if !ok {
+		id, ok = rt.handlers[DefaultHandler]
+	}
+	if !ok {
+		return fmt.Errorf("method %s not registered by go contract", method)
+	}
+
+	rt.pendingEvent = &event{
+		id:   id,
+		args: []interface{}{},
+	}
+	_, err := rt.ctx.Exec(resumeFunc, nil)
+	if err != nil {
+		rt.failed = true
+		return err
+	}
+	if rt.pendingEvent != nil {
+		rt.pendingEvent = nil
+		rt.failed = true
+		return ErrEventNotHandled
+	}
+	return nil
+}
+
+// Live reports whether the go runtime is waiting for events, a live runtime can serve
+// the next call without restarting
+func (rt *Runtime) Live() bool {
+	return rt.started && !rt.exited && !rt.failed && len(rt.handlers) != 0
+}
+
+// saveSnapshot 在main注册完方法回调后保存context和宿主的状态，
+// 每次复用前恢复到该状态，调用之间不共享内存，相同的调用消耗相同的gas
+func (rt *Runtime) saveSnapshot() {
+	if len(rt.handlers) == 0 {
+		return
+	}
+	rt.ctx.Snapshot()
+	rt.snapshot = &runtimeSnapshot{
+		jsvm:     rt.jsvm.SaveState(),
+		handlers: copyHandlers(rt.handlers),
+	}
+}
+
+// Restore restores the host state of the go runtime to the snapshot, it is called after the memory
+// and globals are restored by exec.Context.Reset. The runtime failed in a call is live again after restored
+func (rt *Runtime) Restore() {
+	if rt.snapshot == nil {
+		return
+	}
+	rt.jsvm.RestoreState(rt.snapshot.jsvm)
+	rt.handlers = copyHandlers(rt.snapshot.handlers)
+	rt.pendingEvent = nil
+	rt.exited = false
+	rt.exitcode = 0
+	rt.failed = false
+}
+
+func copyHandlers(handlers map[string]int64) map[string]int64 {
+	m := make(map[string]int64, len(handlers))
+	for method, id := range handlers {
+		m[method] = id
+	}
+	return m
+}
@@ -13,6 +13,11 @@ type PropertyGetter interface {
 	GetProperty(property string) (interface{}, bool)
 }
 
+// A PropertySetter can set property from SetProperty method
+type PropertySetter interface {
+	SetProperty(property string, value interface{}) bool
+}
+
 // VM  simulates the js runtime
 type VM struct {
 	cfg     *VMConfig
@@ -28,6 +33,9 @@ type VMConfig struct {
 	Memory *Memory
 
 	Global *Global
+
+	// Go is the instance of Go class in wasm_exec.js, a default object is used if not set
+	Go interface{}
 }
 
 // NewVM instance a VM object
@@ -54,15 +62,19 @@ func (vm *VM) initDefaultValue() {
 		value: vm.cfg.Memory,
 	}
 
-	goruntime := &Value{
-		name: "Go",
-		ref:  ValueGo,
-		value: map[string]interface{}{
-			"_makeFuncWrapper": func([]interface{}) interface{} {
-				return nil
-			},
+	var goobject interface{} = map[string]interface{}{
+		"_makeFuncWrapper": func([]interface{}) interface{} {
+			return nil
 		},
 	}
+	if vm.cfg.Go != nil {
+		goobject = vm.cfg.Go
+	}
+	goruntime := &Value{
+		name:  "Go",
+		ref:   ValueGo,
+		value: goobject,
+	}
 	vm.values[ValueGo] = goruntime
 	vm.cfg.Global.Register("Go", goruntime)
 
@@ -207,6 +219,57 @@ func (vm *VM) property(object interface{}, name string) (interface{}, bool) {
 	return nil, false
 }
 
+// SetProperty sets the property of a js object, the object must be a PropertySetter
+func (vm *VM) SetProperty(ref Ref, name string, value Ref) {
+	if ref == ValueUndefined {
+		Throw("set property %s on undefined", name)
+	}
+	parent, ok := vm.values[ref]
+	if !ok {
+		ThrowException(ExceptionRefNotFound(ref))
+	}
+	v, ok := vm.loadValue(value)
+	if !ok {
+		ThrowException(ExceptionRefNotFound(value))
+	}
+	setter, ok := parent.value.(PropertySetter)
+	if !ok || !setter.SetProperty(strings.Title(name), v.value) {
+		Throw("can not set property %s on %s", name, parent.Name())
+	}
+}
+
+// Length returns the length of an array or a string
+func (vm *VM) Length(ref Ref) int64 {
+	v, ok := vm.loadValue(ref)
+	if !ok {
+		ThrowException(ExceptionRefNotFound(ref))
+	}
+	p := reflect.ValueOf(v.value)
+	switch p.Kind() {
+	case reflect.Slice, reflect.Array, reflect.String:
+		return int64(p.Len())
+	default:
+		Throw("%s has no length", v.Name())
+	}
+	return 0
+}
+
+// Index returns the element of an array
+func (vm *VM) Index(ref Ref, idx int64) Ref {
+	v, ok := vm.loadValue(ref)
+	if !ok {
+		ThrowException(ExceptionRefNotFound(ref))
+	}
+	p := reflect.ValueOf(v.value)
+	if p.Kind() != reflect.Slice && p.Kind() != reflect.Array {
+		Throw("%s is not an array", v.Name())
+	}
+	if idx < 0 || idx >= int64(p.Len()) {
+		return ValueUndefined
+	}
+	return vm.storeValue(fmt.Sprintf("%s[%d]", v.name, idx), p.Index(int(idx)).Interface())
+}
+
 // Exception wraps an *Exception to Ref
 func (vm *VM) Exception(e *Exception) Ref {
 	return vm.storeValue("Exception", e)
@@ -263,6 +326,34 @@ func (vm *VM) Invoke(ref Ref, args []Ref) Ref {
 	return vm.call(v.name, v.value, args)
 }
 
+// VMState is a copy of the values stored in VM
+type VMState struct {
+	valueid Ref
+	values  map[Ref]*Value
+}
+
+// SaveState returns a copy of the stored values, the refs held by the wasm memory
+// saved at the same time stay valid after RestoreState
+func (vm *VM) SaveState() *VMState {
+	state := &VMState{
+		valueid: vm.valueid,
+		values:  make(map[Ref]*Value, len(vm.values)),
+	}
+	for ref, value := range vm.values {
+		state.values[ref] = value
+	}
+	return state
+}
+
+// RestoreState drops the values stored after the state was saved
+func (vm *VM) RestoreState(state *VMState) {
+	vm.valueid = state.valueid
+	vm.values = make(map[Ref]*Value, len(state.values))
+	for ref, value := range state.values {
+		vm.values[ref] = value
+	}
+}
+
 // Store wrap a Go object to Ref
 func (vm *VM) Store(x interface{}) Ref {
 	return vm.storeValue("store", x)
@@ -21,6 +21,20 @@ type Runtime struct {
 	ctx      exec.Context
 
 	timeOrigin time.Time
+
+	// 合约通过syscall/js.FuncOf注册的方法回调
+	started      bool
+	failed       bool
+	handlers     map[string]int64
+	pendingEvent *event
+
+	// main注册完方法回调后的宿主状态，与context的快照一同恢复
+	snapshot *runtimeSnapshot
+}
+
+type runtimeSnapshot struct {
+	jsvm     *js.VMState
+	handlers map[string]int64
 }
 
 // RegisterRuntime 用于向exec.Context里面注册一个初始化好的js Runtime
@@ -29,6 +43,7 @@ func RegisterRuntime(ctx exec.Context) *Runtime {
 		global:     js.NewGlobal(),
 		timeOrigin: time.Now(),
 		ctx:        ctx,
+		handlers:   make(map[string]int64),
 	}
 	ctx.SetUserData(goRuntimeKey, rt)
 
@@ -38,8 +53,16 @@ func RegisterRuntime(ctx exec.Context) *Runtime {
 	rt.jsvm = js.NewVM(&js.VMConfig{
 		Memory: jsmem,
 		Global: rt.global,
+		Go:     &goObject{rt: rt},
 	})
 	rt.global.Register("Fs", fs.NewFS())
+	rt.global.Register("Contract", &contractObject{rt: rt})
+	return rt
+}
+
+// GetRuntime returns the Runtime registered to the context, nil if there is none
+func GetRuntime(ctx exec.Context) *Runtime {
+	rt, _ := ctx.GetUserData(goRuntimeKey).(*Runtime)
 	return rt
 }
 
@@ -100,7 +123,7 @@ func (rt *Runtime) syscallJsValueGet(ref js.Ref, name string) (ret js.Ref) {
 }
 
 func (rt *Runtime) syscallJsValueSet(ref js.Ref, name string, value js.Ref) {
-
+	rt.jsvm.SetProperty(ref, name, value)
 }
 
 func (rt *Runtime) syscallJsValueNew(ref js.Ref, args []js.Ref) (ret js.Ref, ok bool) {
@@ -141,14 +164,14 @@ func (rt *Runtime) syscallJsStringVal(value string) js.Ref {
 }
 
 func (rt *Runtime) syscallJsValueIndex(ref js.Ref, idx int64) js.Ref {
-	return 0
+	return rt.jsvm.Index(ref, idx)
 }
 
 func (rt *Runtime) syscallJsValueSetIndex(ref js.Ref, idx int64, x js.Ref) {
 }
 
 func (rt *Runtime) syscallJsValueLength(ref js.Ref) int64 {
-	return 0
+	return rt.jsvm.Length(ref)
 }
 
 func (rt *Runtime) syscallJsValueInstanceOf(v, t js.Ref) {